@@ -12,7 +12,9 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmante "github.com/evmos/evmos/v15/app/ante/evm"
 	anteutils "github.com/evmos/evmos/v15/app/ante/utils"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 )
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
@@ -28,6 +30,8 @@ type DeductFeeDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	feegrantKeeper     authante.FeegrantKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	feeMarketKeeper    evmante.FeeMarketKeeper
+	evmKeeper          evmante.EVMKeeper
 	txFeeChecker       anteutils.TxFeeChecker
 }
 
@@ -38,6 +42,8 @@ func NewDeductFeeDecorator(
 	dk anteutils.DistributionKeeper,
 	fk authante.FeegrantKeeper,
 	sk anteutils.StakingKeeper,
+	fmk evmante.FeeMarketKeeper,
+	ek evmante.EVMKeeper,
 	tfc anteutils.TxFeeChecker,
 ) DeductFeeDecorator {
 	if tfc == nil {
@@ -50,6 +56,8 @@ func NewDeductFeeDecorator(
 		distributionKeeper: dk,
 		feegrantKeeper:     fk,
 		stakingKeeper:      sk,
+		feeMarketKeeper:    fmk,
+		evmKeeper:          ek,
 		txFeeChecker:       tfc,
 	}
 }
@@ -82,24 +90,27 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
-	if err = dfd.deductFee(ctx, tx, fee, feePayer, feeGranter); err != nil {
+	swapped, err := dfd.deductFee(ctx, feeTx, fee, feePayer, feeGranter)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	// the post handler refunds the fees paid for the unused gas out of the deducted fees
+	newCtx := anteutils.WithDeductedFees(ctx.WithPriority(priority), anteutils.DeductedFees{Fees: fee, Swapped: swapped})
 
 	return next(newCtx, tx, simulate)
 }
 
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
-// If the spendable balance is not enough, it tries to claim enough staking rewards to cover the fees.
-func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.Coins, feePayer, feeGranter sdk.AccAddress) error {
+// If the spendable balance is not enough, it tries to swap the whitelisted fee tokens or to claim
+// enough staking rewards to cover the fees. It returns the fees swapped from fee tokens.
+func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, feeTx sdk.FeeTx, fees sdk.Coins, feePayer, feeGranter sdk.AccAddress) (sdk.Coins, error) {
 	if fees.IsZero() {
-		return nil
+		return nil, nil
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+		return nil, fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
 	}
 
 	// by default, deduct fees from feePayer address
@@ -109,13 +120,13 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 	// this works only when feegrant is enabled.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
-			return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return nil, errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		}
 
 		if !feeGranter.Equals(feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fees, sdkTx.GetMsgs())
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fees, feeTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not not allow to pay fees for %s", feeGranter, feePayer)
+				return nil, errorsmod.Wrapf(err, "%s does not not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -124,12 +135,17 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return errortypes.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return nil, errortypes.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
+	// the whitelisted fee tokens chosen in the tx fee can be swapped to cover the fees
+	evmDenom := dfd.evmKeeper.GetParams(ctx).EvmDenom
+	feeTokens := dfd.feeMarketKeeper.GetParams(ctx).FeeTokensOf(feeTx.GetFee(), evmDenom)
+
 	// deduct the fees
-	if err := deductFeesFromBalanceOrUnclaimedStakingRewards(ctx, dfd, deductFeesFromAcc, fees); err != nil {
-		return fmt.Errorf("%q has insufficient funds and failed to claim sufficient staking rewards to pay for fees: %w", deductFeesFrom.String(), err)
+	swapped, err := deductFeesFromBalanceOrUnclaimedStakingRewards(ctx, dfd, deductFeesFromAcc, fees, feeTokens)
+	if err != nil {
+		return nil, fmt.Errorf("%q has insufficient funds and failed to claim sufficient staking rewards to pay for fees: %w", deductFeesFrom.String(), err)
 	}
 
 	events := sdk.Events{
@@ -141,21 +157,34 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return swapped, nil
 }
 
 // deductFeesFromBalanceOrUnclaimedStakingRewards tries to deduct the fees from the account balance.
-// If the account balance is not enough, it tries to claim enough staking rewards to cover the fees.
+// If the account balance is not enough, it tries to swap the given fee tokens or to claim enough
+// staking rewards to cover the fees. It returns the fees swapped from fee tokens.
 func deductFeesFromBalanceOrUnclaimedStakingRewards(
-	ctx sdk.Context, dfd DeductFeeDecorator, deductFeesFromAcc authtypes.AccountI, fees sdk.Coins,
-) error {
+	ctx sdk.Context, dfd DeductFeeDecorator, deductFeesFromAcc authtypes.AccountI, fees sdk.Coins, feeTokens []feemarkettypes.FeeToken,
+) (sdk.Coins, error) {
+	evmDenom := dfd.evmKeeper.GetParams(ctx).EvmDenom
+	swapped, err := anteutils.SwapFeeTokensIfNecessary(
+		ctx, dfd.bankKeeper, feeTokens, deductFeesFromAcc.GetAddress(), fees, evmDenom,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := anteutils.ClaimStakingRewardsIfNecessary(
 		ctx, dfd.bankKeeper, dfd.distributionKeeper, dfd.stakingKeeper, deductFeesFromAcc.GetAddress(), fees,
 	); err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees); err != nil {
+		return nil, err
 	}
 
-	return authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees)
+	return sdk.NewCoins(sdk.NewCoin(evmDenom, swapped)), nil
 }
 
 // checkTxFeeWithValidatorMinGasPrices implements the default fee logic, where the minimum price per
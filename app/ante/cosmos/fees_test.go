@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	cosmosante "github.com/evmos/evmos/v15/app/ante/cosmos"
+	anteutils "github.com/evmos/evmos/v15/app/ante/utils"
 	"github.com/evmos/evmos/v15/testutil"
 	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
 	"github.com/evmos/evmos/v15/utils"
@@ -269,7 +270,7 @@ func (suite *AnteTestSuite) TestDeductFeeDecorator() {
 
 				// remove the feegrant keeper from the decorator
 				dfd = cosmosante.NewDeductFeeDecorator(
-					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, nil, suite.app.StakingKeeper, nil,
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, nil, suite.app.StakingKeeper, suite.app.FeeMarketKeeper, suite.app.EvmKeeper, nil,
 				)
 			},
 		},
@@ -293,11 +294,17 @@ func (suite *AnteTestSuite) TestDeductFeeDecorator() {
 			suite.Require().NoError(err, "failed to create transaction")
 
 			// run the ante handler
-			_, err = dfd.AnteHandle(suite.ctx, tx, tc.simulate, testutil.NextFn)
+			ctx, err := dfd.AnteHandle(suite.ctx, tx, tc.simulate, testutil.NextFn)
 
 			// assert the resulting error
 			if tc.expPass {
 				suite.Require().NoError(err, "expected no error")
+
+				// the deducted fees are passed to the post handler
+				deducted, found := anteutils.GetDeductedFees(ctx)
+				suite.Require().True(found, "expected the deducted fees in the context")
+				suite.Require().True(tx.(sdk.FeeTx).GetFee().IsEqual(deducted.Fees), "unexpected deducted fees")
+				suite.Require().True(deducted.Swapped.IsZero(), "expected no swapped fees")
 			} else {
 				suite.Require().Error(err, "expected error")
 				suite.Require().ErrorContains(err, tc.errContains)
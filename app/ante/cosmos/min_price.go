@@ -33,7 +33,8 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feesKeeper.GetParams(ctx).MinGasPrice
+	feeMarketParams := mpd.feesKeeper.GetParams(ctx)
+	minGasPrice := feeMarketParams.MinGasPrice
 
 	// Short-circuit if min gas price is 0 or if simulating
 	if minGasPrice.IsZero() || simulate {
@@ -71,7 +72,9 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			requiredFees)
 	}
 
-	if !feeCoins.IsAnyGTE(requiredFees) {
+	// the fees paid in whitelisted fee tokens count towards the minimum global fee
+	// at their conversion rate to the EVM denomination
+	if feeMarketParams.FeesInEvmDenom(feeCoins, evmDenom).LT(requiredFees.AmountOf(evmDenom)) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the gas price.",
 			feeCoins,
@@ -18,6 +18,7 @@ import (
 	"github.com/evmos/evmos/v15/x/evm/keeper"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -25,15 +26,19 @@ import (
 
 // EthAccountVerificationDecorator validates an account balance checks
 type EthAccountVerificationDecorator struct {
-	ak        evmtypes.AccountKeeper
-	evmKeeper EVMKeeper
+	ak              evmtypes.AccountKeeper
+	bankKeeper      anteutils.BankKeeper
+	feeMarketKeeper FeeMarketKeeper
+	evmKeeper       EVMKeeper
 }
 
 // NewEthAccountVerificationDecorator creates a new EthAccountVerificationDecorator
-func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper) EthAccountVerificationDecorator {
+func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, bk anteutils.BankKeeper, fmk FeeMarketKeeper, ek EVMKeeper) EthAccountVerificationDecorator {
 	return EthAccountVerificationDecorator{
-		ak:        ak,
-		evmKeeper: ek,
+		ak:              ak,
+		bankKeeper:      bk,
+		feeMarketKeeper: fmk,
+		evmKeeper:       ek,
 	}
 }
 
@@ -42,7 +47,8 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper)
 // This AnteHandler decorator will fail if:
 // - any of the msgs is not a MsgEthereumTx
 // - from address is empty
-// - account balance is lower than the transaction cost
+// - account balance is lower than the transaction cost and the missing fees can neither be
+// covered by swapping whitelisted fee tokens nor by a sponsor
 func (avd EthAccountVerificationDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
@@ -83,19 +89,54 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
+		balance := sdkmath.NewIntFromBigInt(acct.Balance)
+		if err := keeper.CheckSenderBalance(balance, txData); err != nil &&
+			!avd.canSwapFeeTokens(ctx, tx, from, balance, txData) &&
+			!avd.isSponsored(ctx, from, balance, txData) {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
+// canSwapFeeTokens checks if the sender balance covers the value of the transaction and if
+// the missing part of the transaction cost can be covered by swapping the whitelisted fee tokens
+// the sender opted into, which happens when the fees are deducted.
+func (avd EthAccountVerificationDecorator) canSwapFeeTokens(ctx sdk.Context, tx sdk.Tx, from sdk.AccAddress, balance sdkmath.Int, txData evmtypes.TxData) bool {
+	feeTokens := optedInFeeTokens(ctx, avd.feeMarketKeeper, tx, avd.evmKeeper.GetParams(ctx).EvmDenom)
+	if len(feeTokens) == 0 || balance.IsNegative() {
+		return false
+	}
+
+	cost := txData.Cost()
+	if cost.Sign() < 0 || balance.BigInt().Cmp(txData.GetValue()) < 0 {
+		return false
+	}
+
+	missing := sdkmath.NewIntFromBigInt(cost).Sub(balance)
+	_, found := anteutils.FindFeeTokenSwap(ctx, avd.bankKeeper, feeTokens, from, missing)
+	return found
+}
+
+// isSponsored checks if the sender balance covers the value of the transaction and if a sponsor
+// pays for the fees of the transaction, which are then deducted from the sponsor balance.
+func (avd EthAccountVerificationDecorator) isSponsored(ctx sdk.Context, from sdk.AccAddress, balance sdkmath.Int, txData evmtypes.TxData) bool {
+	fee := txData.Fee()
+	if fee == nil || fee.Sign() < 0 || balance.BigInt().Cmp(txData.GetValue()) < 0 {
+		return false
+	}
+
+	_, found := avd.evmKeeper.GetFeeSponsor(ctx, from, txData.GetTo(), txData.GetData(), sdkmath.NewIntFromBigInt(fee))
+	return found
+}
+
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
 	bankKeeper         anteutils.BankKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	evmKeeper          EVMKeeper
+	feeMarketKeeper    FeeMarketKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
 }
@@ -105,6 +146,7 @@ func NewEthGasConsumeDecorator(
 	bankKeeper anteutils.BankKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	evmKeeper EVMKeeper,
+	feeMarketKeeper FeeMarketKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
@@ -112,15 +154,17 @@ func NewEthGasConsumeDecorator(
 		bankKeeper,
 		distributionKeeper,
 		evmKeeper,
+		feeMarketKeeper,
 		stakingKeeper,
 		maxGasWanted,
 	}
 }
 
 // AnteHandle validates that the Ethereum tx message has enough to cover intrinsic gas
-// (during CheckTx only) and that the sender has enough balance to pay for the gas cost.
-// If the balance is not sufficient, it will be attempted to withdraw enough staking rewards
-// for the payment.
+// (during CheckTx only) and that the fee payer has enough balance to pay for the gas cost.
+// The fee payer is the sponsor of the called contract if a registered sponsor policy covers the
+// transaction, or the sender otherwise. If the balance is not sufficient, it will be attempted to
+// swap whitelisted fee tokens or to withdraw enough staking rewards for the payment.
 //
 // Intrinsic gas for a transaction is the amount of gas that the transaction uses before the
 // transaction is executed. The gas is a constant value plus any cost incurred by additional bytes
@@ -130,7 +174,7 @@ func NewEthGasConsumeDecorator(
 // - the message is not a MsgEthereumTx
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
-// - user has neither enough balance nor staking rewards to deduct the transaction fees (gas_limit * gas_price)
+// - fee payer has neither enough balance, fee tokens nor staking rewards to deduct the transaction fees (gas_limit * gas_price)
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -162,6 +206,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
 	baseFee := egcd.evmKeeper.GetBaseFee(ctx, ethCfg)
+	feeTokens := optedInFeeTokens(ctx, egcd.feeMarketKeeper, tx, evmDenom)
 
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -191,10 +236,21 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		if err = egcd.deductFee(ctx, fees, from); err != nil {
+		feePayer, err := egcd.feePayer(ctx, from, txData, fees.AmountOf(evmDenom))
+		if err != nil {
+			return ctx, err
+		}
+
+		swapped, err := egcd.deductFee(ctx, fees, evmDenom, feeTokens, feePayer)
+		if err != nil {
 			return ctx, err
 		}
 
+		// the fees swapped from fee tokens are not refunded after the execution
+		if swapped.IsPositive() {
+			egcd.evmKeeper.SetFeeTokenSwapTransient(ctx, common.BytesToAddress(from), txData.GetNonce(), swapped)
+		}
+
 		events = append(events,
 			sdk.NewEvent(
 				sdk.EventTypeTx,
@@ -241,22 +297,60 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
+// feePayer returns the account paying for the fees of the transaction. If a sponsor policy covers
+// the transaction, the fees are charged against the budget of the sender and the sponsor is recorded
+// as the fee payer, so that the leftover gas is refunded to it after the execution.
+func (egcd EthGasConsumeDecorator) feePayer(ctx sdk.Context, from sdk.AccAddress, txData evmtypes.TxData, fees sdkmath.Int) (sdk.AccAddress, error) {
+	policy, found := egcd.evmKeeper.GetFeeSponsor(ctx, from, txData.GetTo(), txData.GetData(), fees)
+	if !found {
+		return from, nil
+	}
+
+	if err := egcd.evmKeeper.UseSponsorBudget(ctx, policy, from, fees); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to use the budget of sponsor %s", policy.Sponsor)
+	}
+
+	sponsor := policy.SponsorAddress()
+	egcd.evmKeeper.SetFeePayerTransient(ctx, common.BytesToAddress(from), txData.GetNonce(), sponsor)
+	return sponsor, nil
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
-// If the spendable balance is not enough, it tries to claim enough staking rewards to cover the fees.
-func (egcd EthGasConsumeDecorator) deductFee(ctx sdk.Context, fees sdk.Coins, feePayer sdk.AccAddress) error {
+// If the spendable balance is not enough, it tries to swap the given whitelisted fee tokens or to
+// claim enough staking rewards to cover the fees. It returns the amount of the fees swapped from
+// fee tokens.
+func (egcd EthGasConsumeDecorator) deductFee(ctx sdk.Context, fees sdk.Coins, evmDenom string, feeTokens []feemarkettypes.FeeToken, feePayer sdk.AccAddress) (sdkmath.Int, error) {
 	if fees.IsZero() {
-		return nil
+		return sdkmath.ZeroInt(), nil
+	}
+
+	// If the account balance is not sufficient, try to swap the whitelisted fee tokens
+	swapped, err := anteutils.SwapFeeTokensIfNecessary(ctx, egcd.bankKeeper, feeTokens, feePayer, fees, evmDenom)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
 	}
 
 	// If the account balance is not sufficient, try to withdraw enough staking rewards
 	if err := anteutils.ClaimStakingRewardsIfNecessary(ctx, egcd.bankKeeper, egcd.distributionKeeper, egcd.stakingKeeper, feePayer, fees); err != nil {
-		return err
+		return sdkmath.ZeroInt(), err
 	}
 
 	if err := egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(feePayer)); err != nil {
-		return errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+	}
+	return swapped, nil
+}
+
+// optedInFeeTokens returns the whitelisted fee tokens that the sender of the Ethereum transaction
+// agrees to swap to pay for the fees. As for Cosmos transactions, these are the fee tokens listed
+// in the fee of the transaction, next to the fees in the given EVM denomination.
+func optedInFeeTokens(ctx sdk.Context, fmk FeeMarketKeeper, tx sdk.Tx, evmDenom string) []feemarkettypes.FeeToken {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil
 	}
-	return nil
+
+	return fmk.GetParams(ctx).FeeTokensOf(feeTx.GetFee(), evmDenom)
 }
 
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
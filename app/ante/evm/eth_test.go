@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	ethante "github.com/evmos/evmos/v15/app/ante/evm"
 	"github.com/evmos/evmos/v15/server/config"
@@ -14,13 +15,14 @@ import (
 	"github.com/evmos/evmos/v15/utils"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	dec := ethante.NewEthAccountVerificationDecorator(
-		suite.app.AccountKeeper, suite.app.EvmKeeper,
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeMarketKeeper, suite.app.EvmKeeper,
 	)
 
 	addr := testutiltx.GenerateAddress()
@@ -180,7 +182,7 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	chainID := suite.app.EvmKeeper.ChainID()
-	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.FeeMarketKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -244,6 +246,42 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	dynamicFeeTx.From = addr.Hex()
 	dynamicFeeTxPriority := int64(1)
 
+	sponsor := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	contract := testutiltx.GenerateAddress()
+	sponsoredTxParams := &evmtypes.EvmTxArgs{
+		ChainID:  chainID,
+		Nonce:    1,
+		To:       &contract,
+		GasLimit: tx2GasLimit,
+		GasPrice: gasPrice,
+	}
+	sponsoredTx := evmtypes.NewTx(sponsoredTxParams)
+	sponsoredTx.From = addr.Hex()
+	sponsoredFees := sdk.NewIntFromBigInt(sponsoredTx.AsTransaction().Cost())
+
+	// the sender opts into swapping a whitelisted fee token by listing it in the fee of the tx
+	feeToken := feemarkettypes.NewFeeToken("uusdc", sdk.NewDec(1e9))
+	tx2Fees := sdk.NewIntFromBigInt(tx2.GetFee())
+	feeTokenMsg := evmtypes.NewTx(eth2TxContractParams)
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	_, err := feeTokenMsg.BuildTx(txBuilder, utils.BaseDenom)
+	suite.Require().NoError(err)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, tx2Fees), sdk.NewCoin(feeToken.Denom, feeToken.FromEvmDenom(tx2Fees))))
+	feeTokenMsg.From = addr.Hex()
+	feeTokenTx := txBuilder.GetTx()
+
+	setupFeeToken := func(ctx sdk.Context) sdk.Context {
+		params := suite.app.FeeMarketKeeper.GetParams(ctx)
+		params.FeeTokens = []feemarkettypes.FeeToken{feeToken}
+		suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(ctx, params))
+
+		err := testutil.FundAccount(ctx, suite.app.BankKeeper, addr.Bytes(), sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdk.NewInt(1e7))))
+		suite.Require().NoError(err)
+		err = testutil.FundModuleAccount(ctx, suite.app.BankKeeper, feemarkettypes.ModuleName, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e16))))
+		suite.Require().NoError(err)
+		return ctx
+	}
+
 	var vmdb *statedb.StateDB
 
 	initialBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addr.Bytes(), utils.BaseDenom)
@@ -429,6 +467,82 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 				)
 			},
 		},
+		{
+			"success - legacy tx - fees paid by the sponsor",
+			sponsoredTx,
+			tx2GasLimit,
+			func(ctx sdk.Context) sdk.Context {
+				err := testutil.FundAccount(ctx, suite.app.BankKeeper, sponsor, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e16))))
+				suite.Require().NoError(err)
+
+				policy := evmtypes.NewSponsorPolicy(sponsor, contract, nil, sdk.NewInt(1e16))
+				suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(ctx, policy))
+				return ctx
+			},
+			true, false,
+			tx2Priority,
+			func(ctx sdk.Context) {
+				finalBalance := suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), utils.BaseDenom)
+				suite.Require().Equal(initialBalance, finalBalance, "the sender balance should not change")
+
+				sponsorBalance := suite.app.BankKeeper.GetBalance(ctx, sponsor, utils.BaseDenom)
+				suite.Require().Equal(sdk.NewInt(1e16).Sub(sponsoredFees), sponsorBalance.Amount)
+
+				spent := suite.app.EvmKeeper.GetSponsorSpent(ctx, contract, sponsor, addr.Bytes())
+				suite.Require().Equal(sponsoredFees, spent)
+
+				feePayer := suite.app.EvmKeeper.GetFeePayerTransient(ctx, addr, sponsoredTxParams.Nonce)
+				suite.Require().Equal(sponsor, feePayer)
+			},
+		},
+		{
+			"not enough balance for fees - sponsor budget exceeded",
+			sponsoredTx,
+			math.MaxUint64,
+			func(ctx sdk.Context) sdk.Context {
+				err := testutil.FundAccount(ctx, suite.app.BankKeeper, sponsor, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e16))))
+				suite.Require().NoError(err)
+
+				policy := evmtypes.NewSponsorPolicy(sponsor, contract, nil, sponsoredFees.SubRaw(1))
+				suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(ctx, policy))
+				return ctx
+			},
+			false, false,
+			0,
+			func(ctx sdk.Context) {},
+		},
+		{
+			"not enough balance for fees - fee token not opted into",
+			tx2,
+			math.MaxUint64,
+			setupFeeToken,
+			false, false,
+			0,
+			func(ctx sdk.Context) {},
+		},
+		{
+			"success - legacy tx - fees swapped from the opted-in fee token",
+			feeTokenTx,
+			tx2GasLimit,
+			setupFeeToken,
+			true, false,
+			tx2Priority,
+			func(ctx sdk.Context) {
+				finalBalance := suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), utils.BaseDenom)
+				suite.Require().Equal(initialBalance, finalBalance, "the swapped fees should be deducted")
+
+				swap := feeToken.FromEvmDenom(tx2Fees)
+				feeTokenBalance := suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), feeToken.Denom)
+				suite.Require().Equal(sdk.NewInt(1e7).Sub(swap), feeTokenBalance.Amount)
+
+				feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+				collected := suite.app.BankKeeper.GetBalance(ctx, feeCollector, feeToken.Denom)
+				suite.Require().Equal(swap, collected.Amount, "the swapped fee tokens should be sent to the fee collector")
+
+				swapped := suite.app.EvmKeeper.GetFeeTokenSwapTransient(ctx, addr, eth2TxContractParams.Nonce)
+				suite.Require().Equal(tx2Fees, swapped)
+			},
+		},
 		{
 			"success - zero fees (no base fee)",
 			zeroFeeTx,
@@ -16,6 +16,7 @@ import (
 	"github.com/evmos/evmos/v15/encoding"
 	"github.com/evmos/evmos/v15/types"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 )
 
 var _ DynamicFeeEVMKeeper = MockEVMKeeper{}
@@ -40,6 +41,26 @@ func (m MockEVMKeeper) ChainID() *big.Int {
 	return big.NewInt(9000)
 }
 
+var _ FeeMarketKeeper = MockFeeMarketKeeper{}
+
+type MockFeeMarketKeeper struct {
+	FeeTokens []feemarkettypes.FeeToken
+}
+
+func (m MockFeeMarketKeeper) GetParams(_ sdk.Context) feemarkettypes.Params {
+	params := feemarkettypes.DefaultParams()
+	params.FeeTokens = m.FeeTokens
+	return params
+}
+
+func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, gasWanted uint64) (uint64, error) {
+	return gasWanted, nil
+}
+
+func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool {
+	return true
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -56,6 +77,9 @@ func TestSDKTxFeeChecker(t *testing.T) {
 	genesisCtx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 	checkTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
 	deliverTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	feeMarketKeeper := MockFeeMarketKeeper{
+		FeeTokens: []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uusdc", sdk.NewDec(10))},
+	}
 
 	testCases := []struct {
 		name        string
@@ -202,6 +226,38 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee paid with a whitelisted fee token",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(1))))
+				return txBuilder.GetTx()
+			},
+			"10aevmos",
+			0,
+			true,
+		},
+		{
+			"fail, dynamic fee paid with a fee token that is not whitelisted",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -229,7 +285,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			fees, priority, err := NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := NewDynamicFeeChecker(tc.keeper, feeMarketKeeper)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
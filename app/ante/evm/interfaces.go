@@ -5,6 +5,8 @@ package evm
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
@@ -28,6 +30,10 @@ type EVMKeeper interface { //nolint: revive
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetFeeSponsor(ctx sdk.Context, user sdk.AccAddress, to *common.Address, input []byte, fees sdkmath.Int) (evmtypes.SponsorPolicy, bool)
+	UseSponsorBudget(ctx sdk.Context, policy evmtypes.SponsorPolicy, user sdk.AccAddress, fees sdkmath.Int) error
+	SetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64, feePayer sdk.AccAddress)
+	SetFeeTokenSwapTransient(ctx sdk.Context, sender common.Address, nonce uint64, amount sdkmath.Int)
 }
 
 type FeeMarketKeeper interface {
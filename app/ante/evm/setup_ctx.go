@@ -181,7 +181,9 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		txFee = txFee.Add(sdk.Coin{Denom: evmDenom, Amount: sdkmath.NewIntFromBigInt(txData.Fee())})
 	}
 
-	if !authInfo.Fee.Amount.IsEqual(txFee) {
+	// besides the fees in the EVM denomination, the fee can list the whitelisted fee tokens that
+	// the sender agrees to swap to pay for the fees
+	if !authInfo.Fee.Amount.AmountOf(evmDenom).Equal(txFee.AmountOf(evmDenom)) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee Amount (%s != %s)", authInfo.Fee.Amount, txFee)
 	}
 
@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// deductedFeesKey is the context key under which the fees deducted by the ante handler are stored.
+type deductedFeesKey struct{}
+
+// DeductedFees defines the fees deducted by the ante handler for a Cosmos transaction. They are
+// passed through the context to the post handler, which refunds the fees paid for the unused gas.
+type DeductedFees struct {
+	// Fees are the fees deducted from the fee payer, or from the fee granter if set
+	Fees sdk.Coins
+	// Swapped are the fees swapped from whitelisted fee tokens into the EVM denomination,
+	// which are not refunded
+	Swapped sdk.Coins
+}
+
+// WithDeductedFees returns a copy of the context that holds the given deducted fees.
+func WithDeductedFees(ctx sdk.Context, deducted DeductedFees) sdk.Context {
+	return ctx.WithValue(deductedFeesKey{}, deducted)
+}
+
+// GetDeductedFees returns the fees deducted by the ante handler from the context.
+func GetDeductedFees(ctx sdk.Context) (DeductedFees, bool) {
+	deducted, ok := ctx.Value(deductedFeesKey{}).(DeductedFees)
+	return deducted, ok
+}
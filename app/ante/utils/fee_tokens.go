@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package utils
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+// SwapFeeTokensIfNecessary checks if the given address has enough balance to cover the
+// given amount in the fee denomination. If not, it swaps the missing amount out of the
+// first of the given fee tokens that the address holds enough of, at the conversion rate
+// set by governance. The fee tokens are sent to the fee collector as part of the fees, while
+// the fee market module account pays out the missing amount from its reserve of the fee
+// denomination. It returns the amount of the fee denomination paid out by the reserve.
+//
+// If none of the fee tokens can cover the missing amount, the balance is left untouched so
+// that the remaining fee deduction logic can still claim staking rewards or fail.
+func SwapFeeTokensIfNecessary(
+	ctx sdk.Context,
+	bankKeeper BankKeeper,
+	feeTokens []feemarkettypes.FeeToken,
+	addr sdk.AccAddress,
+	amount sdk.Coins,
+	feeDenom string,
+) (sdkmath.Int, error) {
+	if len(feeTokens) == 0 {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	found, fee := amount.Find(feeDenom)
+	if !found {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	balance := bankKeeper.GetBalance(ctx, addr, feeDenom)
+	if balance.IsGTE(fee) {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	difference := fee.Sub(balance)
+	swap, found := FindFeeTokenSwap(ctx, bankKeeper, feeTokens, addr, difference.Amount)
+	if !found {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	reserve := bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(feemarkettypes.ModuleName), feeDenom)
+	if reserve.IsLT(difference) {
+		return sdkmath.ZeroInt(), errortypes.ErrInsufficientFunds.Wrapf(
+			"fee token reserve cannot swap %s into %s; reserve: %s", swap, difference, reserve,
+		)
+	}
+
+	if err := bankKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, sdk.Coins{swap}); err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "failed to pay fee tokens %s", swap)
+	}
+
+	if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.ModuleName, addr, sdk.Coins{difference}); err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(err, "failed to pay out %s from the fee token reserve", difference)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feemarkettypes.EventTypeFeeTokenSwap,
+			sdk.NewAttribute(feemarkettypes.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyAmountIn, swap.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyAmountOut, difference.String()),
+		),
+	)
+
+	return difference.Amount, nil
+}
+
+// FindFeeTokenSwap returns the coin that the given address has to swap to cover the given
+// amount in the fee denomination. It is taken from the first of the given fee tokens whose
+// balance is sufficient.
+func FindFeeTokenSwap(
+	ctx sdk.Context,
+	bankKeeper BankKeeper,
+	feeTokens []feemarkettypes.FeeToken,
+	addr sdk.AccAddress,
+	amount sdkmath.Int,
+) (sdk.Coin, bool) {
+	for _, feeToken := range feeTokens {
+		swap := sdk.Coin{Denom: feeToken.Denom, Amount: feeToken.FromEvmDenom(amount)}
+		if bankKeeper.GetBalance(ctx, addr, feeToken.Denom).IsGTE(swap) {
+			return swap, true
+		}
+	}
+
+	return sdk.Coin{}, false
+}
@@ -0,0 +1,136 @@
+package utils_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	anteutils "github.com/evmos/evmos/v15/app/ante/utils"
+	"github.com/evmos/evmos/v15/testutil"
+	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/utils"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+// TestSwapFeeTokensIfNecessary tests the SwapFeeTokensIfNecessary function
+func (suite *AnteTestSuite) TestSwapFeeTokensIfNecessary() {
+	feeTokens := []feemarkettypes.FeeToken{
+		feemarkettypes.NewFeeToken("uatom", sdk.NewDec(100)),
+		feemarkettypes.NewFeeToken("uusdc", sdk.NewDec(1000)),
+	}
+	reserve := authtypes.NewModuleAddress(feemarkettypes.ModuleName)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 10500))
+
+	testcases := []struct {
+		// testcase name
+		name string
+		// malleate sets up the test case specific state, i.e. the balances of the account and the reserve
+		malleate func(addr sdk.AccAddress)
+		// feeTokens are the fee tokens that can be swapped
+		feeTokens []feemarkettypes.FeeToken
+		// expSwapped is the amount of the fee denomination expected to be paid out by the reserve
+		expSwapped int64
+		// expErr defines whether the test case is expected to return an error
+		expErr bool
+		// expErrContains defines the error message that is expected to be returned
+		errContains string
+		// postCheck contains assertions that check the state after the test case has been executed
+		postCheck func(addr sdk.AccAddress)
+	}{
+		{
+			name: "pass - sufficient balance, nothing is swapped",
+			malleate: func(addr sdk.AccAddress) {
+				suite.fund(addr, sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 10500), sdk.NewInt64Coin("uusdc", 100)))
+			},
+			feeTokens: feeTokens,
+			postCheck: func(addr sdk.AccAddress) {
+				suite.requireBalance(addr, "uusdc", 100)
+			},
+		},
+		{
+			name: "pass - no fee tokens, nothing is swapped",
+			malleate: func(addr sdk.AccAddress) {
+				suite.fund(addr, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)))
+			},
+			postCheck: func(addr sdk.AccAddress) {
+				suite.requireBalance(addr, "uusdc", 100)
+				suite.requireBalance(addr, utils.BaseDenom, 0)
+			},
+		},
+		{
+			name: "pass - the missing amount is swapped from the first sufficient fee token, rounding up",
+			malleate: func(addr sdk.AccAddress) {
+				suite.fund(addr, sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uusdc", 100)))
+				suite.fundReserve(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1e18)))
+			},
+			feeTokens:  feeTokens,
+			expSwapped: 10000,
+			postCheck: func(addr sdk.AccAddress) {
+				// 10000aevmos are missing, uatom balance only covers 5000aevmos
+				suite.requireBalance(addr, "uatom", 50)
+				suite.requireBalance(addr, "uusdc", 90)
+				suite.requireBalance(addr, utils.BaseDenom, 10500)
+				suite.requireBalance(feeCollector, "uusdc", 10)
+				suite.requireBalance(reserve, "uusdc", 0)
+				suite.requireBalance(reserve, utils.BaseDenom, 1e18-10000)
+			},
+		},
+		{
+			name: "pass - insufficient fee tokens, nothing is swapped",
+			malleate: func(addr sdk.AccAddress) {
+				suite.fund(addr, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 5)))
+				suite.fundReserve(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1e18)))
+			},
+			feeTokens: feeTokens,
+			postCheck: func(addr sdk.AccAddress) {
+				suite.requireBalance(addr, "uusdc", 5)
+				suite.requireBalance(addr, utils.BaseDenom, 0)
+			},
+		},
+		{
+			name: "fail - insufficient reserve",
+			malleate: func(addr sdk.AccAddress) {
+				suite.fund(addr, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)))
+				suite.fundReserve(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100)))
+			},
+			feeTokens:   feeTokens,
+			expErr:      true,
+			errContains: "fee token reserve cannot swap 11uusdc into 10500aevmos",
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			addr, _ := testutiltx.NewAccAddressAndKey()
+			tc.malleate(addr)
+
+			swapped, err := anteutils.SwapFeeTokensIfNecessary(suite.ctx, suite.app.BankKeeper, tc.feeTokens, addr, fees, utils.BaseDenom)
+
+			if tc.expErr {
+				suite.Require().ErrorContains(err, tc.errContains)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(tc.expSwapped).String(), swapped.String(), "unexpected swapped amount")
+				tc.postCheck(addr)
+			}
+		})
+	}
+}
+
+// fund funds the given address with the given coins.
+func (suite *AnteTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, coins)
+	suite.Require().NoError(err, "failed to fund account")
+}
+
+// fundReserve funds the fee token reserve with the given coins.
+func (suite *AnteTestSuite) fundReserve(coins sdk.Coins) {
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, feemarkettypes.ModuleName, coins)
+	suite.Require().NoError(err, "failed to fund the fee token reserve")
+}
+
+// requireBalance checks the balance of the given address in the given denomination.
+func (suite *AnteTestSuite) requireBalance(addr sdk.AccAddress, denom string, amount int64) {
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, denom)
+	suite.Require().Equal(sdk.NewInt(amount).String(), balance.Amount.String(), "unexpected %s balance of %s", denom, addr)
+}
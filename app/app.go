@@ -49,7 +49,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -124,6 +123,7 @@ import (
 
 	"github.com/evmos/evmos/v15/app/ante"
 	ethante "github.com/evmos/evmos/v15/app/ante/evm"
+	"github.com/evmos/evmos/v15/app/post"
 	v10 "github.com/evmos/evmos/v15/app/upgrades/v10"
 	v11 "github.com/evmos/evmos/v15/app/upgrades/v11"
 	v12 "github.com/evmos/evmos/v15/app/upgrades/v12"
@@ -267,11 +267,13 @@ var (
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		claimstypes.ModuleName:         nil,
 		incentivestypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		feemarkettypes.ModuleName:      nil, // holds the reserve used to swap the whitelisted fee tokens
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
 		incentivestypes.ModuleName: true,
+		feemarkettypes.ModuleName:  true,
 	}
 )
 
@@ -454,7 +456,10 @@ func NewEvmos(
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper)
 
-	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
+	tracer, err := NewEVMTracerConfig(appOpts, homePath)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error on EVM tracer setup"))
+	}
 
 	// Create Ethermint keepers
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
@@ -550,6 +555,14 @@ func NewEvmos(
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
+		evmKeeper,       // Add EVM Keeper for the ICS20 precompile packet callbacks
+	)
+
+	app.GovKeeper = *govKeeper.SetHooks(
+		govtypes.NewMultiGovHooks(
+			app.ClaimsKeeper.Hooks(),
+			app.VestingKeeper.Hooks(),
+		),
 	)
 
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
@@ -557,11 +570,17 @@ func NewEvmos(
 		evmkeeper.AvailablePrecompiles(
 			*stakingKeeper,
 			app.DistrKeeper,
+			app.BankKeeper,
 			app.Erc20Keeper,
 			app.VestingKeeper,
+			app.GovKeeper,
+			app.SlashingKeeper,
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IBCKeeper.ConnectionKeeper,
+			app.IBCKeeper.ClientKeeper,
+			appCodec,
 		),
 	)
 
@@ -574,13 +593,6 @@ func NewEvmos(
 		),
 	)
 
-	app.GovKeeper = *govKeeper.SetHooks(
-		govtypes.NewMultiGovHooks(
-			app.ClaimsKeeper.Hooks(),
-			app.VestingKeeper.Hooks(),
-		),
-	)
-
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.Hooks(),
@@ -933,7 +945,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper, app.FeeMarketKeeper),
 	}
 
 	if err := options.Validate(); err != nil {
@@ -944,14 +956,16 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 }
 
 func (app *Evmos) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
+	options := post.HandlerOptions{
+		BankKeeper:     app.BankKeeper,
+		FeegrantKeeper: app.FeeGrantKeeper,
+	}
+
+	if err := options.Validate(); err != nil {
 		panic(err)
 	}
 
-	app.SetPostHandler(postHandler)
+	app.SetPostHandler(post.NewPostHandler(options))
 }
 
 // BeginBlocker runs the Tendermint ABCI BeginBlock logic. It executes state changes at the beginning
@@ -1327,6 +1341,8 @@ func (app *Evmos) setupUpgradeHandlers() {
 		v16.CreateUpgradeHandler(
 			app.mm, app.configurator,
 			app.EvmKeeper,
+			app.Erc20Keeper,
+			app.FeeMarketKeeper,
 		),
 	)
 
@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+/*
+Package post defines the PostHandler that runs after the messages of a
+transaction are successfully executed.
+
+It refunds the fees paid for the unused gas of Cosmos transactions, mirroring
+the refund of the leftover gas that the EVM performs for Ethereum transactions.
+*/
+package post
@@ -0,0 +1,36 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HandlerOptions defines the list of module keepers required to run the Evmos
+// PostHandler decorators.
+type HandlerOptions struct {
+	BankKeeper     BankKeeper
+	FeegrantKeeper FeegrantKeeper
+}
+
+// Validate checks if the keepers are defined
+func (options HandlerOptions) Validate() error {
+	if options.BankKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "bank keeper is required for PostHandler")
+	}
+	if options.FeegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "feegrant keeper is required for PostHandler")
+	}
+	return nil
+}
+
+// NewPostHandler returns the PostHandler chain that runs after the messages of
+// both Cosmos and Ethereum transactions.
+func NewPostHandler(options HandlerOptions) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewRefundDecorator(options.BankKeeper, options.FeegrantKeeper),
+	)
+}
@@ -0,0 +1,170 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	anteutils "github.com/evmos/evmos/v15/app/ante/utils"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// RefundDecorator refunds the fees paid for the unused gas of Cosmos transactions, i.e.
+// (gasWanted - gasUsed) * effectiveGasPrice, to the account that paid the fees.
+// Ethereum transactions are skipped, as the EVM already refunds their leftover gas.
+type RefundDecorator struct {
+	bankKeeper     BankKeeper
+	feegrantKeeper FeegrantKeeper
+}
+
+// NewRefundDecorator creates a new RefundDecorator
+func NewRefundDecorator(bk BankKeeper, fk FeegrantKeeper) RefundDecorator {
+	return RefundDecorator{
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
+	}
+}
+
+// PostHandle refunds the leftover gas of the transaction out of the fees deducted by the ante
+// handler, which are passed through the context. When the fees were paid with a fee grant, the
+// refund goes to the fee granter and the refunded fees are given back to the allowance.
+func (rd RefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the messages are not executed during CheckTx, so the gas used only accounts for the
+	// ante handler and the refund would overestimate the balance of the fee payer
+	if ctx.IsCheckTx() && !simulate {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return next(ctx, tx, simulate, success)
+		}
+	}
+
+	deducted, found := anteutils.GetDeductedFees(ctx)
+	if !found || deducted.Fees.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	gasWanted := feeTx.GetGas()
+	gasUsed := ctx.GasMeter().GasConsumedToLimit()
+	if gasWanted == 0 || gasUsed >= gasWanted {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the refund doesn't consume gas, as the leftover gas could not cover the transfer
+	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// refund the fee payer or the fee granter if the fees were paid with a fee grant
+	feePayer := feeTx.FeePayer()
+	refunded := feePayer
+	feeGranter := feeTx.FeeGranter()
+	if feeGranter != nil {
+		refunded = feeGranter
+	}
+
+	refundedCoins, err := rd.RefundGas(infCtx, refunded, deducted, gasWanted, gasWanted-gasUsed)
+	if err != nil {
+		return ctx, err
+	}
+
+	if feeGranter != nil && !feeGranter.Equals(feePayer) {
+		if err := rd.restoreAllowance(infCtx, feeGranter, feePayer, refundedCoins); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// RefundGas transfers the fees paid for the leftover gas to the fee payer of the transaction,
+// exchanged at the effective gas price, i.e. fees / gasWanted. The fees swapped from whitelisted
+// fee tokens are not refunded, so that the refund of a denomination never exceeds the fees paid
+// by the fee payer in that denomination. It returns the refunded coins.
+func (rd RefundDecorator) RefundGas(ctx sdk.Context, feePayer sdk.AccAddress, deducted anteutils.DeductedFees, gasWanted, leftoverGas uint64) (sdk.Coins, error) {
+	refundedCoins := sdk.Coins{}
+	for _, fee := range deducted.Fees {
+		amount := fee.Amount.Mul(sdkmath.NewIntFromUint64(leftoverGas)).Quo(sdkmath.NewIntFromUint64(gasWanted))
+		refundable := sdkmath.MaxInt(fee.Amount.Sub(deducted.Swapped.AmountOf(fee.Denom)), sdkmath.ZeroInt())
+		refundedCoins = refundedCoins.Add(sdk.NewCoin(fee.Denom, sdkmath.MinInt(amount, refundable)))
+	}
+
+	// no refund
+	if refundedCoins.IsZero() {
+		return refundedCoins, nil
+	}
+
+	// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+	err := rd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer, refundedCoins)
+	if err != nil {
+		err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+		return nil, errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+	}
+
+	return refundedCoins, nil
+}
+
+// restoreAllowance gives the refunded fees back to the allowance that the fee granter granted to
+// the grantee. An allowance that was fully spent by the transaction has been removed from the
+// store, in which case only the balance of the fee granter is refunded.
+func (rd RefundDecorator) restoreAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, refunded sdk.Coins) error {
+	if refunded.IsZero() {
+		return nil
+	}
+
+	allowance, err := rd.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil || allowance == nil {
+		return nil
+	}
+
+	restored, err := addToAllowance(allowance, refunded)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to restore the allowance of %s to %s", granter, grantee)
+	}
+
+	return rd.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, restored)
+}
+
+// addToAllowance adds the given coins to the amounts that can still be spent with the allowance.
+// An empty spend limit means that the allowance is unlimited, so it is left untouched.
+func addToAllowance(allowance feegrant.FeeAllowanceI, coins sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if !a.SpendLimit.Empty() {
+			a.SpendLimit = a.SpendLimit.Add(coins...)
+		}
+	case *feegrant.PeriodicAllowance:
+		if !a.Basic.SpendLimit.Empty() {
+			a.Basic.SpendLimit = a.Basic.SpendLimit.Add(coins...)
+		}
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(coins...)
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+
+		restored, err := addToAllowance(inner, coins)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := a.SetAllowance(restored); err != nil {
+			return nil, err
+		}
+	}
+
+	return allowance, nil
+}
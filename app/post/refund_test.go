@@ -0,0 +1,237 @@
+package post_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	anteutils "github.com/evmos/evmos/v15/app/ante/utils"
+	"github.com/evmos/evmos/v15/app/post"
+	"github.com/evmos/evmos/v15/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func (suite *PostTestSuite) TestRefundDecorator() {
+	var (
+		sender     sdk.AccAddress
+		feeGranter sdk.AccAddress
+		deducted   *anteutils.DeductedFees
+	)
+
+	// the base fee of the fee market is used as gas price
+	gasPrice := sdkmath.NewInt(1000000000)
+	fees := gasPrice.MulRaw(int64(TestGasLimit))
+	spendLimit := gasPrice.MulRaw(50000)
+
+	testCases := []struct {
+		name        string
+		malleate    func() sdk.Tx
+		gasUsed     uint64
+		checkTx     bool
+		simulate    bool
+		expRefunded sdkmath.Int
+		expPass     bool
+		postCheck   func()
+	}{
+		{
+			"pass - refund the unused gas of a Cosmos tx",
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			40000,
+			false,
+			false,
+			gasPrice.MulRaw(60000),
+			true,
+			func() {},
+		},
+		{
+			"pass - refund the fee granter and restore the allowance",
+			func() sdk.Tx {
+				feeGranter = utiltx.GenerateAddress().Bytes()
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, feeGranter, sender, &feegrant.BasicAllowance{
+					SpendLimit: sdk.Coins{{Denom: suite.denom, Amount: spendLimit}},
+				})
+				suite.Require().NoError(err)
+				return suite.CreateTestCosmosTx(sender, gasPrice, feeGranter)
+			},
+			40000,
+			false,
+			false,
+			gasPrice.MulRaw(60000),
+			true,
+			func() {
+				allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, feeGranter, sender)
+				suite.Require().NoError(err)
+				basic, ok := allowance.(*feegrant.BasicAllowance)
+				suite.Require().True(ok)
+				suite.Require().Equal(spendLimit.Add(gasPrice.MulRaw(60000)), basic.SpendLimit.AmountOf(suite.denom))
+			},
+		},
+		{
+			"pass - refund the fee granter of a fully spent allowance",
+			func() sdk.Tx {
+				feeGranter = utiltx.GenerateAddress().Bytes()
+				return suite.CreateTestCosmosTx(sender, gasPrice, feeGranter)
+			},
+			40000,
+			false,
+			false,
+			gasPrice.MulRaw(60000),
+			true,
+			func() {
+				_, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, feeGranter, sender)
+				suite.Require().Error(err, "the allowance should not be created again")
+			},
+		},
+		{
+			"pass - refund the fees of the tx when simulating",
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			25000,
+			false,
+			true,
+			gasPrice.MulRaw(75000),
+			true,
+			func() {},
+		},
+		{
+			"pass - the fees swapped from fee tokens are not refunded",
+			func() sdk.Tx {
+				deducted.Swapped = sdk.Coins{{Denom: suite.denom, Amount: gasPrice.MulRaw(70000)}}
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			40000,
+			false,
+			false,
+			gasPrice.MulRaw(30000),
+			true,
+			func() {},
+		},
+		{
+			"pass - no refund without the fees deducted by the ante handler",
+			func() sdk.Tx {
+				deducted = nil
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			40000,
+			false,
+			false,
+			sdkmath.ZeroInt(),
+			true,
+			func() {},
+		},
+		{
+			"pass - no refund if all the gas is used",
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			TestGasLimit,
+			false,
+			false,
+			sdkmath.ZeroInt(),
+			true,
+			func() {},
+		},
+		{
+			"pass - no refund during CheckTx",
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			40000,
+			true,
+			false,
+			sdkmath.ZeroInt(),
+			true,
+			func() {},
+		},
+		{
+			"pass - no refund for Ethereum txs",
+			func() sdk.Tx {
+				msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+					ChainID:  suite.app.EvmKeeper.ChainID(),
+					GasLimit: TestGasLimit,
+					GasPrice: gasPrice.BigInt(),
+					Amount:   big.NewInt(0),
+				})
+				msg.From = utiltx.GenerateAddress().Hex()
+
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				err := txBuilder.SetMsgs(msg)
+				suite.Require().NoError(err)
+				return txBuilder.GetTx()
+			},
+			40000,
+			false,
+			false,
+			sdkmath.ZeroInt(),
+			true,
+			func() {},
+		},
+		{
+			"fail - fee collector without funds",
+			func() sdk.Tx {
+				feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.denom)
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(
+					suite.ctx, authtypes.FeeCollectorName, utiltx.GenerateAddress().Bytes(), sdk.Coins{balance},
+				)
+				suite.Require().NoError(err)
+				return suite.CreateTestCosmosTx(sender, gasPrice, nil)
+			},
+			40000,
+			false,
+			false,
+			sdkmath.ZeroInt(),
+			false,
+			func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender = utiltx.GenerateAddress().Bytes()
+			feeGranter = nil
+
+			// fees deducted by the ante handler
+			deducted = &anteutils.DeductedFees{Fees: sdk.Coins{{Denom: suite.denom, Amount: fees}}}
+			err := testutil.FundModuleAccount(
+				suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, deducted.Fees,
+			)
+			suite.Require().NoError(err)
+
+			tx := tc.malleate()
+
+			refunded := sender
+			if feeGranter != nil {
+				refunded = feeGranter
+			}
+
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx).WithGasMeter(sdk.NewGasMeter(TestGasLimit))
+			if deducted != nil {
+				ctx = anteutils.WithDeductedFees(ctx, *deducted)
+			}
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "messages execution")
+
+			dec := post.NewRefundDecorator(suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+			_, err = dec.PostHandle(ctx, tx, tc.simulate, true, nextPostHandler)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, refunded, suite.denom)
+				suite.Require().Equal(tc.expRefunded, balance.Amount)
+				// the refund doesn't consume gas
+				suite.Require().Equal(tc.gasUsed, ctx.GasMeter().GasConsumed())
+				tc.postCheck()
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
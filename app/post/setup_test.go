@@ -0,0 +1,68 @@
+package post_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/evmos/v15/app"
+	"github.com/evmos/evmos/v15/encoding"
+	"github.com/evmos/evmos/v15/utils"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+type PostTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.Evmos
+	clientCtx client.Context
+	denom     string
+}
+
+const TestGasLimit uint64 = 100000
+
+var chainID = utils.TestnetChainID + "-1"
+
+func (suite *PostTestSuite) SetupTest() {
+	suite.app = app.Setup(false, feemarkettypes.DefaultGenesisState(), chainID)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 2, ChainID: chainID, Time: time.Now().UTC()})
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	suite.clientCtx = client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+}
+
+func TestPostTestSuite(t *testing.T) {
+	suite.Run(t, new(PostTestSuite))
+}
+
+// CreateTestCosmosTx creates a bank send transaction from the given address, paying
+// gasPrice * TestGasLimit fees.
+func (suite *PostTestSuite) CreateTestCosmosTx(from sdk.AccAddress, gasPrice sdkmath.Int, feeGranter sdk.AccAddress) sdk.Tx {
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+
+	txBuilder.SetGasLimit(TestGasLimit)
+	txBuilder.SetFeeAmount(sdk.Coins{{Denom: suite.denom, Amount: gasPrice.MulRaw(int64(TestGasLimit))}})
+	txBuilder.SetFeeGranter(feeGranter)
+	err := txBuilder.SetMsgs(&banktypes.MsgSend{
+		FromAddress: from.String(),
+		ToAddress:   from.String(),
+		Amount:      sdk.Coins{{Denom: suite.denom, Amount: sdkmath.NewInt(10)}},
+	})
+	suite.Require().NoError(err)
+
+	return txBuilder.GetTx()
+}
+
+// nextPostHandler is the last post handler of the chain
+func nextPostHandler(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
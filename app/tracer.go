@@ -0,0 +1,73 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/spf13/cast"
+
+	srvflags "github.com/evmos/evmos/v15/server/flags"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// jsonlTraceFile is the name of the JSON lines trace file in the tracer directory.
+const jsonlTraceFile = "traces.jsonl"
+
+// NewEVMTracerConfig returns the configuration of the tracer used by the EVM
+// when the node is run in trace mode. The trace files are stored in the tracer
+// directory, relative to the node home if it isn't an absolute path.
+func NewEVMTracerConfig(appOpts servertypes.AppOptions, homePath string) (evmtypes.TracerConfig, error) {
+	tracerCfg := evmtypes.TracerConfig{
+		Tracer: cast.ToString(appOpts.Get(srvflags.EVMTracer)),
+		LogConfig: logger.Config{
+			EnableMemory:     cast.ToBool(appOpts.Get(srvflags.EVMTracerEnableMemory)),
+			DisableStack:     cast.ToBool(appOpts.Get(srvflags.EVMTracerDisableStack)),
+			DisableStorage:   cast.ToBool(appOpts.Get(srvflags.EVMTracerDisableStorage)),
+			EnableReturnData: cast.ToBool(appOpts.Get(srvflags.EVMTracerEnableReturnData)),
+		},
+	}
+
+	for _, addr := range cast.ToStringSlice(appOpts.Get(srvflags.EVMTracerSenders)) {
+		if !common.IsHexAddress(addr) {
+			return evmtypes.TracerConfig{}, fmt.Errorf("invalid tracer sender address %s", addr)
+		}
+		tracerCfg.Senders = append(tracerCfg.Senders, common.HexToAddress(addr))
+	}
+	for _, addr := range cast.ToStringSlice(appOpts.Get(srvflags.EVMTracerContracts)) {
+		if !common.IsHexAddress(addr) {
+			return evmtypes.TracerConfig{}, fmt.Errorf("invalid tracer contract address %s", addr)
+		}
+		tracerCfg.Contracts = append(tracerCfg.Contracts, common.HexToAddress(addr))
+	}
+
+	output := cast.ToString(appOpts.Get(srvflags.EVMTracerOutput))
+	if tracerCfg.Tracer == "" || output == evmtypes.TraceOutputStd {
+		return tracerCfg, nil
+	}
+
+	dir := cast.ToString(appOpts.Get(srvflags.EVMTracerDir))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(homePath, dir)
+	}
+
+	var err error
+	switch output {
+	case evmtypes.TraceOutputFile:
+		tracerCfg.Sink, err = evmtypes.NewFileTraceSink(dir)
+	case evmtypes.TraceOutputJSONL:
+		maxSize := cast.ToInt64(appOpts.Get(srvflags.EVMTracerMaxFileSize))
+		tracerCfg.Sink, err = evmtypes.NewJSONLTraceSink(filepath.Join(dir, jsonlTraceFile), maxSize)
+	default:
+		err = fmt.Errorf("invalid tracer output %s", output)
+	}
+	if err != nil {
+		return evmtypes.TracerConfig{}, err
+	}
+	return tracerCfg, nil
+}
@@ -89,7 +89,7 @@ func (s *UpgradesTestSuite) TestUpdateMigrateNativeMultisigs() {
 	}
 
 	// delegation to validator 0 with zero tokens being returned because of the slashing
-	_, err := CreateDelegationWithZeroTokens(
+	_, zeroTokensFees, err := CreateDelegationWithZeroTokens(
 		s.ctx,
 		s.app,
 		oldStrategicReserves[0].PrivKey,
@@ -100,7 +100,7 @@ func (s *UpgradesTestSuite) TestUpdateMigrateNativeMultisigs() {
 	s.Require().NoError(err, "failed to create delegation with zero tokens")
 
 	// delegation to validator 1
-	_, err = Delegate(
+	_, delegateFees, err := Delegate(
 		s.ctx,
 		s.app,
 		oldStrategicReserves[0].PrivKey,
@@ -115,8 +115,8 @@ func (s *UpgradesTestSuite) TestUpdateMigrateNativeMultisigs() {
 	// because the expected post-migration balance does not include the delegated amount.
 	err = testutil.FundAccountWithBaseDenom(s.ctx, s.app.BankKeeper, oldStrategicReserves[0].Addr, 2*delegateAmount)
 	s.Require().NoError(err, "failed to fund account %s to even out delegated amount", oldStrategicReserves[0].Addr.String())
-	// Additionally, we need to send twice the default fee that is charged for the delegation transaction.
-	feeAmt := testutiltx.DefaultFee.Amount.MulRaw(2)
+	// Additionally, we need to send the fees that are charged for the delegation transactions.
+	feeAmt := zeroTokensFees.Amount.Add(delegateFees.Amount)
 	err = testutil.FundAccountWithBaseDenom(s.ctx, s.app.BankKeeper, oldStrategicReserves[0].Addr, feeAmt.Int64())
 	s.Require().NoError(err, "failed to fund account %s to account for delegation fees", oldStrategicReserves[0].Addr.String())
 
@@ -190,7 +190,7 @@ func (s *UpgradesTestSuite) TestCreateDelegationWithZeroTokens() {
 
 	s.NextBlock()
 
-	delegation, err := CreateDelegationWithZeroTokens(s.ctx, s.app, priv, addr, targetValidator, 1)
+	delegation, _, err := CreateDelegationWithZeroTokens(s.ctx, s.app, priv, addr, targetValidator, 1)
 	s.Require().NoError(err, "failed to create delegation with zero tokens")
 	s.Require().NotEqual(sdk.ZeroDec(), delegation.Shares, "delegation shares should not be zero")
 
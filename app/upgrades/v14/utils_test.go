@@ -274,24 +274,25 @@ func CreateDelegationWithZeroTokens(
 	delegator sdk.AccAddress,
 	validator stakingtypes.Validator,
 	amount int64,
-) (stakingtypes.Delegation, error) {
-	delegation, err := Delegate(ctx, app, priv, delegator, validator, amount)
+) (stakingtypes.Delegation, sdk.Coin, error) {
+	delegation, fees, err := Delegate(ctx, app, priv, delegator, validator, amount)
 	if err != nil {
-		return stakingtypes.Delegation{}, err
+		return stakingtypes.Delegation{}, sdk.Coin{}, err
 	}
 
 	consAddr, err := validator.GetConsAddr()
 	if err != nil {
-		return stakingtypes.Delegation{}, fmt.Errorf("failed to get validator consensus address: %w", err)
+		return stakingtypes.Delegation{}, sdk.Coin{}, fmt.Errorf("failed to get validator consensus address: %w", err)
 	}
 
 	// Slash the validator
 	app.SlashingKeeper.Slash(s.ctx, consAddr, sdk.NewDecWithPrec(5, 2), 1, 0)
 
-	return delegation, nil
+	return delegation, fees, nil
 }
 
 // Delegate is a helper function to delegation one atto-Evmos to a validator.
+// It returns the delegation and the fees paid for the delegation transaction.
 func Delegate(
 	ctx sdk.Context,
 	app *evmosapp.Evmos,
@@ -299,19 +300,19 @@ func Delegate(
 	delegator sdk.AccAddress,
 	validator stakingtypes.Validator,
 	amount int64,
-) (stakingtypes.Delegation, error) {
+) (stakingtypes.Delegation, sdk.Coin, error) {
 	stakingDenom := app.StakingKeeper.BondDenom(ctx)
 
 	msgDelegate := stakingtypes.NewMsgDelegate(delegator, validator.GetOperator(), sdk.NewInt64Coin(stakingDenom, amount))
-	_, err := evmosutil.DeliverTx(ctx, app, priv, nil, msgDelegate)
+	res, err := evmosutil.DeliverTx(ctx, app, priv, nil, msgDelegate)
 	if err != nil {
-		return stakingtypes.Delegation{}, fmt.Errorf("failed to delegate: %w", err)
+		return stakingtypes.Delegation{}, sdk.Coin{}, fmt.Errorf("failed to delegate: %w", err)
 	}
 
 	delegation, found := app.StakingKeeper.GetDelegation(s.ctx, delegator, validator.GetOperator())
 	if !found {
-		return stakingtypes.Delegation{}, fmt.Errorf("delegation not found")
+		return stakingtypes.Delegation{}, sdk.Coin{}, fmt.Errorf("delegation not found")
 	}
 
-	return delegation, nil
+	return delegation, testutiltx.GetFeesPaid(testutiltx.DefaultFee, res), nil
 }
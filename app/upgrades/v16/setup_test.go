@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v16_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	evmosapp "github.com/evmos/evmos/v15/app"
+	"github.com/evmos/evmos/v15/utils"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+var chainID = utils.MainnetChainID + "-1"
+
+type UpgradesTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *evmosapp.Evmos
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradesTestSuite))
+}
+
+func (s *UpgradesTestSuite) SetupTest() {
+	s.app = evmosapp.Setup(false, feemarkettypes.DefaultGenesisState(), chainID)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: chainID, Time: time.Now().UTC()})
+}
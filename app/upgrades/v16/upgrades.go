@@ -3,12 +3,25 @@
 package v16
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/evmos/v15/precompiles/authz"
+	bankprecompile "github.com/evmos/evmos/v15/precompiles/bank"
+	govprecompile "github.com/evmos/evmos/v15/precompiles/gov"
+	ibcprecompile "github.com/evmos/evmos/v15/precompiles/ibc"
+	multicallprecompile "github.com/evmos/evmos/v15/precompiles/multicall"
 	"github.com/evmos/evmos/v15/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v15/precompiles/slashing"
 	"github.com/evmos/evmos/v15/utils"
+	erc20keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 	evmkeeper "github.com/evmos/evmos/v15/x/evm/keeper"
+	feemarketkeeper "github.com/evmos/evmos/v15/x/feemarket/keeper"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+	"golang.org/x/exp/slices"
 )
 
 // CreateUpgradeHandler creates an SDK upgrade handler for v16.0.0
@@ -16,6 +29,8 @@ func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
 	ek *evmkeeper.Keeper,
+	erc20k erc20keeper.Keeper,
+	fmk feemarketkeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		logger := ctx.Logger().With("upgrade", UpgradeName)
@@ -28,8 +43,88 @@ func CreateUpgradeHandler(
 			}
 		}
 
+		// enable the precompiles added in this version
+		if err := EnableNewPrecompiles(ctx, ek); err != nil {
+			logger.Error("failed to enable new precompiles", "error", err.Error())
+		}
+
+		// set the IBC configuration of the outposts, which was previously
+		// hardcoded in the binary
+		erc20Params := erc20k.GetParams(ctx)
+		erc20Params.Outposts = erc20types.DefaultOutposts()
+		if err := erc20k.SetParams(ctx, erc20Params); err != nil {
+			logger.Error("failed to set outposts params", "error", err.Error())
+		}
+
+		// activate the Shanghai and Cancun instruction sets from the upgrade height
+		if err := EnableCancun(ctx, ek); err != nil {
+			logger.Error("failed to set the Shanghai and Cancun blocks", "error", err.Error())
+		}
+
+		// enable the fee history, which is disabled for the params stored before
+		// the field was added
+		feeMarketParams := fmk.GetParams(ctx)
+		if feeMarketParams.FeeHistorySize == 0 {
+			feeMarketParams.FeeHistorySize = feemarkettypes.DefaultFeeHistorySize
+			if err := fmk.SetParams(ctx, feeMarketParams); err != nil {
+				logger.Error("failed to set the fee history size", "error", err.Error())
+			}
+		}
+
+		// NOTE: the WEVMOS balances were read from the native balances before
+		// this version and are now held in a separate wrapped denomination, so
+		// the existing holders start with a WEVMOS balance of 0. Their native
+		// balances are unchanged and can be wrapped again through deposit.
+		// Minting the wrapped coin for them would double their balances.
+
 		// Leave modules are as-is to avoid running InitGenesis.
 		logger.Debug("running module migrations ...")
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
+
+// NewPrecompiles defines the addresses of the precompiles added in v16.
+var NewPrecompiles = []string{
+	bankprecompile.PrecompileAddress,
+	govprecompile.PrecompileAddress,
+	authzprecompile.PrecompileAddress,
+	slashingprecompile.PrecompileAddress,
+	multicallprecompile.PrecompileAddress,
+	ibcprecompile.PrecompileAddress,
+	erc20types.OsmosisOutpostAddress,
+}
+
+// EnableNewPrecompiles adds the precompiles added in v16 to the active precompiles
+// of the EVM parameters, skipping the ones that are already active.
+func EnableNewPrecompiles(ctx sdk.Context, ek *evmkeeper.Keeper) error {
+	params := ek.GetParams(ctx)
+
+	addresses := make([]common.Address, 0, len(NewPrecompiles))
+	for _, address := range NewPrecompiles {
+		if !slices.Contains(params.ActivePrecompiles, address) {
+			addresses = append(addresses, common.HexToAddress(address))
+		}
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	return ek.EnablePrecompiles(ctx, addresses...)
+}
+
+// EnableCancun sets the Shanghai and Cancun blocks of the EVM chain config to the upgrade
+// height, unless they are already activated at a lower height.
+func EnableCancun(ctx sdk.Context, ek *evmkeeper.Keeper) error {
+	params := ek.GetParams(ctx)
+	height := sdkmath.NewInt(ctx.BlockHeight())
+
+	if params.ChainConfig.ShanghaiBlock == nil || params.ChainConfig.ShanghaiBlock.GT(height) {
+		params.ChainConfig.ShanghaiBlock = &height
+	}
+	if params.ChainConfig.CancunBlock == nil || params.ChainConfig.CancunBlock.GT(height) {
+		params.ChainConfig.CancunBlock = &height
+	}
+
+	return ek.SetParams(ctx, params)
+}
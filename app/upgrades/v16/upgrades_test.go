@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v16_test
+
+import (
+	v16 "github.com/evmos/evmos/v15/app/upgrades/v16"
+	bankprecompile "github.com/evmos/evmos/v15/precompiles/bank"
+	stakingprecompile "github.com/evmos/evmos/v15/precompiles/staking"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func (s *UpgradesTestSuite) TestEnableNewPrecompiles() {
+	testcases := []struct {
+		name              string
+		activePrecompiles []string
+		expPrecompiles    []string
+	}{
+		{
+			name:              "success - enable the new precompiles",
+			activePrecompiles: []string{stakingprecompile.PrecompileAddress},
+			expPrecompiles: []string{
+				stakingprecompile.PrecompileAddress,
+				"0x0000000000000000000000000000000000000804",
+				"0x0000000000000000000000000000000000000805",
+				"0x0000000000000000000000000000000000000806",
+				"0x0000000000000000000000000000000000000807",
+				"0x0000000000000000000000000000000000000808",
+				"0x0000000000000000000000000000000000000809",
+				"0x0000000000000000000000000000000000000901",
+			},
+		},
+		{
+			name:              "success - skip the precompiles that are already active",
+			activePrecompiles: []string{stakingprecompile.PrecompileAddress, bankprecompile.PrecompileAddress},
+			expPrecompiles: []string{
+				stakingprecompile.PrecompileAddress,
+				"0x0000000000000000000000000000000000000804",
+				"0x0000000000000000000000000000000000000805",
+				"0x0000000000000000000000000000000000000806",
+				"0x0000000000000000000000000000000000000807",
+				"0x0000000000000000000000000000000000000808",
+				"0x0000000000000000000000000000000000000809",
+				"0x0000000000000000000000000000000000000901",
+			},
+		},
+		{
+			name:              "success - all the precompiles are already active",
+			activePrecompiles: evmtypes.AvailableEVMExtensions,
+			expPrecompiles:    evmtypes.AvailableEVMExtensions,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			params := s.app.EvmKeeper.GetParams(s.ctx)
+			params.ActivePrecompiles = tc.activePrecompiles
+			err := s.app.EvmKeeper.SetParams(s.ctx, params)
+			s.Require().NoError(err, "expected no error setting params")
+
+			err = v16.EnableNewPrecompiles(s.ctx, s.app.EvmKeeper)
+			s.Require().NoError(err, "expected no error enabling the new precompiles")
+
+			params = s.app.EvmKeeper.GetParams(s.ctx)
+			s.Require().Equal(tc.expPrecompiles, params.ActivePrecompiles, "expected different active precompiles")
+		})
+	}
+}
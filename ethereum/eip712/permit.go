@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package eip712
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// permitType is the primary type of the EIP-2612 permit typed data.
+	permitType = "Permit"
+	// permitDomainVersion is the version of the EIP-2612 permit domain.
+	permitDomainVersion = "1"
+)
+
+// permitTypes are the EIP-712 types of the EIP-2612 permit, as defined in
+// https://eips.ethereum.org/EIPS/eip-2612.
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	permitType: {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// createPermitDomain creates the EIP-2612 permit domain of the token with the
+// given name, deployed at the verifying contract address.
+func createPermitDomain(chainID *big.Int, name string, verifyingContract common.Address) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              name,
+		Version:           permitDomainVersion,
+		ChainId:           (*math.HexOrDecimal256)(chainID),
+		VerifyingContract: verifyingContract.Hex(),
+	}
+}
+
+// WrapPermitToTypedData wraps an EIP-2612 permit, through which the owner approves
+// the spender to spend the value of the given token, into an EIP712-compatible
+// TypedData request.
+func WrapPermitToTypedData(
+	chainID *big.Int,
+	name string,
+	verifyingContract common.Address,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: permitType,
+		Domain:      createPermitDomain(chainID, name, verifyingContract),
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    (*math.HexOrDecimal256)(value),
+			"nonce":    (*math.HexOrDecimal256)(nonce),
+			"deadline": (*math.HexOrDecimal256)(deadline),
+		},
+	}
+}
+
+// PermitDomainSeparator returns the hash of the EIP-2612 permit domain of the
+// token with the given name, deployed at the verifying contract address.
+func PermitDomainSeparator(chainID *big.Int, name string, verifyingContract common.Address) (common.Hash, error) {
+	typedData := apitypes.TypedData{
+		Types:  permitTypes,
+		Domain: createPermitDomain(chainID, name, verifyingContract),
+	}
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(domainSeparator), nil
+}
+
+// RecoverTypedDataSigner returns the address of the signer of the given typed
+// data from the v, r and s values of the signature. The recovery ID v can be
+// either 0 or 1, or 27 or 28 as returned by the Ethereum wallets. Malleable
+// signatures, whose s value is in the upper half of the curve order, are rejected.
+func RecoverTypedDataSigner(typedData apitypes.TypedData, v uint8, r, s [32]byte) (common.Address, error) {
+	if v >= 27 {
+		v -= 27
+	}
+
+	if !crypto.ValidateSignatureValues(v, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, errors.New("invalid signature values")
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	copy(signature[:32], r[:])
+	copy(signature[32:64], s[:])
+	signature[crypto.RecoveryIDOffset] = v
+
+	pubKey, err := crypto.SigToPub(sigHash, signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
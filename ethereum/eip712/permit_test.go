@@ -0,0 +1,90 @@
+package eip712_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermitDomainSeparator(t *testing.T) {
+	token := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	permitChainID := big.NewInt(9001)
+
+	domainSeparator, err := eip712.PermitDomainSeparator(permitChainID, "Osmosis", token)
+	require.NoError(t, err)
+
+	// the domain separator as computed by the EIP-2612 reference implementation
+	bytes32, err := abi.NewType("bytes32", "", nil)
+	require.NoError(t, err)
+	uint256, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	address, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+
+	encoded, err := abi.Arguments{{Type: bytes32}, {Type: bytes32}, {Type: bytes32}, {Type: uint256}, {Type: address}}.Pack(
+		crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256Hash([]byte("Osmosis")),
+		crypto.Keccak256Hash([]byte("1")),
+		permitChainID,
+		token,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(encoded), domainSeparator)
+}
+
+func TestRecoverTypedDataSigner(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	typedData := eip712.WrapPermitToTypedData(
+		big.NewInt(9001),
+		"Osmosis",
+		common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"),
+		owner,
+		common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		big.NewInt(1e18),
+		big.NewInt(0),
+		big.NewInt(1e10),
+	)
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	signature, err := crypto.Sign(sigHash, privKey)
+	require.NoError(t, err)
+
+	var r, s [32]byte
+	copy(r[:], signature[:32])
+	copy(s[:], signature[32:64])
+	v := signature[crypto.RecoveryIDOffset]
+
+	testCases := []struct {
+		name     string
+		v        uint8
+		s        [32]byte
+		expError bool
+	}{
+		{"pass - recovery ID", v, s, false},
+		{"pass - recovery ID with the Ethereum offset", v + 27, s, false},
+		{"fail - invalid recovery ID", 2, s, true},
+		{"fail - malleable signature", v, common.BigToHash(crypto.S256().Params().N), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := eip712.RecoverTypedDataSigner(typedData, tc.v, r, tc.s)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, owner, signer)
+		})
+	}
+}
@@ -35,11 +35,13 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	// indexLogs defines if the logs are indexed by address and topics
+	indexLogs bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -47,6 +49,7 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the logs of every Tx keyed by address and topics, if the log index is enabled
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
 
@@ -114,6 +117,17 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
+
+		if kv.indexLogs && result.Code == abci.CodeTypeOK {
+			if err := saveLogs(kv.clientCtx.Codec, batch, result); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+	}
+	if kv.indexLogs {
+		if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log block key", height)
+		}
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
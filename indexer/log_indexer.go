@@ -0,0 +1,331 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v15/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+const (
+	KeyPrefixLogAddress = 3
+	KeyPrefixLogTopic   = 4
+	KeyPrefixLog        = 5
+	KeyPrefixLogBlock   = 6
+
+	// LogPositionLength is the length of the log position suffix of the log index
+	// keys: block number, tx index and log index.
+	LogPositionLength = 8 + 8 + 8
+
+	// maxLogTopics is the maximum number of topics of a log
+	maxLogTopics = 4
+)
+
+var _ evmostypes.EVMLogIndexer = &KVIndexer{}
+
+// WithLogIndex enables the address and topic keyed log index, which is written
+// in the same batch as the tx index of each block.
+func (kv *KVIndexer) WithLogIndex() *KVIndexer {
+	kv.indexLogs = true
+	return kv
+}
+
+// FirstLogIndexedBlock returns the first block covered by the log index, returns
+// -1 if the log index is empty.
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseLogBlockFromKey(it.Key())
+}
+
+// LastLogIndexedBlock returns the last block covered by the log index, returns
+// -1 if the log index is empty.
+func (kv *KVIndexer) LastLogIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseLogBlockFromKey(it.Key())
+}
+
+// IsLogIndexedRange returns true if every block of the [from, to] range is
+// covered by the log index.
+func (kv *KVIndexer) IsLogIndexedRange(from, to int64) (bool, error) {
+	if from > to {
+		return false, nil
+	}
+	it, err := kv.db.Iterator(LogBlockKey(from), LogBlockKey(to+1))
+	if err != nil {
+		return false, errorsmod.Wrap(err, "IsLogIndexedRange")
+	}
+	defer it.Close()
+
+	var count int64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	if err := it.Error(); err != nil {
+		return false, errorsmod.Wrap(err, "IsLogIndexedRange")
+	}
+	return count == to-from+1, nil
+}
+
+// GetLogs returns the logs of the [from, to] block range that match the given
+// addresses and topics, ordered by their position in the chain. The candidate
+// logs are read from the address index if addresses are given, or from the
+// topic index of the first non-wildcard topic position otherwise. It returns an
+// error if more than limit logs match the criteria.
+func (kv *KVIndexer) GetLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	var prefixes [][]byte
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			prefixes = append(prefixes, logAddressPrefix(address))
+		}
+	} else {
+		for i, sub := range topics {
+			if len(sub) == 0 || i >= maxLogTopics {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, logTopicPrefix(i, topic))
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{{KeyPrefixLog}}
+	}
+
+	// the keys of each prefix are ordered by log position, so the iterators are
+	// merged to visit the positions in order and stop as soon as the limit is
+	// exceeded
+	iterators := make([]dbm.Iterator, 0, len(prefixes))
+	defer func() {
+		for _, it := range iterators {
+			it.Close()
+		}
+	}()
+	for _, prefix := range prefixes {
+		start := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(from))...)
+		end := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(to+1))...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+		iterators = append(iterators, it)
+	}
+
+	logs := []*ethtypes.Log{}
+	for {
+		position, err := nextLogPosition(iterators)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+		if position == nil {
+			break
+		}
+
+		bz, err := kv.db.Get(append([]byte{KeyPrefixLog}, position...))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+		if len(bz) == 0 {
+			continue
+		}
+
+		var log evmtypes.Log
+		if err := kv.clientCtx.Codec.Unmarshal(bz, &log); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+
+		ethLog := log.ToEthereum()
+		if !matchLog(ethLog, addresses, topics) {
+			continue
+		}
+
+		if len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, ethLog)
+	}
+
+	return logs, nil
+}
+
+// nextLogPosition returns the lowest log position pointed by the given
+// iterators and advances the iterators past it, returns nil once all the
+// iterators are exhausted.
+func nextLogPosition(iterators []dbm.Iterator) ([]byte, error) {
+	var next []byte
+	for _, it := range iterators {
+		if !it.Valid() {
+			if err := it.Error(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		position, err := parseLogPositionFromKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		if next == nil || bytes.Compare(position, next) < 0 {
+			next = position
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	// the same log can be indexed under several of the prefixes
+	for _, it := range iterators {
+		for it.Valid() {
+			position, err := parseLogPositionFromKey(it.Key())
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(position, next) {
+				break
+			}
+			it.Next()
+		}
+	}
+	return next, nil
+}
+
+// saveLogs indexes the logs emitted by the eth txs of a tx result into the kv db batch
+func saveLogs(codec codec.Codec, batch dbm.Batch, result *abci.ResponseDeliverTx) error {
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var log evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &log); err != nil {
+				return errorsmod.Wrap(err, "parse tx log")
+			}
+
+			position := logPosition(&log)
+			if err := batch.Set(append([]byte{KeyPrefixLog}, position...), codec.MustMarshal(&log)); err != nil {
+				return errorsmod.Wrap(err, "set log key")
+			}
+			if err := batch.Set(append(logAddressPrefix(common.HexToAddress(log.Address)), position...), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log address key")
+			}
+			for i, topic := range log.Topics {
+				if i >= maxLogTopics {
+					break
+				}
+				if err := batch.Set(append(logTopicPrefix(i, common.HexToHash(topic)), position...), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log topic key")
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// LogBlockKey returns the key for db entry that marks a block as covered by the log index
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// logPosition returns the position of the log in the chain: `block number | tx index | log index`
+func logPosition(log *evmtypes.Log) []byte {
+	bz := make([]byte, 0, LogPositionLength)
+	bz = append(bz, sdk.Uint64ToBigEndian(log.BlockNumber)...)
+	bz = append(bz, sdk.Uint64ToBigEndian(log.TxIndex)...)
+	return append(bz, sdk.Uint64ToBigEndian(log.Index)...)
+}
+
+// logAddressPrefix returns the key prefix of the log index entries of an address
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// logTopicPrefix returns the key prefix of the log index entries of a topic at
+// the given position
+func logTopicPrefix(index int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(index)}, topic.Bytes()...)
+}
+
+// matchLog returns true if the log matches the address and topic criteria, it
+// follows the semantics of the eth_getLogs filter criteria.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if address == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// If the to filtered topics is greater than the amount of topics in logs, skip.
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLogPositionFromKey returns a copy of the log position suffix of a log index key
+func parseLogPositionFromKey(key []byte) ([]byte, error) {
+	if len(key) < LogPositionLength {
+		return nil, fmt.Errorf("wrong log index key length, got: %d", len(key))
+	}
+	return bytes.Clone(key[len(key)-LogPositionLength:]), nil
+}
+
+func parseLogBlockFromKey(key []byte) (int64, error) {
+	if len(key) != 1+8 {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", 1+8, len(key))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
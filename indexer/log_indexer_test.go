@@ -0,0 +1,258 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v15/indexer"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	contractA := utiltx.GenerateAddress()
+	contractB := utiltx.GenerateAddress()
+	transferTopic := common.BytesToHash([]byte("transfer"))
+	approvalTopic := common.BytesToHash([]byte("approval"))
+	accountTopic := common.BytesToHash(from.Bytes())
+
+	// blockResult returns the tx results of a block with a single eth tx that
+	// emitted the given logs
+	blockResult := func(height int64, logs []*ethtypes.Log) []*abci.ResponseDeliverTx {
+		attrs := make([]abci.EventAttribute, 0, len(logs))
+		for i, log := range logs {
+			log.BlockNumber = uint64(height)
+			log.TxHash = txHash
+			log.Index = uint(i)
+			bz, err := json.Marshal(types.NewLogFromEth(log))
+			require.NoError(t, err)
+			attrs = append(attrs, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+		}
+
+		return []*abci.ResponseDeliverTx{
+			{
+				Code:    0,
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: attrs},
+				},
+			},
+		}
+	}
+
+	blocks := []struct {
+		height int64
+		logs   []*ethtypes.Log
+	}{
+		{
+			1,
+			[]*ethtypes.Log{
+				{Address: contractA, Topics: []common.Hash{transferTopic, accountTopic}},
+				{Address: contractB, Topics: []common.Hash{approvalTopic}},
+			},
+		},
+		{
+			2,
+			[]*ethtypes.Log{
+				{Address: contractB, Topics: []common.Hash{transferTopic}},
+			},
+		},
+		{
+			3,
+			nil,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		from      int64
+		to        int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		limit     int
+		expLogs   []common.Address
+		expPass   bool
+	}{
+		{
+			"pass - all the logs of the range",
+			1, 3,
+			nil,
+			nil,
+			10,
+			[]common.Address{contractA, contractB, contractB},
+			true,
+		},
+		{
+			"pass - logs of a single block",
+			2, 2,
+			nil,
+			nil,
+			10,
+			[]common.Address{contractB},
+			true,
+		},
+		{
+			"pass - logs by address",
+			1, 3,
+			[]common.Address{contractB},
+			nil,
+			10,
+			[]common.Address{contractB, contractB},
+			true,
+		},
+		{
+			"pass - logs by topic",
+			1, 3,
+			nil,
+			[][]common.Hash{{transferTopic}},
+			10,
+			[]common.Address{contractA, contractB},
+			true,
+		},
+		{
+			"pass - logs by wildcard and second topic",
+			1, 3,
+			nil,
+			[][]common.Hash{{}, {accountTopic}},
+			10,
+			[]common.Address{contractA},
+			true,
+		},
+		{
+			"pass - logs by address and topic",
+			1, 3,
+			[]common.Address{contractA, contractB},
+			[][]common.Hash{{approvalTopic}},
+			10,
+			[]common.Address{contractB},
+			true,
+		},
+		{
+			"fail - more logs than the limit",
+			1, 3,
+			nil,
+			nil,
+			2,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx).WithLogIndex()
+
+			for _, blk := range blocks {
+				block := &tmtypes.Block{Header: tmtypes.Header{Height: blk.height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+				require.NoError(t, idxer.IndexBlock(block, blockResult(blk.height, blk.logs)))
+			}
+
+			first, err := idxer.FirstLogIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(1), first)
+			last, err := idxer.LastLogIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, int64(3), last)
+
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, logs, len(tc.expLogs))
+			for i, log := range logs {
+				require.Equal(t, tc.expLogs[i], log.Address)
+				require.Equal(t, txHash, log.TxHash)
+			}
+		})
+	}
+}
+
+func TestKVIndexerLogsDisabled(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 1}}, nil))
+
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+}
+
+func TestKVIndexerIsLogIndexedRange(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx).WithLogIndex()
+	for _, height := range []int64{1, 2, 4} {
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil))
+	}
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		expIndexed bool
+	}{
+		{"pass - all blocks indexed", 1, 2, true},
+		{"pass - single block indexed", 4, 4, true},
+		{"fail - missing block in the middle of the range", 1, 4, false},
+		{"fail - range after the last indexed block", 4, 5, false},
+		{"fail - invalid range", 2, 1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			indexed, err := idxer.IsLogIndexedRange(tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expIndexed, indexed)
+		})
+	}
+}
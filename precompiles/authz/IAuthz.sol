@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Grant represents a grant from a granter to a grantee. The authorization
+/// is JSON encoded and the expiration is a UNIX timestamp, which is zero if the
+/// grant doesn't expire.
+struct Grant {
+    string msgTypeUrl;
+    bytes authorization;
+    uint64 expiration;
+}
+
+/// @dev GrantAuthorization represents a grant together with its granter and grantee.
+struct GrantAuthorization {
+    address granter;
+    address grantee;
+    string msgTypeUrl;
+    bytes authorization;
+    uint64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the authz module.
+/// The caller is always the granter of the grants it creates or revokes, and the
+/// grantee of the messages it executes.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface IAuthz {
+    /// @dev This event is emitted when the granter grants an authorization to the grantee.
+    /// @param grantee The address that received an Authorization from the granter.
+    /// @param granter The address that granted an Authorization.
+    /// @param methods The message type URLs of the granted authorizations.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods
+    );
+
+    /// @dev This event is emitted when the granter revokes the grantee authorizations.
+    /// @param grantee The address that had an Authorization from the granter.
+    /// @param granter The address that revoked the Authorization.
+    /// @param typeUrls The message type URLs of the revoked authorizations.
+    event Revocation(
+        address indexed grantee,
+        address indexed granter,
+        string[] typeUrls
+    );
+
+    /// @dev This event is emitted when the grantee executes messages.
+    /// @param grantee The address that executed the messages.
+    /// @param typeUrls The message type URLs of the executed messages.
+    event Exec(address indexed grantee, string[] typeUrls);
+
+    /// TRANSACTIONS
+
+    /// @dev Grants an authorization from the caller to the grantee.
+    /// @param grantee The address that receives the authorization.
+    /// @param jsonAuthorization The JSON encoded authorization, e.g.
+    /// {"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.gov.v1.MsgVote"}.
+    /// @param expiration The UNIX timestamp when the grant expires, or zero to use the default expiration.
+    /// @return success Whether the grant was successful.
+    function grant(
+        address grantee,
+        bytes calldata jsonAuthorization,
+        uint64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the grant from the caller to the grantee for the given message type.
+    /// @param grantee The address whose authorization is revoked.
+    /// @param msgTypeUrl The message type URL of the revoked authorization.
+    /// @return success Whether the revocation was successful.
+    function revoke(
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes the given messages with the caller as the grantee. Only bank sends,
+    /// staking, distribution and governance deposit and vote messages are allowed.
+    /// @param jsonMsgs The JSON encoded messages, each with its "@type".
+    /// @return results The data returned by each executed message.
+    function exec(
+        bytes[] calldata jsonMsgs
+    ) external returns (bytes[] memory results);
+
+    /// QUERIES
+
+    /// @dev Queries the grants from the granter to the grantee.
+    /// @param granter The address that granted the authorizations.
+    /// @param grantee The address that received the authorizations.
+    /// @param msgTypeUrl The message type URL to filter by, or an empty string for all grants.
+    /// @param pagination The pagination options.
+    /// @return grants The grants from the granter to the grantee.
+    /// @return pageResponse The pagination response.
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Queries all the grants issued by the granter.
+    /// @param granter The address that granted the authorizations.
+    /// @param pagination The pagination options.
+    /// @return grants The grants issued by the granter.
+    /// @return pageResponse The pagination response.
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            GrantAuthorization[] memory grants,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidAuthorization is raised when the authorization can't be decoded from its JSON encoding.
+	ErrInvalidAuthorization = "invalid authorization: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid UNIX timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidMsgs is raised when the messages can't be decoded from their JSON encoding.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrMsgNotAllowed is raised when the message type can't be executed through the precompile.
+	ErrMsgNotAllowed = "message type %s is not allowed in exec"
+)
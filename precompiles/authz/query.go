@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+)
+
+// Grants returns the grants from the granter to the grantee, optionally
+// filtered by message type.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromResponse(res, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// GranterGrants returns all the grants issued by the granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GranterGrantsOutput).FromResponse(res, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
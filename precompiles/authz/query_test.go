@@ -0,0 +1,124 @@
+package authz_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authz"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - grant of message type not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), grantee, sendMsgURL, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - filtered by message type",
+			func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), grantee, s.sendAuthorizationJSON(100))
+				return []interface{}{s.keyring.GetAddr(0), grantee, sendMsgURL, query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(sendMsgURL, out.Grants[0].MsgTypeURL)
+				s.Require().Contains(string(out.Grants[0].Authorization), "/cosmos.bank.v1beta1.SendAuthorization")
+				s.Require().NotZero(out.Grants[0].Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all grants",
+			func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), grantee, s.sendAuthorizationJSON(100))
+				s.grant(s.keyring.GetAddr(0), grantee, []byte(`{"@type":"/cosmos.authz.v1beta1.GenericAuthorization","msg":"/cosmos.gov.v1.MsgVote"}`))
+				return []interface{}{s.keyring.GetAddr(0), grantee, "", query.PageRequest{CountTotal: true}}
+			},
+			func(bz []byte) {
+				var out authz.GrantsOutput
+				err := s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Grants(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	s.Run("success - paginated grants", func() {
+		s.SetupTest()
+		grantees := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+		for _, grantee := range grantees {
+			s.grant(s.keyring.GetAddr(0), grantee, s.sendAuthorizationJSON(100))
+		}
+
+		bz, err := s.precompile.GranterGrants(s.network.GetContext(), &method, []interface{}{
+			s.keyring.GetAddr(0),
+			query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out authz.GranterGrantsOutput
+		err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Len(out.Grants, 1)
+		s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+		s.Require().Contains(grantees, out.Grants[0].Grantee)
+		s.Require().Equal(sendMsgURL, out.Grants[0].MsgTypeURL)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+	})
+}
@@ -0,0 +1,156 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants the given authorization from the caller to the grantee. The caller
+// is always the granter, so that both accounts and smart contracts can only manage
+// their own grants.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+	defaultExpiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+
+	msg, grantee, err := NewMsgGrant(args, granter, defaultExpiration, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, expiration: %s }",
+			granter, grantee, msg.Grant.Expiration,
+		),
+	)
+
+	if _, err := p.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	auth, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, granter, []string{auth.MsgTypeURL()}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the grant from the caller to the grantee for the given message type.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.CallerAddress
+
+	msg, grantee, err := NewMsgRevoke(args, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ granter: %s, grantee: %s, msg_type_url: %s }",
+			granter, grantee, msg.MsgTypeUrl,
+		),
+	)
+
+	if _, err := p.AuthzKeeper.Revoke(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	event := authorization.EventRevocation{
+		Granter:  granter,
+		Grantee:  grantee,
+		TypeUrls: []string{msg.MsgTypeUrl},
+	}
+
+	if err := authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData:      event,
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages with the caller as the grantee. Each message
+// must be signed by the caller or by an account that granted the caller an
+// authorization for it, and its type must be allowed by ExecAllowedMsgURLs.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.CallerAddress
+
+	msg, typeURLs, err := NewMsgExec(args, grantee, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ grantee: %s, msgs: %v }",
+			grantee, typeURLs,
+		),
+	)
+
+	res, err := p.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The executed messages can change the balances of any account, so the
+	// balances cached by the EVM are synced with the bank module.
+	stateDB.SyncBalances()
+
+	if err := p.EmitExecEvent(ctx, stateDB, grantee, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
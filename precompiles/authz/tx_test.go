@@ -0,0 +1,279 @@
+package authz_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authz"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+// sendMsgURL is the type URL of the bank MsgSend.
+const sendMsgURL = "/cosmos.bank.v1beta1.MsgSend"
+
+// txTestCase is a test case for the authz precompile transactions.
+type txTestCase struct {
+	name        string
+	caller      func() common.Address
+	malleate    func() []interface{}
+	postCheck   func(data []byte)
+	expError    bool
+	errContains string
+}
+
+// runTxTestCases runs the given transaction on each test case.
+func (s *PrecompileTestSuite) runTxTestCases(methodName string, testCases []txTestCase) {
+	method := s.precompile.Methods[methodName]
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller(), s.precompile, 200_000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch methodName {
+			case authz.GrantMethod:
+				bz, err = s.precompile.Grant(ctx, contract, s.stateDB, &method, args)
+			case authz.RevokeMethod:
+				bz, err = s.precompile.Revoke(ctx, contract, s.stateDB, &method, args)
+			case authz.ExecMethod:
+				bz, err = s.precompile.Exec(ctx, contract, s.stateDB, &method, args)
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrant() {
+	grantee := utiltx.GenerateAddress()
+	customExpiration := time.Now().Add(time.Hour).UTC()
+	granter := func() common.Address { return s.keyring.GetAddr(0) }
+
+	s.runTxTestCases(authz.GrantMethod, []txTestCase{
+		{
+			name:   "fail - invalid number of args",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 1),
+		},
+		{
+			name:   "fail - empty grantee",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{common.Address{}, s.sendAuthorizationJSON(100), uint64(0)}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(authz.ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:   "fail - invalid authorization JSON",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee, []byte(`{"@type":"/cosmos.bank.v1beta1.MsgSend"}`), uint64(0)}
+			},
+			expError:    true,
+			errContains: "invalid authorization",
+		},
+		{
+			name:   "fail - grant to self",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.sendAuthorizationJSON(100), uint64(0)}
+			},
+			expError:    true,
+			errContains: "grantee and granter should be different",
+		},
+		{
+			name:   "success - default expiration",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee, s.sendAuthorizationJSON(100), uint64(0)}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				ctx := s.network.GetContext()
+				auth, expiration := s.network.App.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), s.keyring.GetAccAddr(0), sendMsgURL)
+				s.Require().NotNil(auth)
+				s.Require().Equal(ctx.BlockTime().Add(cmn.DefaultExpirationDuration).Unix(), expiration.Unix())
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+		{
+			name:   "success - custom expiration",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee, s.sendAuthorizationJSON(100), uint64(customExpiration.Unix())}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				_, expiration := s.network.App.AuthzKeeper.GetAuthorization(s.network.GetContext(), grantee.Bytes(), s.keyring.GetAccAddr(0), sendMsgURL)
+				s.Require().Equal(customExpiration.Unix(), expiration.Unix())
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	grantee := utiltx.GenerateAddress()
+	granter := func() common.Address { return s.keyring.GetAddr(0) }
+
+	s.runTxTestCases(authz.RevokeMethod, []txTestCase{
+		{
+			name:   "fail - empty message type URL",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee, ""}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(authz.ErrInvalidMsgTypeURL, ""),
+		},
+		{
+			name:   "fail - grant not found",
+			caller: granter,
+			malleate: func() []interface{} {
+				return []interface{}{grantee, sendMsgURL}
+			},
+			expError:    true,
+			errContains: "authorization not found",
+		},
+		{
+			name:   "fail - only the granter can revoke",
+			caller: func() common.Address { return s.keyring.GetAddr(1) },
+			malleate: func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), grantee, s.sendAuthorizationJSON(100))
+				return []interface{}{grantee, sendMsgURL}
+			},
+			expError:    true,
+			errContains: "authorization not found",
+		},
+		{
+			name:   "success",
+			caller: granter,
+			malleate: func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), grantee, s.sendAuthorizationJSON(100))
+				return []interface{}{grantee, sendMsgURL}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				auth, _ := s.network.App.AuthzKeeper.GetAuthorization(s.network.GetContext(), grantee.Bytes(), s.keyring.GetAccAddr(0), sendMsgURL)
+				s.Require().Nil(auth)
+				// one log for the grant and one for the revocation
+				s.Require().Len(s.stateDB.Logs(), 2)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	recipient := utiltx.GenerateAddress()
+	grantee := func() common.Address { return s.keyring.GetAddr(1) }
+
+	s.runTxTestCases(authz.ExecMethod, []txTestCase{
+		{
+			name:   "fail - no messages",
+			caller: grantee,
+			malleate: func() []interface{} {
+				return []interface{}{[][]byte{}}
+			},
+			expError:    true,
+			errContains: "invalid messages",
+		},
+		{
+			name:   "fail - message not allowed",
+			caller: grantee,
+			malleate: func() []interface{} {
+				msg := fmt.Sprintf(
+					`{"@type":"/cosmos.authz.v1beta1.MsgRevoke","granter":"%s","grantee":"%s","msg_type_url":"%s"}`,
+					s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgURL,
+				)
+				return []interface{}{[][]byte{[]byte(msg)}}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(authz.ErrMsgNotAllowed, "/cosmos.authz.v1beta1.MsgRevoke"),
+		},
+		{
+			name:   "fail - no authorization",
+			caller: grantee,
+			malleate: func() []interface{} {
+				return []interface{}{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(0), recipient, 10)}}
+			},
+			expError:    true,
+			errContains: "authorization not found",
+		},
+		{
+			name:   "fail - spend limit exceeded",
+			caller: grantee,
+			malleate: func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.sendAuthorizationJSON(5))
+				return []interface{}{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(0), recipient, 10)}}
+			},
+			expError:    true,
+			errContains: "insufficient funds",
+		},
+		{
+			name:   "success - send on behalf of the granter",
+			caller: grantee,
+			malleate: func() []interface{} {
+				s.grant(s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.sendAuthorizationJSON(100))
+				// cache the granter balance in the state DB to check it is synced after exec
+				s.stateDB.GetBalance(s.keyring.GetAddr(0))
+				return []interface{}{[][]byte{
+					s.sendMsgJSON(s.keyring.GetAddr(0), recipient, 60),
+					s.sendMsgJSON(s.keyring.GetAddr(0), recipient, 40),
+				}}
+			},
+			postCheck: func(data []byte) {
+				var results [][]byte
+				err := s.precompile.UnpackIntoInterface(&results, authz.ExecMethod, data)
+				s.Require().NoError(err)
+				s.Require().Len(results, 2)
+
+				ctx := s.network.GetContext()
+				balance := s.network.App.BankKeeper.GetBalance(ctx, recipient.Bytes(), s.bondDenom)
+				s.Require().Equal(int64(100), balance.Amount.Int64())
+
+				granterBalance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.bondDenom)
+				s.Require().Equal(granterBalance.Amount.BigInt(), s.stateDB.GetBalance(s.keyring.GetAddr(0)))
+
+				// the spend limit is used up, so the grant is removed
+				auth, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgURL)
+				s.Require().Nil(auth)
+
+				// one log for the grant and one for the exec
+				s.Require().Len(s.stateDB.Logs(), 2)
+			},
+		},
+		{
+			name:   "success - own message without grant",
+			caller: grantee,
+			malleate: func() []interface{} {
+				return []interface{}{[][]byte{s.sendMsgJSON(s.keyring.GetAddr(1), recipient, 10)}}
+			},
+			postCheck: func(data []byte) {
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), recipient.Bytes(), s.bondDenom)
+				s.Require().Equal(int64(10), balance.Amount.Int64())
+			},
+		},
+	})
+}
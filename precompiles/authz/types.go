@@ -0,0 +1,320 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"golang.org/x/exp/slices"
+)
+
+// ExecAllowedMsgURLs defines the Cosmos messages that can be executed through
+// the exec method of the authz precompile.
+var ExecAllowedMsgURLs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+}
+
+// Grant is the struct used to return a grant of a granter to a grantee.
+// The authorization is JSON encoded and the expiration is a UNIX timestamp,
+// which is zero if the grant doesn't expire.
+type Grant struct {
+	MsgTypeURL    string `abi:"msgTypeUrl"`
+	Authorization []byte `abi:"authorization"`
+	Expiration    uint64 `abi:"expiration"`
+}
+
+// GrantAuthorization is the struct used to return a grant together with its
+// granter and grantee.
+type GrantAuthorization struct {
+	Granter       common.Address `abi:"granter"`
+	Grantee       common.Address `abi:"grantee"`
+	MsgTypeURL    string         `abi:"msgTypeUrl"`
+	Authorization []byte         `abi:"authorization"`
+	Expiration    uint64         `abi:"expiration"`
+}
+
+// GrantsInput is a struct used to parse the arguments of the grants query.
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgTypeURL string            `abi:"msgTypeUrl"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput is a struct used to parse the arguments of the granterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GrantsOutput is a struct to represent the key information from a Grants response.
+type GrantsOutput struct {
+	Grants       []Grant
+	PageResponse query.PageResponse
+}
+
+// GranterGrantsOutput is a struct to represent the key information from a GranterGrants response.
+type GranterGrantsOutput struct {
+	Grants       []GrantAuthorization
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant instance from the JSON encoded authorization
+// granted by the granter to the grantee. A zero expiration is replaced by the given
+// default expiration.
+func NewMsgGrant(
+	args []interface{},
+	granter common.Address,
+	defaultExpiration time.Time,
+	cdc codec.Codec,
+) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonAuthorization, ok := args[1].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAuthorization, args[1])
+	}
+
+	var authorization authz.Authorization
+	if err := cdc.UnmarshalInterfaceJSON(jsonAuthorization, &authorization); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAuthorization, err)
+	}
+
+	expirationUnix, ok := args[2].(uint64)
+	if !ok || expirationUnix > math.MaxInt64 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[2])
+	}
+
+	expiration := defaultExpiration
+	if expirationUnix != 0 {
+		expiration = time.Unix(int64(expirationUnix), 0).UTC() //#nosec G701 -- checked for int overflow already
+	}
+
+	msg, err := authz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), authorization, &expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance to revoke the grant of the
+// granter to the grantee for the given message type.
+func NewMsgRevoke(args []interface{}, granter common.Address) (*authz.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	msg := authz.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &msg, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance to execute the given JSON encoded
+// messages on behalf of their signers. Only the messages allowed by
+// ExecAllowedMsgURLs can be executed.
+func NewMsgExec(args []interface{}, grantee common.Address, cdc codec.Codec) (*authz.MsgExec, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	jsonMsgs, ok := args[0].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, args[0])
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	typeURLs := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(jsonMsg, &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgs, err)
+		}
+
+		typeURL := sdk.MsgTypeURL(msg)
+		if !slices.Contains(ExecAllowedMsgURLs, typeURL) {
+			return nil, nil, fmt.Errorf(ErrMsgNotAllowed, typeURL)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+
+		msgs[i] = msg
+		typeURLs[i] = typeURL
+	}
+
+	msg := authz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, typeURLs, nil
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance.
+func NewGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeURL,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the GrantsOutput from a QueryGrantsResponse.
+func (gso *GrantsOutput) FromResponse(res *authz.QueryGrantsResponse, cdc codec.Codec) (*GrantsOutput, error) {
+	gso.Grants = make([]Grant, len(res.Grants))
+	for i, grant := range res.Grants {
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := cdc.MarshalInterfaceJSON(authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		gso.Grants[i] = Grant{
+			MsgTypeURL:    authorization.MsgTypeURL(),
+			Authorization: bz,
+			Expiration:    unixTime(grant.Expiration),
+		}
+	}
+
+	if res.Pagination != nil {
+		gso.PageResponse.Total = res.Pagination.Total
+		gso.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return gso, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (gso *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(gso.Grants, gso.PageResponse)
+}
+
+// FromResponse populates the GranterGrantsOutput from a QueryGranterGrantsResponse.
+func (ggo *GranterGrantsOutput) FromResponse(res *authz.QueryGranterGrantsResponse, cdc codec.Codec) (*GranterGrantsOutput, error) {
+	ggo.Grants = make([]GrantAuthorization, len(res.Grants))
+	for i, grant := range res.Grants {
+		authorization, ok := grant.Authorization.GetCachedValue().(authz.Authorization)
+		if !ok {
+			return nil, fmt.Errorf(ErrInvalidAuthorization, grant.Authorization.TypeUrl)
+		}
+
+		bz, err := cdc.MarshalInterfaceJSON(authorization)
+		if err != nil {
+			return nil, err
+		}
+
+		granter, err := sdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		ggo.Grants[i] = GrantAuthorization{
+			Granter:       common.BytesToAddress(granter),
+			Grantee:       common.BytesToAddress(grantee),
+			MsgTypeURL:    authorization.MsgTypeURL(),
+			Authorization: bz,
+			Expiration:    unixTime(grant.Expiration),
+		}
+	}
+
+	if res.Pagination != nil {
+		ggo.PageResponse.Total = res.Pagination.Total
+		ggo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return ggo, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (ggo *GranterGrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(ggo.Grants, ggo.PageResponse)
+}
+
+// unixTime returns the UNIX timestamp of the given time, or zero if it's nil.
+func unixTime(t *time.Time) uint64 {
+	if t == nil {
+		return 0
+	}
+	return uint64(t.Unix()) //#nosec G701 -- the grant times are always after the UNIX epoch
+}
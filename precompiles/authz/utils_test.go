@@ -0,0 +1,37 @@
+package authz_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authz"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+)
+
+// sendAuthorizationJSON returns the JSON encoded authorization to send up to the
+// given amount of the bond denom.
+func (s *PrecompileTestSuite) sendAuthorizationJSON(amount int64) []byte {
+	return []byte(fmt.Sprintf(
+		`{"@type":"/cosmos.bank.v1beta1.SendAuthorization","spend_limit":[{"denom":"%s","amount":"%d"}]}`,
+		s.bondDenom, amount,
+	))
+}
+
+// sendMsgJSON returns the JSON encoded MsgSend of the given amount of the bond
+// denom from the sender to the recipient.
+func (s *PrecompileTestSuite) sendMsgJSON(from, to common.Address, amount int64) []byte {
+	return []byte(fmt.Sprintf(
+		`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"%d"}]}`,
+		sdk.AccAddress(from.Bytes()), sdk.AccAddress(to.Bytes()), s.bondDenom, amount,
+	))
+}
+
+// grant is a helper function to grant the given JSON encoded authorization from
+// the granter to the grantee through the precompile.
+func (s *PrecompileTestSuite) grant(granter, grantee common.Address, jsonAuthorization []byte) {
+	method := s.precompile.Methods[authz.GrantMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granter, s.precompile, 200_000)
+	_, err := s.precompile.Grant(ctx, contract, s.stateDB, &method, []interface{}{grantee, jsonAuthorization, uint64(0)})
+	s.Require().NoError(err, "failed to grant authorization")
+}
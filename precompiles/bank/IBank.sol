@@ -1,12 +1,17 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804; 
 
 /// @dev The IBank contract's instance.
 IBank constant IBANK_CONTRACT = IBank(IBANK_PRECOMPILE_ADDRESS);
 
+/// @dev The bank message type url that the approvals authorize.
+string constant MSG_SEND = "/cosmos.bank.v1beta1.MsgSend";
+
 /// @dev Balance specifies the ERC20 contract address and the amount of tokens.
 struct Balance {
   /// contractAddress defines the ERC20 contract address.
@@ -15,12 +20,72 @@ struct Balance {
   uint256 amount;
 }
 
+/// @dev Output specifies the recipient and the amount of coins of a multiSend transaction.
+struct Output {
+  /// to defines the recipient address.
+  address to;
+  /// amount of native coins to send
+  Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module and
+ * sending native coins of any denomination.
  */
 interface IBank {
+  /// @dev Emitted when the granter approves the grantee to send coins on its behalf.
+  /// @param grantee The address of the grantee.
+  /// @param granter The address of the granter.
+  /// @param spendLimit The amount of coins the grantee is allowed to send.
+  event Approval(address indexed grantee, address indexed granter, Coin[] spendLimit);
+
+  /// @dev Emitted when the granter revokes the grantee send authorization.
+  /// @param grantee The address of the grantee.
+  /// @param granter The address of the granter.
+  /// @param typeUrls The revoked message type urls.
+  event Revocation(address indexed grantee, address indexed granter, string[] typeUrls);
+
+  /// @dev Emitted for each recipient of a send or multiSend transaction.
+  /// @param from The address of the sender.
+  /// @param to The address of the recipient.
+  /// @param amount The amount of coins sent.
+  event Send(address indexed from, address indexed to, Coin[] amount);
+
+  /// @dev Approves the grantee to send up to the given amount of coins on behalf
+  /// of the origin through the send and multiSend methods.
+  /// @param grantee The address of the grantee.
+  /// @param spendLimit The amount of coins the grantee is allowed to send.
+  /// @return approved Whether the approval was successful.
+  function approve(address grantee, Coin[] calldata spendLimit) external returns (bool approved);
+
+  /// @dev Revokes the send authorization that the origin granted to the grantee.
+  /// @param grantee The address of the grantee.
+  /// @return revoked Whether the revocation was successful.
+  function revoke(address grantee) external returns (bool revoked);
+
+  /// @dev Sends native coins of any denomination. The sender must be the caller,
+  /// or the origin if it approved the caller to send on its behalf.
+  /// @param from The address of the sender.
+  /// @param to The address of the recipient.
+  /// @param amount The amount of coins to send.
+  /// @return success Whether the transfer was successful.
+  function send(address from, address to, Coin[] calldata amount) external returns (bool success);
+
+  /// @dev Sends native coins of any denomination to multiple recipients. The sender
+  /// must be the caller, or the origin if it approved the caller to send on its behalf.
+  /// @param from The address of the sender.
+  /// @param outputs The recipients and the amount of coins to send to each of them.
+  /// @return success Whether the transfer was successful.
+  function multiSend(address from, Output[] calldata outputs) external returns (bool success);
+
+  /// @dev Returns the remaining amount of coins that the granter allows the grantee to send.
+  /// @param grantee The address of the grantee.
+  /// @param granter The address of the granter.
+  /// @return spendLimit The remaining amount of coins.
+  function allowance(address grantee, address granter) external view returns (Coin[] memory spendLimit);
+
   /// @dev Balances defines a method for retrieving all the native token balances
   /// for a given account.
   /// @param account the address of the account to query balances for
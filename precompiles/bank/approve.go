@@ -0,0 +1,157 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// SendMsgURL defines the authorization type for MsgSend
+var SendMsgURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+// Approve grants the grantee a send authorization to send up to the given spend
+// limit on behalf of the origin. Returns a boolean value indicating whether the
+// operation succeeded.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sendAuthz, grantee, err := NewSendAuthorization(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+	if err := p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), origin.Bytes(), sendAuthz, &expiration); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, origin, sendAuthz.SpendLimit); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the send authorization that the origin granted to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	if err := p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), SendMsgURL); err != nil {
+		return nil, err
+	}
+
+	if err := authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  origin,
+			Grantee:  grantee,
+			TypeUrls: []string{SendMsgURL},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// acceptSends checks that the contract caller is allowed to send the coins of the
+// given sender. A caller can always send its own coins. Otherwise, the sender must
+// be the origin and it must have granted the caller a send authorization that
+// accepts all the messages. It returns the updated authorization, which must be
+// stored once the messages are executed, or nil if no authorization is needed.
+func (p Precompile) acceptSends(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	sender common.Address,
+	msgs ...*banktypes.MsgSend,
+) (*banktypes.SendAuthorization, *time.Time, error) {
+	if contract.CallerAddress == sender {
+		return nil, nil, nil
+	}
+
+	if origin != sender {
+		return nil, nil, fmt.Errorf(ErrDifferentOrigin, origin, sender)
+	}
+
+	msgAuthz, expiration, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, origin, SendMsgURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sendAuthz, ok := msgAuthz.(*banktypes.SendAuthorization)
+	if !ok {
+		return nil, nil, fmt.Errorf(authorization.ErrAuthzNotAccepted, SendMsgURL, contract.CallerAddress)
+	}
+
+	for _, msg := range msgs {
+		resp, err := sendAuthz.Accept(ctx, msg)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch {
+		case !resp.Accept:
+			return nil, nil, fmt.Errorf(authorization.ErrAuthzNotAccepted, SendMsgURL, contract.CallerAddress)
+		case resp.Delete:
+			// the spend limit is exhausted, so any further message is rejected
+			sendAuthz = banktypes.NewSendAuthorization(sdk.NewCoins(), nil)
+		case resp.Updated != nil:
+			sendAuthz, ok = resp.Updated.(*banktypes.SendAuthorization)
+			if !ok {
+				return nil, nil, fmt.Errorf(authorization.ErrAuthzNotAccepted, SendMsgURL, contract.CallerAddress)
+			}
+		}
+	}
+
+	return sendAuthz, expiration, nil
+}
+
+// updateSendAuthz stores the send authorization returned by acceptSends, deleting
+// the grant once its spend limit is exhausted.
+func (p Precompile) updateSendAuthz(
+	ctx sdk.Context,
+	grantee, granter common.Address,
+	sendAuthz *banktypes.SendAuthorization,
+	expiration *time.Time,
+) error {
+	if sendAuthz == nil {
+		return nil
+	}
+
+	if sendAuthz.SpendLimit.IsZero() {
+		return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), SendMsgURL)
+	}
+
+	return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), sendAuthz, expiration)
+}
@@ -7,10 +7,14 @@ import (
 	"embed"
 	"fmt"
 
+	"github.com/cometbft/cometbft/libs/log"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	erc20keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
 )
@@ -48,6 +52,7 @@ type Precompile struct {
 func NewPrecompile(
 	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20keeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, "abi.json")
 	if err != nil {
@@ -55,12 +60,15 @@ func NewPrecompile(
 	}
 
 	// NOTE: we set an empty gas configuration to avoid extra gas costs
-	// during the run execution
+	// during the run execution of queries. Transactions use the default
+	// KV gas configuration instead, see Run.
 	return &Precompile{
 		Precompile: cmn.Precompile{
 			ABI:                  newABI,
+			AuthzKeeper:          authzKeeper,
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
+			ApprovalExpiration:   cmn.DefaultExpirationDuration,
 		},
 		bankKeeper:  bankKeeper,
 		erc20Keeper: erc20Keeper,
@@ -94,21 +102,52 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasSupplyOf
 	}
 
-	return 0
+	// NOTE: the remaining methods are charged like any other precompile
+	// using the default KV gas configuration
+	kvGasConfig := storetypes.KVGasConfig()
+	argsBz := input[4:]
+	if p.IsTransaction(method.Name) {
+		return kvGasConfig.WriteCostFlat + (kvGasConfig.WriteCostPerByte * uint64(len(argsBz)))
+	}
+
+	return kvGasConfig.ReadCostFlat + (kvGasConfig.ReadCostPerByte * uint64(len(argsBz)))
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	if p.IsTransaction(method.Name) {
+		// charge the state changes of transactions with the default gas configuration
+		ctx = ctx.WithKVGasConfig(storetypes.KVGasConfig()).
+			WithTransientKVGasConfig(storetypes.TransientGasConfig())
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
 	switch method.Name {
+	// Authorization transactions
+	case authorization.ApproveMethod:
+		bz, err = p.Approve(ctx, evm.Origin, stateDB, method, args)
+	case authorization.RevokeMethod:
+		bz, err = p.Revoke(ctx, evm.Origin, stateDB, method, args)
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, evm.Origin, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, evm.Origin, contract, stateDB, method, args)
+	// Authorization queries
+	case authorization.AllowanceMethod:
+		bz, err = p.Allowance(ctx, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -134,7 +173,25 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ string) bool {
-	return false
+//
+// Available bank transactions are:
+//   - Send
+//   - MultiSend
+//   - Approve
+//   - Revoke
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case SendMethod,
+		MultiSendMethod,
+		authorization.ApproveMethod,
+		authorization.RevokeMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "bank")
 }
@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+const (
+	// ErrDifferentOrigin is raised when the tx origin address is not the same as the sender
+	// of the coins and the caller isn't the sender either.
+	ErrDifferentOrigin = "tx origin address %s does not match the sender address %s"
+	// ErrInvalidAmount is raised when a coin amount is negative or missing.
+	ErrInvalidAmount = "invalid amount for %s: %v"
+	// ErrEmptyOutputs is raised when a multiSend transaction has no recipients.
+	ErrEmptyOutputs = "no outputs defined; expected at least one recipient"
+)
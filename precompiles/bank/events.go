@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// EventTypeSend defines the event type for the bank Send and MultiSend transactions.
+const EventTypeSend = "Send"
+
+// EmitApprovalEvent creates a new approval event emitted on an Approve transaction.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, grantee, granter common.Address, spendLimit sdk.Coins) error {
+	return p.emitEvent(ctx, stateDB, authorization.EventTypeApproval, grantee, granter, cmn.NewCoinsResponse(spendLimit))
+}
+
+// EmitSendEvent creates a new send event emitted for each recipient of a Send or
+// MultiSend transaction.
+func (p Precompile) EmitSendEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, amount sdk.Coins) error {
+	return p.emitEvent(ctx, stateDB, EventTypeSend, from, to, cmn.NewCoinsResponse(amount))
+}
+
+// emitEvent emits the given event, whose indexed arguments are the two given
+// addresses, with the given data.
+func (p Precompile) emitEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, addr1, addr2 common.Address, data ...interface{}) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(addr1)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(addr2)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -5,8 +5,10 @@ package bank
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	"github.com/evmos/evmos/v15/x/erc20/types"
 )
 
@@ -123,3 +125,24 @@ func (p Precompile) SupplyOf(
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
+
+// Allowance returns the remaining spend limit of the send authorization that the
+// granter granted to the grantee. It returns an empty array if there is none.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, granter, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	spendLimit := sdk.NewCoins()
+	msgAuthz, _ := p.AuthzKeeper.GetAuthorization(ctx, grantee.Bytes(), granter.Bytes(), SendMsgURL)
+	if sendAuthz, ok := msgAuthz.(*banktypes.SendAuthorization); ok {
+		spendLimit = sendAuthz.SpendLimit
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(spendLimit))
+}
@@ -7,7 +7,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/evmos/evmos/v15/precompiles/authorization"
 	"github.com/evmos/evmos/v15/precompiles/bank"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	evmosutiltx "github.com/evmos/evmos/v15/testutil/tx"
 )
 
@@ -260,3 +262,33 @@ func (s *PrecompileTestSuite) TestSupplyOf() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[authorization.AllowanceMethod]
+	grantee := evmosutiltx.GenerateAddress()
+
+	s.Run("success - no authorization", func() {
+		s.SetupTest()
+
+		bz, err := s.precompile.Allowance(s.network.GetContext(), &method, []interface{}{grantee, s.keyring.GetAddr(0)})
+		s.Require().NoError(err)
+
+		var spendLimit []cmn.Coin
+		err = s.precompile.UnpackIntoInterface(&spendLimit, method.Name, bz)
+		s.Require().NoError(err)
+		s.Require().Empty(spendLimit)
+	})
+
+	s.Run("success - remaining spend limit", func() {
+		s.SetupTest()
+		s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin("xmpl", 100)))
+
+		bz, err := s.precompile.Allowance(s.network.GetContext(), &method, []interface{}{grantee, s.keyring.GetAddr(0)})
+		s.Require().NoError(err)
+
+		var spendLimit []cmn.Coin
+		err = s.precompile.UnpackIntoInterface(&spendLimit, method.Name, bz)
+		s.Require().NoError(err)
+		s.Require().Equal([]cmn.Coin{{Denom: "xmpl", Amount: big.NewInt(100)}}, spendLimit)
+	})
+}
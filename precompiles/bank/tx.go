@@ -0,0 +1,131 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends native coins of any denomination from the sender to the recipient.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, fromHexAddr, err := NewMsgSend(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	toHexAddr := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.ToAddress))
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ from: %s, to: %s, amount: %s }",
+			fromHexAddr, toHexAddr, msg.Amount,
+		),
+	)
+
+	sendAuthz, expiration, err := p.acceptSends(ctx, origin, contract, fromHexAddr, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.Send(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.updateSendAuthz(ctx, contract.CallerAddress, origin, sendAuthz, expiration); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendEvent(ctx, stateDB, fromHexAddr, toHexAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	stateDB.SyncBalance(fromHexAddr)
+	stateDB.SyncBalance(toHexAddr)
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends native coins of any denomination from the sender to multiple
+// recipients.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, fromHexAddr, err := NewMsgMultiSend(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ from: %s, outputs: %d, total: %s }",
+			fromHexAddr, len(msg.Outputs), msg.Inputs[0].Coins,
+		),
+	)
+
+	// NOTE: each output consumes the spend limit of the authorization as a MsgSend
+	recipients := make([]common.Address, len(msg.Outputs))
+	sends := make([]*banktypes.MsgSend, len(msg.Outputs))
+	for i, output := range msg.Outputs {
+		recipients[i] = common.BytesToAddress(sdk.MustAccAddressFromBech32(output.Address))
+		sends[i] = banktypes.NewMsgSend(fromHexAddr.Bytes(), recipients[i].Bytes(), output.Coins)
+	}
+
+	sendAuthz, expiration, err := p.acceptSends(ctx, origin, contract, fromHexAddr, sends...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err = msgSrv.MultiSend(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.updateSendAuthz(ctx, contract.CallerAddress, origin, sendAuthz, expiration); err != nil {
+		return nil, err
+	}
+
+	stateDB.SyncBalance(fromHexAddr)
+	for i, output := range msg.Outputs {
+		if err = p.EmitSendEvent(ctx, stateDB, fromHexAddr, recipients[i], output.Coins); err != nil {
+			return nil, err
+		}
+		stateDB.SyncBalance(recipients[i])
+	}
+
+	return method.Outputs.Pack(true)
+}
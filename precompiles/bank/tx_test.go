@@ -0,0 +1,313 @@
+package bank_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	"github.com/evmos/evmos/v15/precompiles/bank"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+// txTestCase is a test case for the bank precompile transactions. The origin is
+// always the first keyring account.
+type txTestCase struct {
+	name        string
+	caller      func() common.Address
+	malleate    func(stateDB *statedb.StateDB) []interface{}
+	postCheck   func(stateDB *statedb.StateDB, data []byte)
+	expError    bool
+	errContains string
+}
+
+// runTxTestCases runs the given transaction on each test case.
+func (s *PrecompileTestSuite) runTxTestCases(methodName string, testCases []txTestCase) {
+	method := s.precompile.Methods[methodName]
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			origin := s.keyring.GetAddr(0)
+			stateDB := s.network.GetStateDB()
+
+			args := tc.malleate(stateDB)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller(), s.precompile, 200_000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch methodName {
+			case bank.SendMethod:
+				bz, err = s.precompile.Send(ctx, origin, contract, stateDB, &method, args)
+			case bank.MultiSendMethod:
+				bz, err = s.precompile.MultiSend(ctx, origin, contract, stateDB, &method, args)
+			case authorization.ApproveMethod:
+				bz, err = s.precompile.Approve(ctx, origin, stateDB, &method, args)
+			case authorization.RevokeMethod:
+				bz, err = s.precompile.Revoke(ctx, origin, stateDB, &method, args)
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(stateDB, bz)
+			}
+		})
+	}
+}
+
+// approve grants the grantee a send authorization with the given spend limit on
+// behalf of the first keyring account.
+func (s *PrecompileTestSuite) approve(grantee common.Address, spendLimit sdk.Coins) {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+	_, err := s.precompile.Approve(s.network.GetContext(), s.keyring.GetAddr(0), s.network.GetStateDB(), &method, []interface{}{grantee, cmn.NewCoinsResponse(spendLimit)})
+	s.Require().NoError(err)
+}
+
+// getAllowance returns the spend limit of the send authorization of the grantee.
+func (s *PrecompileTestSuite) getAllowance(grantee common.Address) sdk.Coins {
+	authz, _ := s.network.App.AuthzKeeper.GetAuthorization(s.network.GetContext(), grantee.Bytes(), s.keyring.GetAccAddr(0), bank.SendMsgURL)
+	if authz == nil {
+		return nil
+	}
+	return authz.(*banktypes.SendAuthorization).SpendLimit
+}
+
+func (s *PrecompileTestSuite) TestApprove() {
+	grantee := utiltx.GenerateAddress()
+	caller := func() common.Address { return s.keyring.GetAddr(0) }
+
+	s.runTxTestCases(authorization.ApproveMethod, []txTestCase{
+		{
+			name:   "fail - empty input args",
+			caller: caller,
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:   "fail - empty spend limit",
+			caller: caller,
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{}}
+			},
+			expError:    true,
+			errContains: "spend limit cannot be nil",
+		},
+		{
+			name:   "success",
+			caller: caller,
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{grantee, []cmn.Coin{{Denom: "xmpl", Amount: big.NewInt(100)}}}
+			},
+			postCheck: func(stateDB *statedb.StateDB, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("xmpl", 100)), s.getAllowance(grantee))
+				s.Require().Len(stateDB.Logs(), 1)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	grantee := utiltx.GenerateAddress()
+	caller := func() common.Address { return s.keyring.GetAddr(0) }
+
+	s.runTxTestCases(authorization.RevokeMethod, []txTestCase{
+		{
+			name:   "fail - no authorization",
+			caller: caller,
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{grantee}
+			},
+			expError:    true,
+			errContains: "authorization not found",
+		},
+		{
+			name:   "success",
+			caller: caller,
+			malleate: func(*statedb.StateDB) []interface{} {
+				s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin("xmpl", 100)))
+				return []interface{}{grantee}
+			},
+			postCheck: func(stateDB *statedb.StateDB, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+				s.Require().Nil(s.getAllowance(grantee))
+				s.Require().Len(stateDB.Logs(), 1)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestSend() {
+	grantee := utiltx.GenerateAddress()
+	recipient := utiltx.GenerateAddress()
+
+	s.runTxTestCases(bank.SendMethod, []txTestCase{
+		{
+			name:   "fail - empty input args",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:   "fail - negative amount",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(-1)}}}
+			},
+			expError:    true,
+			errContains: "invalid amount",
+		},
+		{
+			name:   "fail - sender is neither the caller nor the origin",
+			caller: func() common.Address { return grantee },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), recipient, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}}
+			},
+			expError:    true,
+			errContains: "does not match the sender address",
+		},
+		{
+			name:   "fail - caller without authorization",
+			caller: func() common.Address { return grantee },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}}
+			},
+			expError:    true,
+			errContains: "does not exist or is expired",
+		},
+		{
+			name:   "fail - amount exceeds the spend limit",
+			caller: func() common.Address { return grantee },
+			malleate: func(*statedb.StateDB) []interface{} {
+				s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 50)))
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}}
+			},
+			expError:    true,
+			errContains: "requested amount is more than spend limit",
+		},
+		{
+			name:   "fail - insufficient funds",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{{Denom: "xmpl", Amount: big.NewInt(100)}}}
+			},
+			expError:    true,
+			errContains: "insufficient funds",
+		},
+		{
+			name:   "success - caller sends its own coins",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func(stateDB *statedb.StateDB) []interface{} {
+				// load the accounts in the stateDB cache
+				s.Require().Zero(stateDB.GetBalance(recipient).Sign())
+				s.Require().NotZero(stateDB.GetBalance(s.keyring.GetAddr(0)).Sign())
+
+				s.mintAndSendCoin("xmpl", s.keyring.GetAccAddr(0), sdk.NewInt(1e18))
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{
+					{Denom: s.bondDenom, Amount: big.NewInt(100)},
+					{Denom: "xmpl", Amount: big.NewInt(200)},
+				}}
+			},
+			postCheck: func(stateDB *statedb.StateDB, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+				s.Require().Len(stateDB.Logs(), 1)
+
+				ctx := s.network.GetContext()
+				senderBalance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.bondDenom).Amount.BigInt()
+
+				// the stateDB balances are synced with the bank balances
+				s.Require().Equal(big.NewInt(100), stateDB.GetBalance(recipient))
+				s.Require().Equal(senderBalance, stateDB.GetBalance(s.keyring.GetAddr(0)))
+
+				// committing the stateDB doesn't overwrite the bank balances
+				s.Require().NoError(stateDB.Commit())
+				s.Require().Equal(int64(100), s.network.App.BankKeeper.GetBalance(ctx, recipient.Bytes(), s.bondDenom).Amount.Int64())
+				s.Require().Equal(int64(200), s.network.App.BankKeeper.GetBalance(ctx, recipient.Bytes(), "xmpl").Amount.Int64())
+				s.Require().Equal(senderBalance, s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), s.bondDenom).Amount.BigInt())
+			},
+		},
+		{
+			name:   "success - caller sends the origin coins with an authorization",
+			caller: func() common.Address { return grantee },
+			malleate: func(*statedb.StateDB) []interface{} {
+				s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 150)))
+				return []interface{}{s.keyring.GetAddr(0), recipient, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}}
+			},
+			postCheck: func(stateDB *statedb.StateDB, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 50)), s.getAllowance(grantee))
+				s.Require().Equal(big.NewInt(100), stateDB.GetBalance(recipient))
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	grantee := utiltx.GenerateAddress()
+	recipient1 := utiltx.GenerateAddress()
+	recipient2 := utiltx.GenerateAddress()
+
+	s.runTxTestCases(bank.MultiSendMethod, []txTestCase{
+		{
+			name:   "fail - no outputs",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func(*statedb.StateDB) []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), []bank.Output{}}
+			},
+			expError:    true,
+			errContains: bank.ErrEmptyOutputs,
+		},
+		{
+			name:   "fail - outputs exceed the spend limit",
+			caller: func() common.Address { return grantee },
+			malleate: func(*statedb.StateDB) []interface{} {
+				s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 150)))
+				return []interface{}{s.keyring.GetAddr(0), []bank.Output{
+					{To: recipient1, Amount: []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}},
+					{To: recipient2, Amount: []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}},
+				}}
+			},
+			expError:    true,
+			errContains: "requested amount is more than spend limit",
+		},
+		{
+			name:   "success - spend limit exhausted",
+			caller: func() common.Address { return grantee },
+			malleate: func(stateDB *statedb.StateDB) []interface{} {
+				s.Require().Zero(stateDB.GetBalance(recipient2).Sign())
+
+				s.approve(grantee, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 300)))
+				return []interface{}{s.keyring.GetAddr(0), []bank.Output{
+					{To: recipient1, Amount: []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(100)}}},
+					{To: recipient2, Amount: []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(200)}}},
+				}}
+			},
+			postCheck: func(stateDB *statedb.StateDB, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+				s.Require().Len(stateDB.Logs(), 2)
+				s.Require().Nil(s.getAllowance(grantee))
+
+				s.Require().Equal(big.NewInt(100), stateDB.GetBalance(recipient1))
+				s.Require().Equal(big.NewInt(200), stateDB.GetBalance(recipient2))
+				s.Require().NoError(stateDB.Commit())
+				s.Require().Equal(int64(200), s.network.App.BankKeeper.GetBalance(s.network.GetContext(), recipient2.Bytes(), s.bondDenom).Amount.Int64())
+			},
+		},
+	})
+}
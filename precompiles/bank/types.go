@@ -8,7 +8,10 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
 )
 
@@ -18,6 +21,31 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output defines the recipient and the amount of coins of a MultiSend transaction.
+type Output struct {
+	To     common.Address `abi:"to"`
+	Amount []cmn.Coin     `abi:"amount"`
+}
+
+// SendInput defines the input arguments of the bank Send transaction.
+type SendInput struct {
+	From   common.Address `abi:"from"`
+	To     common.Address `abi:"to"`
+	Amount []cmn.Coin     `abi:"amount"`
+}
+
+// MultiSendInput defines the input arguments of the bank MultiSend transaction.
+type MultiSendInput struct {
+	From    common.Address `abi:"from"`
+	Outputs []Output       `abi:"outputs"`
+}
+
+// ApproveInput defines the input arguments of the bank Approve transaction.
+type ApproveInput struct {
+	Grantee    common.Address `abi:"grantee"`
+	SpendLimit []cmn.Coin     `abi:"spendLimit"`
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -45,3 +73,128 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// NewMsgSend creates a new MsgSend instance from the given arguments.
+func NewMsgSend(args []interface{}, method *abi.Method) (*banktypes.MsgSend, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input SendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SendInput struct: %s", err)
+	}
+
+	amount, err := newCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := banktypes.NewMsgSend(input.From.Bytes(), input.To.Bytes(), amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.From, nil
+}
+
+// NewMsgMultiSend creates a new MsgMultiSend instance from the given arguments.
+// The single input of the message sends the sum of the output amounts.
+func NewMsgMultiSend(args []interface{}, method *abi.Method) (*banktypes.MsgMultiSend, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to MultiSendInput struct: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrEmptyOutputs)
+	}
+
+	total := sdk.NewCoins()
+	outputs := make([]banktypes.Output, len(input.Outputs))
+	for i, output := range input.Outputs {
+		amount, err := newCoins(output.Amount)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+
+		total = total.Add(amount...)
+		outputs[i] = banktypes.NewOutput(output.To.Bytes(), amount)
+	}
+
+	msg := banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(input.From.Bytes(), total)}, outputs)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.From, nil
+}
+
+// NewSendAuthorization creates a new SendAuthorization for the grantee from the
+// Approve transaction arguments.
+func NewSendAuthorization(args []interface{}, method *abi.Method) (*banktypes.SendAuthorization, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input ApproveInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to ApproveInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, input.Grantee)
+	}
+
+	spendLimit, err := newCoins(input.SpendLimit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	sendAuthz := banktypes.NewSendAuthorization(spendLimit, nil)
+	if err := sendAuthz.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return sendAuthz, input.Grantee, nil
+}
+
+// ParseAllowanceArgs parses the call arguments for the bank Allowance query.
+func ParseAllowanceArgs(args []interface{}) (grantee, granter common.Address, err error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	granter, ok = args[1].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(authorization.ErrInvalidGranter, args[1])
+	}
+
+	return grantee, granter, nil
+}
+
+// newCoins converts the given coins into sorted and validated SDK coins.
+func newCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil || coin.Amount.Sign() < 0 {
+			return nil, fmt.Errorf(ErrInvalidAmount, coin.Denom, coin.Amount)
+		}
+		sdkCoins[i] = coin.ToSDKType()
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, err
+	}
+	return sdkCoins, nil
+}
@@ -13,6 +13,7 @@ func (s *PrecompileTestSuite) setupBankPrecompile() *bank.Precompile {
 	precompile, err := bank.NewPrecompile(
 		s.network.App.BankKeeper,
 		s.network.App.Erc20Keeper,
+		s.network.App.AuthzKeeper,
 	)
 
 	s.Require().NoError(err, "failed to create bank precompile")
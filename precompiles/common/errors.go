@@ -20,6 +20,8 @@ const (
 	ErrInvalidMsgType = "invalid %s transaction type: %s"
 	// ErrInvalidNumberOfArgs is raised when the number of arguments is not what is expected.
 	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	// ErrNonPayable is raised when value is sent to a method that is not payable.
+	ErrNonPayable = "method %s is not payable"
 	// ErrUnknownMethod is raised when the method is not known.
 	ErrUnknownMethod = "unknown method: %s"
 	// ErrIntegerOverflow is raised when an integer overflow occurs.
@@ -44,6 +44,20 @@ func (p Precompile) RunSetup(
 	contract *vm.Contract,
 	readOnly bool,
 	isTransaction func(name string) bool,
+) (ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, gasConfig sdk.Gas, args []interface{}, err error) {
+	return p.RunSetupWithInput(evm, contract, contract.Input, readOnly, isTransaction)
+}
+
+// RunSetupWithInput runs the initial setup required to run a transaction or a query for
+// the given call input instead of the contract input. It is used by the precompiles that
+// dispatch a call to a method other than the one of the contract input, e.g. the receive
+// and fallback functions.
+func (p Precompile) RunSetupWithInput(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	input []byte,
+	readOnly bool,
+	isTransaction func(name string) bool,
 ) (ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, gasConfig sdk.Gas, args []interface{}, err error) {
 	stateDB, ok := evm.StateDB.(*statedb.StateDB)
 	if !ok {
@@ -51,7 +65,7 @@ func (p Precompile) RunSetup(
 	}
 	ctx = stateDB.GetContext()
 
-	methodID := contract.Input[:4]
+	methodID := input[:4]
 	// NOTE: this function iterates over the method map and returns
 	// the method with the given ID
 	method, err = p.MethodById(methodID)
@@ -64,7 +78,7 @@ func (p Precompile) RunSetup(
 		return sdk.Context{}, nil, nil, uint64(0), nil, vm.ErrWriteProtection
 	}
 
-	argsBz := contract.Input[4:]
+	argsBz := input[4:]
 	args, err = method.Inputs.Unpack(argsBz)
 	if err != nil {
 		return sdk.Context{}, nil, nil, uint64(0), nil, err
@@ -36,6 +36,12 @@ var (
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqpgshrm7", // Distribution precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqzxrz44p", // ICS20 transfer precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqrm4kqgn", // Vesting precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqy6vpsfk", // Bank precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq986495y", // Gov precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqxffqn6m", // Authz precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq85l5x8f", // Slashing precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqgtj86c3", // Multicall precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqfkyn09r", // IBC precompile
 	}
 )
 
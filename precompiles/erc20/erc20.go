@@ -25,6 +25,10 @@ const (
 	// abiPath defines the path to the ERC-20 precompile ABI JSON file.
 	abiPath = "abi.json"
 
+	// WrappedDenomPrefix defines the prefix of the bank denomination of a
+	// coin wrapped by the WERC-20 precompile.
+	WrappedDenomPrefix = "werc20/"
+
 	GasTransfer          = 3_000_000
 	GasApprove           = 30_956
 	GasIncreaseAllowance = 34_605
@@ -35,6 +39,9 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+	GasPermit            = 58_318
+	GasNonces            = 2_652
+	GasDomainSeparator   = 1_342
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -50,6 +57,7 @@ type Precompile struct {
 	tokenPair      erc20types.TokenPair
 	bankKeeper     bankkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
+	erc20Keeper    Erc20Keeper
 }
 
 // NewPrecompile creates a new ERC-20 Precompile instance as a
@@ -59,6 +67,7 @@ func NewPrecompile(
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
+	erc20Keeper Erc20Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, abiPath)
 	if err != nil {
@@ -76,6 +85,7 @@ func NewPrecompile(
 		tokenPair:      tokenPair,
 		bankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
+		erc20Keeper:    erc20Keeper,
 	}, nil
 }
 
@@ -112,6 +122,8 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case auth.DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	case PermitMethod:
+		return GasPermit
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -125,6 +137,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case auth.AllowanceMethod:
 		return GasAllowance
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
 	default:
 		return 0
 	}
@@ -162,7 +178,8 @@ func (Precompile) IsTransaction(methodID string) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod:
 		return true
 	default:
 		return false
@@ -189,6 +206,8 @@ func (p Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -202,6 +221,10 @@ func (p Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -0,0 +1,127 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+	evmostypes "github.com/evmos/evmos/v15/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 Permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 Nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signature of the permit, as defined in EIP-2612.
+// The permit is signed for the current nonce of the owner, which is incremented so
+// that the signature can't be replayed. It emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, v, r, s, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, fmt.Errorf("permit expired at %s, block time %d", deadline, ctx.BlockTime().Unix())
+	}
+
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.name(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	typedData := eip712.WrapPermitToTypedData(chainID, name, p.Address(), owner, spender, value, new(big.Int).SetUint64(nonce), deadline)
+
+	signer, err := eip712.RecoverTypedDataSigner(typedData, v, r, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permit signature: %w", err)
+	}
+
+	if signer != owner {
+		return nil, fmt.Errorf("invalid permit signer %s, expected owner %s", signer, owner)
+	}
+
+	p.erc20Keeper.SetPermitNonce(ctx, p.Address(), owner, nonce+1)
+
+	if err := p.approve(ctx, spender, owner, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the EIP-2612 permits
+// of the token, which are signed for its name, the chain ID and the precompile
+// address.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.name(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainSeparator, err := eip712.PermitDomainSeparator(chainID, name, p.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
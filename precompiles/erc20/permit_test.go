@@ -0,0 +1,174 @@
+package erc20_test
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+	"github.com/evmos/evmos/v15/precompiles/erc20"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	amount := int64(100)
+
+	var (
+		precompile *erc20.Precompile
+		deadline   *big.Int
+	)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func() []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - invalid v",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), deadline, "v", [32]byte{}, [32]byte{},
+				}
+			},
+			errContains: "invalid v",
+		},
+		{
+			name: "fail - expired permit",
+			malleate: func() []interface{} {
+				expired := new(big.Int).Sub(deadline, big.NewInt(7200))
+				v, r, sig := s.signPermit(precompile, 0, s.keyring.GetAddr(1), big.NewInt(amount), common.Big0, expired)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), expired, v, r, sig,
+				}
+			},
+			errContains: "permit expired",
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func() []interface{} {
+				v, r, sig := s.signPermit(precompile, 1, s.keyring.GetAddr(1), big.NewInt(amount), common.Big0, deadline)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), deadline, v, r, sig,
+				}
+			},
+			errContains: "invalid permit signer",
+		},
+		{
+			name: "fail - signed for a different value",
+			malleate: func() []interface{} {
+				v, r, sig := s.signPermit(precompile, 0, s.keyring.GetAddr(1), big.NewInt(amount+1), common.Big0, deadline)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), deadline, v, r, sig,
+				}
+			},
+			errContains: "invalid permit signer",
+		},
+		{
+			name: "fail - signed for a used nonce",
+			malleate: func() []interface{} {
+				s.network.App.Erc20Keeper.SetPermitNonce(s.network.GetContext(), precompile.Address(), s.keyring.GetAddr(0), 1)
+				v, r, sig := s.signPermit(precompile, 0, s.keyring.GetAddr(1), big.NewInt(amount), common.Big0, deadline)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), deadline, v, r, sig,
+				}
+			},
+			errContains: "invalid permit signer",
+		},
+		{
+			name: "pass - approve the spender and increment the nonce",
+			malleate: func() []interface{} {
+				v, r, sig := s.signPermit(precompile, 0, s.keyring.GetAddr(1), big.NewInt(amount), common.Big0, deadline)
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(amount), deadline, v, r, sig,
+				}
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireSendAuthz(
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					sdk.NewCoins(sdk.NewInt64Coin(validMetadataDenom, amount)),
+					[]string{},
+				)
+
+				nonce := s.network.App.Erc20Keeper.GetPermitNonce(s.network.GetContext(), precompile.Address(), s.keyring.GetAddr(0))
+				s.Require().Equal(uint64(1), nonce, "expected the nonce to be incremented")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.network.GetContext().WithBlockTime(time.Now())
+			s.network.App.BankKeeper.SetDenomMetaData(ctx, validMetadata)
+			precompile = s.setupERC20Precompile(validMetadataDenom)
+			deadline = big.NewInt(ctx.BlockTime().Add(time.Hour).Unix())
+
+			args := tc.malleate()
+
+			// NOTE: the permit is submitted by the spender, not the owner
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), precompile, 200_000)
+
+			bz, err := precompile.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().Empty(bz, "expected no return values")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+				s.Require().Empty(bz, "expected empty bytes")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+
+	ctx := s.network.GetContext()
+	owner := s.keyring.GetAddr(0)
+
+	bz, err := s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+	s.requireOut(bz, err, method, true, "", big.NewInt(0))
+
+	s.network.App.Erc20Keeper.SetPermitNonce(ctx, s.precompile.Address(), owner, 3)
+	bz, err = s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{owner})
+	s.requireOut(bz, err, method, true, "", big.NewInt(3))
+
+	_, err = s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{"owner"})
+	s.Require().ErrorContains(err, "invalid owner address")
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+
+	ctx := s.network.GetContext()
+	s.network.App.BankKeeper.SetDenomMetaData(ctx, validMetadata)
+	precompile := s.setupERC20Precompile(validMetadataDenom)
+
+	expDomainSeparator, err := eip712.PermitDomainSeparator(s.network.GetEIP155ChainID(), validMetadataName, precompile.Address())
+	s.Require().NoError(err)
+
+	bz, err := precompile.DomainSeparator(ctx, nil, nil, &method, nil)
+	s.requireOut(bz, err, method, true, "", [32]byte(expDomainSeparator))
+
+	// the domain separator requires the token name
+	_, err = s.setupERC20Precompile("ab").DomainSeparator(ctx, nil, nil, &method, nil)
+	s.Require().ErrorContains(err, "invalid base denomination")
+}
@@ -44,7 +44,7 @@ const (
 
 // Name returns the name of the token. If the token metadata is registered in the
 // bank module, it returns its name. Otherwise, it returns the base denomination of
-// the token capitalized (eg. uatom -> Atom).
+// the token, or of its IBC denomination trace, capitalized (eg. uatom -> Atom).
 func (p Precompile) Name(
 	ctx sdk.Context,
 	_ *vm.Contract,
@@ -52,18 +52,30 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
-	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	name, err := p.name(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// name returns the name of the token, as described in Name. A wrapped coin
+// (eg. werc20/aevmos) has the name of the coin it wraps.
+func (p Precompile) name(ctx sdk.Context) (string, error) {
+	denom := strings.TrimPrefix(p.tokenPair.Denom, WrappedDenomPrefix)
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
 	if found {
-		return method.Outputs.Pack(metadata.Name)
+		return metadata.Name, nil
 	}
 
-	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	baseDenom, err := p.getBaseDenom(ctx, denom)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
-	return method.Outputs.Pack(name)
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
 }
 
 // Symbol returns the symbol of the token. If the token metadata is registered in the
@@ -76,12 +88,14 @@ func (p Precompile) Symbol(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
-	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	denom := strings.TrimPrefix(p.tokenPair.Denom, WrappedDenomPrefix)
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
 	if found {
 		return method.Outputs.Pack(metadata.Symbol)
 	}
 
-	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	baseDenom, err := p.getBaseDenom(ctx, denom)
 	if err != nil {
 		return nil, err
 	}
@@ -252,20 +266,26 @@ func GetAuthzExpirationAndAllowance(
 	return authorization, expiration, allowance.BigInt(), nil
 }
 
-// getBaseDenomFromIBCVoucher returns the base denomination from the given IBC voucher denomination.
-func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, denom string) (string, error) {
-	// Infer the denomination name from the coin denomination base denom
-	denomTrace, err := GetDenomTrace(p.transferKeeper, ctx, denom)
-	if err != nil {
-		// FIXME: return 'not supported' (same error as when you call the method on an ERC20.sol)
-		return "", err
+// getBaseDenom returns the base denomination of the given denomination. For an IBC
+// voucher, it is the base denomination of its denomination trace. Otherwise, it is
+// the native denomination itself.
+func (p Precompile) getBaseDenom(ctx sdk.Context, denom string) (string, error) {
+	baseDenom := denom
+	if strings.HasPrefix(denom, "ibc/") {
+		// Infer the denomination name from the coin denomination base denom
+		denomTrace, err := GetDenomTrace(p.transferKeeper, ctx, denom)
+		if err != nil {
+			// FIXME: return 'not supported' (same error as when you call the method on an ERC20.sol)
+			return "", err
+		}
+		baseDenom = denomTrace.BaseDenom
 	}
 
 	// safety check
-	if len(denomTrace.BaseDenom) < 3 {
+	if len(baseDenom) < 3 {
 		// FIXME: return not supported (same error as when you call the method on an ERC20.sol)
-		return "", fmt.Errorf("invalid base denomination; should be at least length 3; got: %q", denomTrace.BaseDenom)
+		return "", fmt.Errorf("invalid base denomination; should be at least length 3; got: %q", baseDenom)
 	}
 
-	return denomTrace.BaseDenom, nil
+	return baseDenom, nil
 }
@@ -119,7 +119,7 @@ func (s *PrecompileTestSuite) TestNameSymbol() {
 		{
 			name:        "fail - empty denom",
 			denom:       "",
-			errContains: "denom is not an IBC voucher",
+			errContains: "invalid base denomination; should be at least length 3; got: \"\"",
 		},
 		{
 			name:        "fail - invalid denom trace",
@@ -140,9 +140,28 @@ func (s *PrecompileTestSuite) TestNameSymbol() {
 			errContains: "invalid base denomination; should be at least length 3; got: \"ab\"",
 		},
 		{
-			name:        "fail - denom without metadata and not an IBC voucher",
-			denom:       "noIBCvoucher",
-			errContains: "denom is not an IBC voucher",
+			name:      "pass - native denom without metadata",
+			denom:     "acoin",
+			expPass:   true,
+			expName:   "Coin",
+			expSymbol: "COIN",
+		},
+		{
+			name:      "pass - wrapped native denom without metadata",
+			denom:     erc20.WrappedDenomPrefix + "acoin",
+			expPass:   true,
+			expName:   "Coin",
+			expSymbol: "COIN",
+		},
+		{
+			name:  "pass - wrapped denom with the metadata of the native denom",
+			denom: erc20.WrappedDenomPrefix + validMetadataDenom,
+			malleate: func(ctx sdk.Context, app *app.Evmos) {
+				app.BankKeeper.SetDenomMetaData(ctx, validMetadata)
+			},
+			expPass:   true,
+			expName:   "Atom",
+			expSymbol: "ATOM",
 		},
 		{
 			name:  "pass - valid ibc denom without metadata and neither atto nor micro prefix",
@@ -9,6 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v15/ethereum/eip712"
 	"github.com/evmos/evmos/v15/precompiles/erc20"
 	commonfactory "github.com/evmos/evmos/v15/testutil/integration/common/factory"
 	utiltx "github.com/evmos/evmos/v15/testutil/tx"
@@ -119,8 +124,40 @@ func (s *PrecompileTestSuite) setupERC20Precompile(denom string) *erc20.Precompi
 		s.network.App.BankKeeper,
 		s.network.App.AuthzKeeper,
 		s.network.App.TransferKeeper,
+		s.network.App.Erc20Keeper,
 	)
 	s.Require().NoError(err, "failed to create erc20 precompile")
 
 	return precompile
 }
+
+// signPermit is a helper function to sign an EIP-2612 permit of the given ERC20
+// precompile with the private key of the given keyring account, which is the owner.
+func (s *PrecompileTestSuite) signPermit(
+	precompile *erc20.Precompile, ownerIndex int, spender common.Address, value, nonce, deadline *big.Int,
+) (v uint8, r, sig [32]byte) {
+	privKey, ok := s.keyring.GetPrivKey(ownerIndex).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected eth_secp256k1 private key")
+	ecdsaPrivKey, err := privKey.ToECDSA()
+	s.Require().NoError(err, "failed to convert private key")
+
+	typedData := eip712.WrapPermitToTypedData(
+		s.network.GetEIP155ChainID(),
+		validMetadataName,
+		precompile.Address(),
+		s.keyring.GetAddr(ownerIndex),
+		spender,
+		value,
+		nonce,
+		deadline,
+	)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash permit")
+
+	signature, err := crypto.Sign(sigHash, ecdsaPrivKey)
+	s.Require().NoError(err, "failed to sign permit")
+
+	copy(r[:], signature[:32])
+	copy(sig[:], signature[32:64])
+	return signature[crypto.RecoveryIDOffset] + 27, r, sig
+}
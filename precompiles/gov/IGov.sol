@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IGov contract's address.
+address constant GOV_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IGov contract's instance.
+IGov constant GOV_CONTRACT = IGov(GOV_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available governance methods.
+string constant MSG_SUBMIT_PROPOSAL = "/cosmos.gov.v1.MsgSubmitProposal";
+string constant MSG_DEPOSIT = "/cosmos.gov.v1.MsgDeposit";
+string constant MSG_VOTE = "/cosmos.gov.v1.MsgVote";
+string constant MSG_VOTE_WEIGHTED = "/cosmos.gov.v1.MsgVoteWeighted";
+
+/// @dev VoteOption enumerates the valid vote options for a given governance proposal.
+enum VoteOption {
+    // Unspecified defines a no-op vote option.
+    Unspecified,
+    // Yes defines a yes vote option.
+    Yes,
+    // Abstain defines an abstain vote option.
+    Abstain,
+    // No defines a no vote option.
+    No,
+    // NoWithVeto defines a no with veto vote option.
+    NoWithVeto
+}
+
+/// @dev WeightedVoteOption defines a unit of vote for vote split.
+struct WeightedVoteOption {
+    VoteOption option;
+    string weight;
+}
+
+/// @dev WeightedVote represents a vote on a governance proposal.
+struct WeightedVote {
+    uint64 proposalId;
+    address voter;
+    WeightedVoteOption[] options;
+    string metadata;
+}
+
+/// @dev TallyResultData represents the tally result of a proposal.
+struct TallyResultData {
+    string yes;
+    string abstain;
+    string no;
+    string noWithVeto;
+}
+
+/// @dev ProposalData represents a governance proposal. The messages are
+/// the type URLs of the proposal messages and the times are UNIX timestamps.
+struct ProposalData {
+    uint64 id;
+    string[] messages;
+    uint32 status;
+    TallyResultData finalTallyResult;
+    uint64 submitTime;
+    uint64 depositEndTime;
+    uint64 votingStartTime;
+    uint64 votingEndTime;
+    Coin[] totalDeposit;
+    string metadata;
+    string title;
+    string summary;
+    address proposer;
+}
+
+/// @author Evmos Team
+/// @title Gov Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with governance.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface IGov {
+    /// @dev This event is emitted when the granter approves the grantee to send the
+    /// given governance messages on its behalf.
+    /// @param grantee The contract address that received an Authorization from the granter.
+    /// @param granter The account address that granted an Authorization.
+    /// @param methods The message type URLs of the methods for which the approval is set.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods
+    );
+
+    /// @dev This event is emitted when the granter revokes the grantee authorizations.
+    /// @param grantee The contract address that had an Authorization from the granter.
+    /// @param granter The account address that revoked the Authorization.
+    /// @param typeUrls The message type URLs of the revoked authorizations.
+    event Revocation(
+        address indexed grantee,
+        address indexed granter,
+        string[] typeUrls
+    );
+
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer The address of the proposer.
+    /// @param proposalId The ID of the proposal.
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is made to a proposal.
+    /// @param depositor The address of the depositor.
+    /// @param proposalId The ID of the proposal.
+    /// @param amount The amount of the deposit.
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev Vote defines an Event emitted when a proposal voted.
+    /// @param voter The address of the voter.
+    /// @param proposalId The ID of the proposal.
+    /// @param option The option of the vote.
+    event Vote(address indexed voter, uint64 proposalId, uint8 option);
+
+    /// @dev VoteWeighted defines an Event emitted when a proposal voted with weights.
+    /// @param voter The address of the voter.
+    /// @param proposalId The ID of the proposal.
+    /// @param options The weighted options of the vote.
+    event VoteWeighted(
+        address indexed voter,
+        uint64 proposalId,
+        WeightedVoteOption[] options
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev Approves the grantee to send the given governance messages on behalf of the origin.
+    /// @param grantee The address of the contract that is allowed to send the messages.
+    /// @param methods The message type URLs of the approved methods.
+    /// @return approved Whether the approval was successful.
+    function approve(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool approved);
+
+    /// @dev Revokes the grantee authorizations to send the given governance messages.
+    /// @param grantee The address of the contract whose authorizations are revoked.
+    /// @param methods The message type URLs of the revoked methods.
+    /// @return revoked Whether the revocation was successful.
+    function revoke(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool revoked);
+
+    /// @dev Submits a new governance proposal.
+    /// @param proposer The address of the proposer.
+    /// @param jsonProposal The JSON encoded proposal, with its messages, metadata, title and summary.
+    /// @param deposit The initial deposit of the proposal.
+    /// @return proposalId The ID of the submitted proposal.
+    function submitProposal(
+        address proposer,
+        bytes calldata jsonProposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev Deposits funds to a governance proposal.
+    /// @param depositor The address of the depositor.
+    /// @param proposalId The ID of the proposal.
+    /// @param amount The amount to deposit.
+    /// @return success Whether the deposit was successful.
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev Votes on a governance proposal.
+    /// @param voter The address of the voter.
+    /// @param proposalId The ID of the proposal.
+    /// @param option The option of the vote.
+    /// @param metadata The metadata of the vote.
+    /// @return success Whether the vote was successful.
+    function vote(
+        address voter,
+        uint64 proposalId,
+        VoteOption option,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @dev Votes on a governance proposal splitting the voting power between options.
+    /// @param voter The address of the voter.
+    /// @param proposalId The ID of the proposal.
+    /// @param options The weighted options of the vote.
+    /// @param metadata The metadata of the vote.
+    /// @return success Whether the vote was successful.
+    function voteWeighted(
+        address voter,
+        uint64 proposalId,
+        WeightedVoteOption[] calldata options,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Queries a governance proposal.
+    /// @param proposalId The ID of the proposal.
+    /// @return proposal The proposal data.
+    function getProposal(
+        uint64 proposalId
+    ) external view returns (ProposalData memory proposal);
+
+    /// @dev Queries the tally result of a proposal.
+    /// @param proposalId The ID of the proposal.
+    /// @return tallyResult The current tally of the proposal.
+    function getTallyResult(
+        uint64 proposalId
+    ) external view returns (TallyResultData memory tallyResult);
+
+    /// @dev Queries the vote of a voter on a proposal.
+    /// @param proposalId The ID of the proposal.
+    /// @param voter The address of the voter.
+    /// @return vote The vote of the voter.
+    function getVote(
+        uint64 proposalId,
+        address voter
+    ) external view returns (WeightedVote memory vote);
+
+    /// @dev Queries the votes on a proposal.
+    /// @param proposalId The ID of the proposal.
+    /// @param pagination The pagination options.
+    /// @return votes The votes on the proposal.
+    /// @return pageResponse The pagination response.
+    function getVotes(
+        uint64 proposalId,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (WeightedVote[] memory votes, PageResponse memory pageResponse);
+}
@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+var (
+	// SubmitProposalMsgURL defines the authorization type for MsgSubmitProposal
+	SubmitProposalMsgURL = sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})
+	// DepositMsgURL defines the authorization type for MsgDeposit
+	DepositMsgURL = sdk.MsgTypeURL(&govv1.MsgDeposit{})
+	// VoteMsgURL defines the authorization type for MsgVote
+	VoteMsgURL = sdk.MsgTypeURL(&govv1.MsgVote{})
+	// VoteWeightedMsgURL defines the authorization type for MsgVoteWeighted
+	VoteWeightedMsgURL = sdk.MsgTypeURL(&govv1.MsgVoteWeighted{})
+)
+
+// Approve grants the grantee a generic authorization to send the given governance
+// messages on behalf of the origin. Returns a boolean value indicating whether
+// the operation succeeded.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := checkApprovalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+	for _, typeURL := range typeURLs {
+		switch typeURL {
+		case SubmitProposalMsgURL, DepositMsgURL, VoteMsgURL, VoteWeightedMsgURL:
+			genericAuthz := authz.NewGenericAuthorization(typeURL)
+			if err := p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), origin.Bytes(), genericAuthz, &expiration); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "gov", typeURL)
+		}
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, grantee, origin, typeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorization grants given in the typeUrls for a given granter to a given grantee.
+// It only works if the origin matches the granter to avoid unauthorized revocations.
+// Works only for governance messages.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		switch typeURL {
+		case SubmitProposalMsgURL, DepositMsgURL, VoteMsgURL, VoteWeightedMsgURL:
+			if err = p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), typeURL); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "gov", typeURL)
+		}
+	}
+
+	if err = authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  origin,
+			Grantee:  grantee,
+			TypeUrls: typeURLs,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkSigner checks that the contract caller is allowed to send the governance
+// message signed by the given address. A caller can always act on its own behalf,
+// e.g. a contract voting with its staked position. Otherwise, the signer must be
+// the origin and it must have granted the caller an authorization for the message.
+func (p Precompile) checkSigner(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	signer common.Address,
+	msgURL string,
+) error {
+	if contract.CallerAddress == signer {
+		return nil
+	}
+
+	if origin != signer {
+		return fmt.Errorf(ErrDifferentOrigin, origin, signer)
+	}
+
+	_, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, origin, msgURL)
+	return err
+}
@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+const (
+	// ErrDifferentOrigin is raised when the tx origin address is not the same as the signer of the
+	// governance message and the caller isn't the signer either.
+	ErrDifferentOrigin = "tx origin address %s does not match the signer address %s"
+	// ErrInvalidProposalJSON is raised when the proposal can't be decoded from its JSON encoding.
+	ErrInvalidProposalJSON = "invalid proposal JSON: %s"
+	// ErrInvalidVoter is raised when the voter address is not valid.
+	ErrInvalidVoter = "invalid voter address: %v"
+)
@@ -0,0 +1,112 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+const (
+	// EventTypeSubmitProposal defines the event type for the gov SubmitProposal transaction.
+	EventTypeSubmitProposal = "SubmitProposal"
+	// EventTypeDeposit defines the event type for the gov Deposit transaction.
+	EventTypeDeposit = "Deposit"
+	// EventTypeVote defines the event type for the gov Vote transaction.
+	EventTypeVote = "Vote"
+	// EventTypeVoteWeighted defines the event type for the gov VoteWeighted transaction.
+	EventTypeVoteWeighted = "VoteWeighted"
+)
+
+// EmitApprovalEvent creates a new approval event emitted on an Approve transaction.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, grantee, granter common.Address, typeURLs []string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[authorization.EventTypeApproval]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	packed, err := event.Inputs.NonIndexed().Pack(typeURLs)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitSubmitProposalEvent creates a new event emitted on a SubmitProposal transaction.
+func (p Precompile) EmitSubmitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposer common.Address, proposalID uint64) error {
+	return p.emitSignerEvent(ctx, stateDB, EventTypeSubmitProposal, proposer, proposalID)
+}
+
+// EmitDepositEvent creates a new event emitted on a Deposit transaction.
+func (p Precompile) EmitDepositEvent(ctx sdk.Context, stateDB vm.StateDB, depositor common.Address, proposalID uint64, amount sdk.Coins) error {
+	return p.emitSignerEvent(ctx, stateDB, EventTypeDeposit, depositor, proposalID, cmn.NewCoinsResponse(amount))
+}
+
+// EmitVoteEvent creates a new event emitted on a Vote transaction.
+func (p Precompile) EmitVoteEvent(ctx sdk.Context, stateDB vm.StateDB, voter common.Address, proposalID uint64, option uint8) error {
+	return p.emitSignerEvent(ctx, stateDB, EventTypeVote, voter, proposalID, option)
+}
+
+// EmitVoteWeightedEvent creates a new event emitted on a VoteWeighted transaction.
+func (p Precompile) EmitVoteWeightedEvent(ctx sdk.Context, stateDB vm.StateDB, voter common.Address, proposalID uint64, options []WeightedVoteOption) error {
+	return p.emitSignerEvent(ctx, stateDB, EventTypeVoteWeighted, voter, proposalID, options)
+}
+
+// emitSignerEvent emits the given event, whose only indexed argument is the
+// signer of the governance message, with the given data.
+func (p Precompile) emitSignerEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, signer common.Address, data ...interface{}) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(signer)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
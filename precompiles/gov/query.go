@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// GetProposalMethod defines the ABI method name for the gov Proposal query.
+	GetProposalMethod = "getProposal"
+	// GetTallyResultMethod defines the ABI method name for the gov TallyResult query.
+	GetTallyResultMethod = "getTallyResult"
+	// GetVoteMethod defines the ABI method name for the gov Vote query.
+	GetVoteMethod = "getVote"
+	// GetVotesMethod defines the ABI method name for the gov Votes query.
+	GetVotesMethod = "getVotes"
+)
+
+// GetProposal returns the proposal with the given ID.
+func (p Precompile) GetProposal(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewProposalRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.Proposal(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(ProposalData).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetTallyResult returns the current tally of the proposal with the given ID,
+// or its final tally once the voting period has ended.
+func (p Precompile) GetTallyResult(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewTallyResultRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.TallyResult(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(TallyResultData).FromResponse(res)
+
+	return method.Outputs.Pack(out)
+}
+
+// GetVote returns the vote of the given voter on the proposal with the given ID.
+func (p Precompile) GetVote(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVoteRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.Vote(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(WeightedVote).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetVotes returns the votes on the proposal with the given ID.
+func (p Precompile) GetVotes(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewVotesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.govKeeper.Votes(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GetVotesOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
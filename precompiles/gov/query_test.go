@@ -0,0 +1,214 @@
+package gov_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/gov"
+)
+
+func (s *PrecompileTestSuite) TestGetProposal() {
+	method := s.precompile.Methods[gov.GetProposalMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - proposal not found",
+			func() []interface{} {
+				return []interface{}{uint64(10)}
+			},
+			func([]byte) {},
+			true,
+			"doesn't exist",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)}
+			},
+			func(bz []byte) {
+				var out struct {
+					Proposal gov.ProposalData
+				}
+				err := s.precompile.UnpackIntoInterface(&out, gov.GetProposalMethod, bz)
+				s.Require().NoError(err)
+
+				proposal := out.Proposal
+				s.Require().Equal(uint64(1), proposal.ID)
+				s.Require().Empty(proposal.Messages)
+				s.Require().Equal(uint32(govv1.StatusVotingPeriod), proposal.Status)
+				s.Require().Equal("0", proposal.FinalTallyResult.Yes)
+				s.Require().NotZero(proposal.SubmitTime)
+				s.Require().NotZero(proposal.VotingEndTime)
+				s.Require().Equal(cmn.NewCoinsResponse(s.minDeposit), proposal.TotalDeposit)
+				s.Require().Equal("Text", proposal.Title)
+				s.Require().Equal(s.keyring.GetAddr(0), proposal.Proposer)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.GetProposal(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetTallyResult() {
+	method := s.precompile.Methods[gov.GetTallyResultMethod]
+
+	s.Run("success", func() {
+		s.SetupTest()
+		proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+		s.vote(s.keyring.GetAccAddr(0), proposalID, govv1.OptionYes)
+
+		bz, err := s.precompile.GetTallyResult(s.network.GetContext(), &method, []interface{}{proposalID})
+		s.Require().NoError(err)
+
+		var out struct {
+			TallyResult gov.TallyResultData
+		}
+		err = s.precompile.UnpackIntoInterface(&out, gov.GetTallyResultMethod, bz)
+		s.Require().NoError(err)
+
+		// the first account delegated to all validators at genesis
+		s.Require().NotEqual("0", out.TallyResult.Yes)
+		s.Require().Equal("0", out.TallyResult.No)
+		s.Require().Equal("0", out.TallyResult.Abstain)
+		s.Require().Equal("0", out.TallyResult.NoWithVeto)
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetVote() {
+	method := s.precompile.Methods[gov.GetVoteMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - vote not found",
+			func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				return []interface{}{proposalID, s.keyring.GetAddr(1)}
+			},
+			func([]byte) {},
+			true,
+			"not found",
+		},
+		{
+			"success",
+			func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				s.vote(s.keyring.GetAccAddr(1), proposalID, govv1.OptionAbstain)
+				return []interface{}{proposalID, s.keyring.GetAddr(1)}
+			},
+			func(bz []byte) {
+				var out struct {
+					Vote gov.WeightedVote
+				}
+				err := s.precompile.UnpackIntoInterface(&out, gov.GetVoteMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(uint64(1), out.Vote.ProposalID)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Vote.Voter)
+				s.Require().Equal([]gov.WeightedVoteOption{{Option: uint8(govv1.OptionAbstain), Weight: "1.000000000000000000"}}, out.Vote.Options)
+			},
+			false,
+			"",
+		},
+		{
+			"success - voter address containing val",
+			func() []interface{} {
+				// evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqrzel5valy
+				voter := common.HexToAddress("0x0000000000000000000000000000000000000C59")
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				s.vote(voter.Bytes(), proposalID, govv1.OptionYes)
+				return []interface{}{proposalID, voter}
+			},
+			func(bz []byte) {
+				var out struct {
+					Vote gov.WeightedVote
+				}
+				err := s.precompile.UnpackIntoInterface(&out, gov.GetVoteMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(common.HexToAddress("0x0000000000000000000000000000000000000C59"), out.Vote.Voter)
+				s.Require().Equal([]gov.WeightedVoteOption{{Option: uint8(govv1.OptionYes), Weight: "1.000000000000000000"}}, out.Vote.Options)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.GetVote(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetVotes() {
+	method := s.precompile.Methods[gov.GetVotesMethod]
+
+	s.Run("success - paginated votes", func() {
+		s.SetupTest()
+		proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+		s.vote(s.keyring.GetAccAddr(0), proposalID, govv1.OptionYes)
+		s.vote(s.keyring.GetAccAddr(1), proposalID, govv1.OptionNo)
+
+		bz, err := s.precompile.GetVotes(s.network.GetContext(), &method, []interface{}{
+			proposalID,
+			query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out gov.GetVotesOutput
+		err = s.precompile.UnpackIntoInterface(&out, gov.GetVotesMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Len(out.Votes, 1)
+		s.Require().Equal(uint64(2), out.PageResponse.Total)
+		s.Require().NotEmpty(out.PageResponse.NextKey)
+	})
+}
@@ -0,0 +1,65 @@
+package gov_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v15/precompiles/gov"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the gov precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	bondDenom string
+	// minDeposit is the deposit required for a proposal to enter the voting period.
+	minDeposit sdk.Coins
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+	stateDB *statedb.StateDB
+
+	precompile *gov.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	ctx := integrationNetwork.GetContext()
+	bondDenom := integrationNetwork.App.StakingKeeper.BondDenom(ctx)
+	s.Require().NotEmpty(bondDenom, "bond denom cannot be empty")
+
+	s.bondDenom = bondDenom
+	s.minDeposit = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	s.keyring = keyring
+	s.network = integrationNetwork
+	s.stateDB = integrationNetwork.GetStateDB()
+
+	params := integrationNetwork.App.GovKeeper.GetParams(ctx)
+	params.MinDeposit = s.minDeposit
+	err := integrationNetwork.App.GovKeeper.SetParams(ctx, params)
+	s.Require().NoError(err, "failed to set gov params")
+
+	precompile, err := gov.NewPrecompile(
+		integrationNetwork.App.GovKeeper,
+		integrationNetwork.App.AuthzKeeper,
+		integrationNetwork.App.AppCodec(),
+	)
+	s.Require().NoError(err, "failed to create gov precompile")
+	s.precompile = precompile
+}
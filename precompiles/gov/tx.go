@@ -0,0 +1,211 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+const (
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// VoteMethod defines the ABI method name for the gov Vote transaction.
+	VoteMethod = "vote"
+	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
+	VoteWeightedMethod = "voteWeighted"
+)
+
+// SubmitProposal submits a new governance proposal with the given initial deposit.
+func (p Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(args, method, p.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ proposer: %s, title: %s, initial_deposit: %s }",
+			proposerHexAddr, msg.Title, msg.InitialDeposit,
+		),
+	)
+
+	if err := p.checkSigner(ctx, origin, contract, proposerHexAddr, SubmitProposalMsgURL); err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	evmStateDB, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(cmn.ErrNotRunInEvm)
+	}
+	evmStateDB.SyncBalance(proposerHexAddr)
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit deposits funds to a governance proposal.
+func (p Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ depositor: %s, proposal_id: %d, amount: %s }",
+			depositorHexAddr, msg.ProposalId, sdk.Coins(msg.Amount),
+		),
+	)
+
+	if err := p.checkSigner(ctx, origin, contract, depositorHexAddr, DepositMsgURL); err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	evmStateDB, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(cmn.ErrNotRunInEvm)
+	}
+	evmStateDB.SyncBalance(depositorHexAddr)
+
+	return method.Outputs.Pack(true)
+}
+
+// Vote casts a vote on a governance proposal.
+func (p Precompile) Vote(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterHexAddr, err := NewMsgVote(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, option: %s }",
+			voterHexAddr, msg.ProposalId, msg.Option,
+		),
+	)
+
+	if err := p.checkSigner(ctx, origin, contract, voterHexAddr, VoteMsgURL); err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Vote(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVoteEvent(ctx, stateDB, voterHexAddr, msg.ProposalId, uint8(msg.Option)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// VoteWeighted casts a weighted vote on a governance proposal, splitting the
+// voting power between the given options.
+func (p Precompile) VoteWeighted(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterHexAddr, err := NewMsgVoteWeighted(args, method)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ voter: %s, proposal_id: %d, options: %s }",
+			voterHexAddr, msg.ProposalId, msg.Options,
+		),
+	)
+
+	if err := p.checkSigner(ctx, origin, contract, voterHexAddr, VoteWeightedMsgURL); err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.VoteWeighted(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	options := make([]WeightedVoteOption, len(msg.Options))
+	for i, option := range msg.Options {
+		options[i] = WeightedVoteOption{Option: uint8(option.Option), Weight: option.Weight}
+	}
+
+	if err = p.EmitVoteWeightedEvent(ctx, stateDB, voterHexAddr, msg.ProposalId, options); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,389 @@
+package gov_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/gov"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+// txTestCase is a test case for the gov precompile transactions. The origin is
+// always the first keyring account.
+type txTestCase struct {
+	name        string
+	caller      func() common.Address
+	malleate    func() []interface{}
+	postCheck   func(data []byte)
+	expError    bool
+	errContains string
+}
+
+// runTxTestCases runs the given transaction on each test case.
+func (s *PrecompileTestSuite) runTxTestCases(methodName string, testCases []txTestCase) {
+	method := s.precompile.Methods[methodName]
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			origin := s.keyring.GetAddr(0)
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller(), s.precompile, 200_000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch methodName {
+			case gov.SubmitProposalMethod:
+				bz, err = s.precompile.SubmitProposal(ctx, origin, contract, s.stateDB, &method, args)
+			case gov.DepositMethod:
+				bz, err = s.precompile.Deposit(ctx, origin, contract, s.stateDB, &method, args)
+			case gov.VoteMethod:
+				bz, err = s.precompile.Vote(ctx, origin, contract, s.stateDB, &method, args)
+			case gov.VoteWeightedMethod:
+				bz, err = s.precompile.VoteWeighted(ctx, origin, contract, s.stateDB, &method, args)
+			case authorization.ApproveMethod:
+				bz, err = s.precompile.Approve(ctx, origin, s.stateDB, &method, args)
+			case authorization.RevokeMethod:
+				bz, err = s.precompile.Revoke(ctx, origin, s.stateDB, &method, args)
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+// approve grants the grantee the authorization to send the given message on
+// behalf of the first keyring account.
+func (s *PrecompileTestSuite) approve(grantee common.Address, typeURL string) {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+	_, err := s.precompile.Approve(s.network.GetContext(), s.keyring.GetAddr(0), s.stateDB, &method, []interface{}{grantee, []string{typeURL}})
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestApprove() {
+	grantee := utiltx.GenerateAddress()
+
+	s.runTxTestCases(authorization.ApproveMethod, []txTestCase{
+		{
+			name:   "fail - empty input args",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:   "fail - not a gov message",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{grantee, []string{gov.VoteMsgURL, "/cosmos.bank.v1beta1.MsgSend"}}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidMsgType, "gov", "/cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			name:   "success",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{grantee, []string{gov.VoteMsgURL, gov.DepositMsgURL}}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				for _, typeURL := range []string{gov.VoteMsgURL, gov.DepositMsgURL} {
+					authz, _ := s.network.App.AuthzKeeper.GetAuthorization(s.network.GetContext(), grantee.Bytes(), s.keyring.GetAccAddr(0), typeURL)
+					s.Require().NotNil(authz, typeURL)
+				}
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	grantee := utiltx.GenerateAddress()
+
+	s.runTxTestCases(authorization.RevokeMethod, []txTestCase{
+		{
+			name:   "fail - no authorization",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{grantee, []string{gov.VoteMsgURL}}
+			},
+			expError:    true,
+			errContains: "authorization not found",
+		},
+		{
+			name:   "success",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				s.approve(grantee, gov.VoteMsgURL)
+				return []interface{}{grantee, []string{gov.VoteMsgURL}}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				authz, _ := s.network.App.AuthzKeeper.GetAuthorization(s.network.GetContext(), grantee.Bytes(), s.keyring.GetAccAddr(0), gov.VoteMsgURL)
+				s.Require().Nil(authz)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	recipient := utiltx.GenerateAddress()
+	deposit := []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(1000)}}
+
+	s.runTxTestCases(gov.SubmitProposalMethod, []txTestCase{
+		{
+			name:   "fail - empty input args",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:   "fail - invalid proposal JSON",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), []byte("{"), deposit}
+			},
+			expError:    true,
+			errContains: "invalid proposal JSON",
+		},
+		{
+			name:   "fail - proposer is neither the caller nor the origin",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.proposalJSON(recipient, 1), deposit}
+			},
+			expError:    true,
+			errContains: "does not match the signer address",
+		},
+		{
+			name:   "fail - contract caller without authorization",
+			caller: func() common.Address { return utiltx.GenerateAddress() },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.proposalJSON(recipient, 1), deposit}
+			},
+			expError:    true,
+			errContains: "does not exist or is expired",
+		},
+		{
+			name:   "success",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.proposalJSON(recipient, 1), deposit}
+			},
+			postCheck: func(data []byte) {
+				out, err := s.precompile.Unpack(gov.SubmitProposalMethod, data)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, found := s.network.App.GovKeeper.GetProposal(s.network.GetContext(), proposalID)
+				s.Require().True(found)
+				s.Require().Equal(sdk.AccAddress(s.keyring.GetAddr(0).Bytes()).String(), proposal.Proposer)
+				s.Require().Equal(govv1.StatusVotingPeriod, proposal.Status)
+				s.Require().Len(proposal.Messages, 1)
+
+				// the deposit is mirrored to the stateDB balance
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.bondDenom)
+				s.Require().Equal(balance.Amount.BigInt(), s.stateDB.GetBalance(s.keyring.GetAddr(0)))
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	contractAddr := utiltx.GenerateAddress()
+	amount := []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(500)}}
+
+	s.runTxTestCases(gov.DepositMethod, []txTestCase{
+		{
+			name:   "fail - invalid amount",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), nil)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, []cmn.Coin{{Denom: s.bondDenom, Amount: big.NewInt(-1)}}}
+			},
+			expError:    true,
+			errContains: "invalid amount",
+		},
+		{
+			name:   "fail - contract caller without authorization",
+			caller: func() common.Address { return contractAddr },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), nil)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, amount}
+			},
+			expError:    true,
+			errContains: "does not exist or is expired",
+		},
+		{
+			name:   "success - contract caller with authorization",
+			caller: func() common.Address { return contractAddr },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), nil)
+				s.approve(contractAddr, gov.DepositMsgURL)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, amount}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				deposit, found := s.network.App.GovKeeper.GetDeposit(s.network.GetContext(), 1, s.keyring.GetAccAddr(0))
+				s.Require().True(found)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 500)), sdk.NewCoins(deposit.Amount...))
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestVote() {
+	contractAddr := utiltx.GenerateAddress()
+
+	s.runTxTestCases(gov.VoteMethod, []txTestCase{
+		{
+			name:   "fail - invalid voter",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{common.Address{}, uint64(1), uint8(govv1.OptionYes), ""}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(gov.ErrInvalidVoter, common.Address{}),
+		},
+		{
+			name:   "fail - invalid option",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), uint64(1), uint8(5), ""}
+			},
+			expError:    true,
+			errContains: "invalid vote option",
+		},
+		{
+			name:   "fail - proposal not in voting period",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), nil)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, uint8(govv1.OptionYes), ""}
+			},
+			expError:    true,
+			errContains: "inactive proposal",
+		},
+		{
+			name:   "fail - contract caller without authorization",
+			caller: func() common.Address { return contractAddr },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, uint8(govv1.OptionYes), ""}
+			},
+			expError:    true,
+			errContains: "does not exist or is expired",
+		},
+		{
+			name:   "success - contract votes on its own behalf",
+			caller: func() common.Address { return contractAddr },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				return []interface{}{contractAddr, proposalID, uint8(govv1.OptionNo), "treasury"}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				vote, found := s.network.App.GovKeeper.GetVote(s.network.GetContext(), 1, contractAddr.Bytes())
+				s.Require().True(found)
+				s.Require().Len(vote.Options, 1)
+				s.Require().Equal(govv1.OptionNo, vote.Options[0].Option)
+				s.Require().Equal("treasury", vote.Metadata)
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+		{
+			name:   "success - contract caller with authorization",
+			caller: func() common.Address { return contractAddr },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				s.approve(contractAddr, gov.VoteMsgURL)
+				return []interface{}{s.keyring.GetAddr(0), proposalID, uint8(govv1.OptionYes), ""}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				vote, found := s.network.App.GovKeeper.GetVote(s.network.GetContext(), 1, s.keyring.GetAccAddr(0))
+				s.Require().True(found)
+				s.Require().Len(vote.Options, 1)
+				s.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+			},
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestVoteWeighted() {
+	s.runTxTestCases(gov.VoteWeightedMethod, []txTestCase{
+		{
+			name:   "fail - invalid weight",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				options := []gov.WeightedVoteOption{{Option: uint8(govv1.OptionYes), Weight: "abc"}}
+				return []interface{}{s.keyring.GetAddr(0), uint64(1), options, ""}
+			},
+			expError:    true,
+			errContains: "invalid weight",
+		},
+		{
+			name:   "fail - weights don't add up to one",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				options := []gov.WeightedVoteOption{
+					{Option: uint8(govv1.OptionYes), Weight: "0.5"},
+					{Option: uint8(govv1.OptionNo), Weight: "0.2"},
+				}
+				return []interface{}{s.keyring.GetAddr(0), uint64(1), options, ""}
+			},
+			expError:    true,
+			errContains: "weight lower than 1.00",
+		},
+		{
+			name:   "success",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				proposalID := s.submitProposal(s.keyring.GetAccAddr(0), s.minDeposit)
+				options := []gov.WeightedVoteOption{
+					{Option: uint8(govv1.OptionYes), Weight: "0.7"},
+					{Option: uint8(govv1.OptionNo), Weight: "0.3"},
+				}
+				return []interface{}{s.keyring.GetAddr(0), proposalID, options, ""}
+			},
+			postCheck: func(data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				vote, found := s.network.App.GovKeeper.GetVote(s.network.GetContext(), 1, s.keyring.GetAccAddr(0))
+				s.Require().True(found)
+				s.Require().Len(vote.Options, 2)
+				s.Require().Equal(govv1.OptionYes, vote.Options[0].Option)
+				s.Require().Equal(sdk.MustNewDecFromStr("0.7").String(), vote.Options[0].Weight)
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+	})
+}
@@ -0,0 +1,438 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package gov
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"golang.org/x/exp/slices"
+)
+
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option uint8  `abi:"option"`
+	Weight string `abi:"weight"`
+}
+
+// WeightedVote represents a vote on a governance proposal.
+type WeightedVote struct {
+	ProposalID uint64               `abi:"proposalId"`
+	Voter      common.Address       `abi:"voter"`
+	Options    []WeightedVoteOption `abi:"options"`
+	Metadata   string               `abi:"metadata"`
+}
+
+// TallyResultData represents the tally result of a proposal.
+type TallyResultData struct {
+	Yes        string `abi:"yes"`
+	Abstain    string `abi:"abstain"`
+	No         string `abi:"no"`
+	NoWithVeto string `abi:"noWithVeto"`
+}
+
+// ProposalData represents a governance proposal. The messages are the type URLs
+// of the proposal messages and the times are UNIX timestamps.
+type ProposalData struct {
+	ID               uint64          `abi:"id"`
+	Messages         []string        `abi:"messages"`
+	Status           uint32          `abi:"status"`
+	FinalTallyResult TallyResultData `abi:"finalTallyResult"`
+	SubmitTime       uint64          `abi:"submitTime"`
+	DepositEndTime   uint64          `abi:"depositEndTime"`
+	VotingStartTime  uint64          `abi:"votingStartTime"`
+	VotingEndTime    uint64          `abi:"votingEndTime"`
+	TotalDeposit     []cmn.Coin      `abi:"totalDeposit"`
+	Metadata         string          `abi:"metadata"`
+	Title            string          `abi:"title"`
+	Summary          string          `abi:"summary"`
+	Proposer         common.Address  `abi:"proposer"`
+}
+
+// SubmitProposalInput is a struct used to parse the arguments of the
+// submitProposal transaction.
+type SubmitProposalInput struct {
+	Proposer     common.Address `abi:"proposer"`
+	JSONProposal []byte         `abi:"jsonProposal"`
+	Deposit      []cmn.Coin     `abi:"deposit"`
+}
+
+// DepositInput is a struct used to parse the arguments of the deposit transaction.
+type DepositInput struct {
+	Depositor  common.Address `abi:"depositor"`
+	ProposalID uint64         `abi:"proposalId"`
+	Amount     []cmn.Coin     `abi:"amount"`
+}
+
+// VoteWeightedInput is a struct used to parse the arguments of the voteWeighted
+// transaction.
+type VoteWeightedInput struct {
+	Voter      common.Address       `abi:"voter"`
+	ProposalID uint64               `abi:"proposalId"`
+	Options    []WeightedVoteOption `abi:"options"`
+	Metadata   string               `abi:"metadata"`
+}
+
+// GetVotesInput is a struct used to parse the arguments of the getVotes query.
+type GetVotesInput struct {
+	ProposalID uint64            `abi:"proposalId"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GetVotesOutput is a struct to represent the key information from a Votes response.
+type GetVotesOutput struct {
+	Votes        []WeightedVote
+	PageResponse query.PageResponse
+}
+
+// checkApprovalArgs checks the arguments passed to the approve function.
+func checkApprovalArgs(args []interface{}) (common.Address, []string, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	typeURLs, ok := args[1].([]string)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(authorization.ErrInvalidMethods, args[1])
+	}
+	if len(typeURLs) == 0 {
+		return common.Address{}, nil, fmt.Errorf(authorization.ErrEmptyMethods)
+	}
+	if slices.Contains(typeURLs, "") {
+		return common.Address{}, nil, fmt.Errorf(authorization.ErrEmptyStringInMethods, typeURLs)
+	}
+
+	return grantee, typeURLs, nil
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance from the proposer,
+// the JSON encoded proposal and the initial deposit. The proposer and the initial
+// deposit in the JSON encoded proposal, if any, are overridden.
+func NewMsgSubmitProposal(args []interface{}, method *abi.Method, cdc codec.Codec) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input SubmitProposalInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to SubmitProposalInput struct: %s", err)
+	}
+
+	msg := &govv1.MsgSubmitProposal{}
+	if err := cdc.UnmarshalJSON(input.JSONProposal, msg); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	deposit, err := newCoins(input.Deposit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg.Proposer = sdk.AccAddress(input.Proposer.Bytes()).String()
+	msg.InitialDeposit = deposit
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Proposer, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(args []interface{}, method *abi.Method) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input DepositInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to DepositInput struct: %s", err)
+	}
+
+	amount, err := newCoins(input.Amount)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := govv1.NewMsgDeposit(input.Depositor.Bytes(), input.ProposalID, amount)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Depositor, nil
+}
+
+// NewMsgVote creates a new MsgVote instance.
+func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	voter, ok := args[0].(common.Address)
+	if !ok || voter == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVoter, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "proposalId", uint64(0), args[1])
+	}
+
+	option, ok := args[2].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "option", uint8(0), args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "metadata", "", args[3])
+	}
+
+	msg := govv1.NewMsgVote(voter.Bytes(), proposalID, govv1.VoteOption(option), metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, voter, nil
+}
+
+// NewMsgVoteWeighted creates a new MsgVoteWeighted instance.
+func NewMsgVoteWeighted(args []interface{}, method *abi.Method) (*govv1.MsgVoteWeighted, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input VoteWeightedInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to VoteWeightedInput struct: %s", err)
+	}
+
+	options := make(govv1.WeightedVoteOptions, len(input.Options))
+	for i, option := range input.Options {
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return nil, common.Address{}, fmt.Errorf("invalid weight %q: %s", option.Weight, err)
+		}
+		options[i] = govv1.NewWeightedVoteOption(govv1.VoteOption(option.Option), weight)
+	}
+
+	msg := govv1.NewMsgVoteWeighted(input.Voter.Bytes(), input.ProposalID, options, input.Metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Voter, nil
+}
+
+// NewProposalRequest creates a new QueryProposalRequest instance.
+func NewProposalRequest(args []interface{}) (*govv1.QueryProposalRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "proposalId", uint64(0), args[0])
+	}
+
+	return &govv1.QueryProposalRequest{ProposalId: proposalID}, nil
+}
+
+// NewTallyResultRequest creates a new QueryTallyResultRequest instance.
+func NewTallyResultRequest(args []interface{}) (*govv1.QueryTallyResultRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "proposalId", uint64(0), args[0])
+	}
+
+	return &govv1.QueryTallyResultRequest{ProposalId: proposalID}, nil
+}
+
+// NewVoteRequest creates a new QueryVoteRequest instance.
+func NewVoteRequest(args []interface{}) (*govv1.QueryVoteRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "proposalId", uint64(0), args[0])
+	}
+
+	voter, ok := args[1].(common.Address)
+	if !ok || voter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidVoter, args[1])
+	}
+
+	return &govv1.QueryVoteRequest{
+		ProposalId: proposalID,
+		Voter:      sdk.AccAddress(voter.Bytes()).String(),
+	}, nil
+}
+
+// NewVotesRequest creates a new QueryVotesRequest instance.
+func NewVotesRequest(method *abi.Method, args []interface{}) (*govv1.QueryVotesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GetVotesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GetVotesInput struct: %s", err)
+	}
+
+	return &govv1.QueryVotesRequest{
+		ProposalId: input.ProposalID,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the ProposalData from a QueryProposalResponse.
+func (pd *ProposalData) FromResponse(res *govv1.QueryProposalResponse) (*ProposalData, error) {
+	proposal := res.Proposal
+
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	pd.ID = proposal.Id
+	pd.Messages = make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		pd.Messages[i] = msg.TypeUrl
+	}
+	pd.Status = uint32(proposal.Status)
+	if proposal.FinalTallyResult != nil {
+		pd.FinalTallyResult = newTallyResultData(proposal.FinalTallyResult)
+	}
+	pd.SubmitTime = unixTime(proposal.SubmitTime)
+	pd.DepositEndTime = unixTime(proposal.DepositEndTime)
+	pd.VotingStartTime = unixTime(proposal.VotingStartTime)
+	pd.VotingEndTime = unixTime(proposal.VotingEndTime)
+	pd.TotalDeposit = cmn.NewCoinsResponse(proposal.TotalDeposit)
+	pd.Metadata = proposal.Metadata
+	pd.Title = proposal.Title
+	pd.Summary = proposal.Summary
+	pd.Proposer = common.BytesToAddress(proposer)
+
+	return pd, nil
+}
+
+// FromResponse populates the TallyResultData from a QueryTallyResultResponse.
+func (tr *TallyResultData) FromResponse(res *govv1.QueryTallyResultResponse) *TallyResultData {
+	if res.Tally != nil {
+		*tr = newTallyResultData(res.Tally)
+	}
+	return tr
+}
+
+// FromResponse populates the WeightedVote from a QueryVoteResponse.
+func (wv *WeightedVote) FromResponse(res *govv1.QueryVoteResponse) (*WeightedVote, error) {
+	vote, err := newWeightedVote(res.Vote)
+	if err != nil {
+		return nil, err
+	}
+	*wv = vote
+	return wv, nil
+}
+
+// FromResponse populates the GetVotesOutput from a QueryVotesResponse.
+func (vo *GetVotesOutput) FromResponse(res *govv1.QueryVotesResponse) (*GetVotesOutput, error) {
+	vo.Votes = make([]WeightedVote, len(res.Votes))
+	for i, v := range res.Votes {
+		vote, err := newWeightedVote(v)
+		if err != nil {
+			return nil, err
+		}
+		vo.Votes[i] = vote
+	}
+
+	if res.Pagination != nil {
+		vo.PageResponse.Total = res.Pagination.Total
+		vo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return vo, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (vo *GetVotesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(vo.Votes, vo.PageResponse)
+}
+
+// newCoins converts the coins passed to the precompile into a valid set of
+// SDK coins.
+func newCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	sdkCoins := make(sdk.Coins, len(coins))
+	for i, coin := range coins {
+		if coin.Amount == nil || coin.Amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount for %s: %v", coin.Denom, coin.Amount)
+		}
+		sdkCoins[i] = coin.ToSDKType()
+	}
+
+	sdkCoins = sdkCoins.Sort()
+	if err := sdkCoins.Validate(); err != nil {
+		return nil, err
+	}
+	return sdkCoins, nil
+}
+
+// newWeightedVote converts a governance vote into a WeightedVote.
+func newWeightedVote(vote *govv1.Vote) (WeightedVote, error) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return WeightedVote{}, err
+	}
+
+	options := make([]WeightedVoteOption, len(vote.Options))
+	for i, option := range vote.Options {
+		options[i] = WeightedVoteOption{
+			Option: uint8(option.Option),
+			Weight: option.Weight,
+		}
+	}
+
+	return WeightedVote{
+		ProposalID: vote.ProposalId,
+		Voter:      common.BytesToAddress(voter),
+		Options:    options,
+		Metadata:   vote.Metadata,
+	}, nil
+}
+
+// newTallyResultData converts a governance tally result into a TallyResultData.
+func newTallyResultData(tally *govv1.TallyResult) TallyResultData {
+	return TallyResultData{
+		Yes:        tally.YesCount,
+		Abstain:    tally.AbstainCount,
+		No:         tally.NoCount,
+		NoWithVeto: tally.NoWithVetoCount,
+	}
+}
+
+// unixTime returns the UNIX timestamp of the given time, or zero if it's not set.
+func unixTime(t *time.Time) uint64 {
+	if t == nil {
+		return 0
+	}
+	return uint64(t.Unix())
+}
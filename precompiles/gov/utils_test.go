@@ -0,0 +1,53 @@
+package gov_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// proposalJSON returns the JSON encoded proposal to send the given amount from the
+// gov module account to the recipient.
+func (s *PrecompileTestSuite) proposalJSON(recipient common.Address, amount int64) []byte {
+	return []byte(fmt.Sprintf(`{
+		"messages": [{
+			"@type": "/cosmos.bank.v1beta1.MsgSend",
+			"from_address": "%s",
+			"to_address": "%s",
+			"amount": [{"denom": "%s", "amount": "%d"}]
+		}],
+		"metadata": "ipfs://proposal",
+		"title": "Community spend",
+		"summary": "Send funds to the treasury"
+	}`,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		sdk.AccAddress(recipient.Bytes()),
+		s.bondDenom,
+		amount,
+	))
+}
+
+// submitProposal is a helper function to submit a text proposal with the given
+// initial deposit through the gov module.
+func (s *PrecompileTestSuite) submitProposal(proposer sdk.AccAddress, deposit sdk.Coins) uint64 {
+	msg, err := govv1.NewMsgSubmitProposal(nil, deposit, proposer.String(), "ipfs://proposal", "Text", "Text proposal")
+	s.Require().NoError(err)
+
+	msgSrv := govkeeper.NewMsgServerImpl(&s.network.App.GovKeeper)
+	res, err := msgSrv.SubmitProposal(sdk.WrapSDKContext(s.network.GetContext()), msg)
+	s.Require().NoError(err, "failed to submit proposal")
+
+	return res.ProposalId
+}
+
+// vote is a helper function to vote on a proposal through the gov module.
+func (s *PrecompileTestSuite) vote(voter sdk.AccAddress, proposalID uint64, option govv1.VoteOption) {
+	msgSrv := govkeeper.NewMsgServerImpl(&s.network.App.GovKeeper)
+	_, err := msgSrv.Vote(sdk.WrapSDKContext(s.network.GetContext()), govv1.NewMsgVote(voter, proposalID, option, ""))
+	s.Require().NoError(err, "failed to vote")
+}
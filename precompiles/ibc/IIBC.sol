@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IIBC contract's address.
+address constant IBC_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IIBC contract's instance.
+IIBC constant IBC_CONTRACT = IIBC(IBC_PRECOMPILE_ADDRESS);
+
+/// @dev ChannelState defines the state of an IBC channel end.
+enum ChannelState {
+    UNINITIALIZED,
+    INIT,
+    TRYOPEN,
+    OPEN,
+    CLOSED
+}
+
+/// @dev ChannelOrder defines the ordering of the packets sent on an IBC channel.
+enum ChannelOrder {
+    NONE,
+    UNORDERED,
+    ORDERED
+}
+
+/// @dev ConnectionState defines the state of an IBC connection end.
+enum ConnectionState {
+    UNINITIALIZED,
+    INIT,
+    TRYOPEN,
+    OPEN
+}
+
+/// @dev Channel represents an IBC channel end.
+struct Channel {
+    ChannelState state;
+    ChannelOrder ordering;
+    string counterpartyPortId;
+    string counterpartyChannelId;
+    string[] connectionHops;
+    string version;
+}
+
+/// @dev IdentifiedChannel represents an IBC channel end together with its
+/// port and channel identifiers.
+struct IdentifiedChannel {
+    string portId;
+    string channelId;
+    ChannelState state;
+    ChannelOrder ordering;
+    string counterpartyPortId;
+    string counterpartyChannelId;
+    string[] connectionHops;
+    string version;
+}
+
+/// @dev Connection represents an IBC connection end. The delay period is
+/// expressed in nanoseconds.
+struct Connection {
+    string clientId;
+    ConnectionState state;
+    string counterpartyClientId;
+    string counterpartyConnectionId;
+    uint64 delayPeriod;
+}
+
+/// @dev ClientState represents the key information of an IBC light client.
+/// The status is one of Active, Frozen, Expired, Unknown or Unauthorized.
+struct ClientState {
+    string clientType;
+    string status;
+    Height latestHeight;
+}
+
+/// @author Evmos Team
+/// @title IBC Precompiled Contract
+/// @dev The interface through which solidity contracts can query the IBC core
+/// state, e.g. to validate a route before sending an ICS20 transfer.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IIBC {
+    /// QUERIES
+
+    /// @dev Queries an IBC channel end.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The channel identifier.
+    /// @return channel The channel end.
+    function channel(
+        string memory portId,
+        string memory channelId
+    ) external view returns (Channel memory channel);
+
+    /// @dev Queries all the IBC channel ends.
+    /// @param pagination The pagination options.
+    /// @return channels The channel ends.
+    /// @return pageResponse The pagination response.
+    function channels(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            IdentifiedChannel[] memory channels,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Queries an IBC connection end.
+    /// @param connectionId The connection identifier.
+    /// @return connection The connection end.
+    function connection(
+        string memory connectionId
+    ) external view returns (Connection memory connection);
+
+    /// @dev Queries the type, status and latest height of an IBC light client.
+    /// @param clientId The client identifier.
+    /// @return clientState The key information of the client.
+    function clientState(
+        string memory clientId
+    ) external view returns (ClientState memory clientState);
+
+    /// @dev Queries the sequence of the next packet to be sent on a channel.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The channel identifier.
+    /// @return sequence The next send sequence.
+    function nextSequenceSend(
+        string memory portId,
+        string memory channelId
+    ) external view returns (uint64 sequence);
+}
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+const (
+	// ErrInvalidPortID is raised when the port identifier is not valid.
+	ErrInvalidPortID = "invalid port ID: %v"
+	// ErrInvalidChannelID is raised when the channel identifier is not valid.
+	ErrInvalidChannelID = "invalid channel ID: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidClientID is raised when the client identifier is not valid.
+	ErrInvalidClientID = "invalid client ID: %v"
+)
@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// ChannelMethod defines the ABI method name for the IBC Channel query.
+	ChannelMethod = "channel"
+	// ChannelsMethod defines the ABI method name for the IBC Channels query.
+	ChannelsMethod = "channels"
+	// ConnectionMethod defines the ABI method name for the IBC Connection query.
+	ConnectionMethod = "connection"
+	// ClientStateMethod defines the ABI method name for the IBC ClientState query.
+	ClientStateMethod = "clientState"
+	// NextSequenceSendMethod defines the ABI method name for the IBC NextSequenceSend query.
+	NextSequenceSendMethod = "nextSequenceSend"
+)
+
+// Channel returns the channel end for the given port and channel identifiers.
+func (p Precompile) Channel(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := NewChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := p.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return method.Outputs.Pack(NewChannel(channel))
+}
+
+// Channels returns all the channel ends of the chain.
+func (p Precompile) Channels(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewChannelsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.channelKeeper.Channels(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(ChannelsOutput).FromResponse(res).Pack(method.Outputs)
+}
+
+// Connection returns the connection end for the given connection identifier.
+func (p Precompile) Connection(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, err := NewConnectionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, found := p.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return nil, errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "connection ID (%s)", connectionID)
+	}
+
+	return method.Outputs.Pack(NewConnection(connection))
+}
+
+// ClientState returns the type, status and latest height of the light client
+// with the given client identifier.
+func (p Precompile) ClientState(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := NewClientArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, found := p.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client ID (%s)", clientID)
+	}
+
+	latestHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "client ID (%s)", clientID)
+	}
+
+	return method.Outputs.Pack(ClientState{
+		ClientType:   clientState.ClientType(),
+		Status:       p.clientKeeper.GetClientStatus(ctx, clientState, clientID).String(),
+		LatestHeight: latestHeight,
+	})
+}
+
+// NextSequenceSend returns the sequence of the next packet to be sent on the
+// given port and channel.
+func (p Precompile) NextSequenceSend(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := NewChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, found := p.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return method.Outputs.Pack(sequence)
+}
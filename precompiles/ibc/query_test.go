@@ -0,0 +1,258 @@
+package ibc_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/ibc"
+)
+
+func (s *PrecompileTestSuite) TestChannel() {
+	method := s.precompile.Methods[ibc.ChannelMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{transfertypes.PortID}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - invalid channel ID",
+			func() []interface{} {
+				return []interface{}{transfertypes.PortID, "c"}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(ibc.ErrInvalidChannelID, "c"),
+		},
+		{
+			"fail - channel not found",
+			func() []interface{} {
+				return []interface{}{transfertypes.PortID, "channel-99"}
+			},
+			func([]byte) {},
+			true,
+			channeltypes.ErrChannelNotFound.Error(),
+		},
+		{
+			"success",
+			func() []interface{} {
+				s.setupChannel()
+				return []interface{}{transfertypes.PortID, testChannelID}
+			},
+			func(bz []byte) {
+				var out struct {
+					Channel ibc.Channel
+				}
+				err := s.precompile.UnpackIntoInterface(&out, ibc.ChannelMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(uint8(channeltypes.OPEN), out.Channel.State)
+				s.Require().Equal(uint8(channeltypes.UNORDERED), out.Channel.Ordering)
+				s.Require().Equal(transfertypes.PortID, out.Channel.CounterpartyPortID)
+				s.Require().Equal("channel-7", out.Channel.CounterpartyChannelID)
+				s.Require().Equal([]string{exported.LocalhostConnectionID}, out.Channel.ConnectionHops)
+				s.Require().Equal(transfertypes.Version, out.Channel.Version)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Channel(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestChannels() {
+	method := s.precompile.Methods[ibc.ChannelsMethod]
+
+	s.Run("success - paginated channels", func() {
+		s.SetupTest()
+		s.setupChannel()
+
+		bz, err := s.precompile.Channels(s.network.GetContext(), &method, []interface{}{
+			query.PageRequest{Limit: 10, CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out ibc.ChannelsOutput
+		err = s.precompile.UnpackIntoInterface(&out, ibc.ChannelsMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().Len(out.Channels, 1)
+		s.Require().Equal(uint64(1), out.PageResponse.Total)
+		s.Require().Equal(transfertypes.PortID, out.Channels[0].PortID)
+		s.Require().Equal(testChannelID, out.Channels[0].ChannelID)
+		s.Require().Equal(uint8(channeltypes.OPEN), out.Channels[0].State)
+		s.Require().Equal("channel-7", out.Channels[0].CounterpartyChannelID)
+	})
+}
+
+func (s *PrecompileTestSuite) TestConnection() {
+	method := s.precompile.Methods[ibc.ConnectionMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			[]interface{}{},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - connection not found",
+			[]interface{}{"connection-99"},
+			func([]byte) {},
+			true,
+			connectiontypes.ErrConnectionNotFound.Error(),
+		},
+		{
+			"success - localhost connection",
+			[]interface{}{exported.LocalhostConnectionID},
+			func(bz []byte) {
+				var out struct {
+					Connection ibc.Connection
+				}
+				err := s.precompile.UnpackIntoInterface(&out, ibc.ConnectionMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(exported.LocalhostClientID, out.Connection.ClientID)
+				s.Require().Equal(uint8(connectiontypes.OPEN), out.Connection.State)
+				s.Require().Equal(exported.LocalhostClientID, out.Connection.CounterpartyClientID)
+				s.Require().Equal(exported.LocalhostConnectionID, out.Connection.CounterpartyConnectionID)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Connection(s.network.GetContext(), &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClientState() {
+	method := s.precompile.Methods[ibc.ClientStateMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid client ID",
+			[]interface{}{"c"},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(ibc.ErrInvalidClientID, "c"),
+		},
+		{
+			"fail - client not found",
+			[]interface{}{"07-tendermint-99"},
+			func([]byte) {},
+			true,
+			clienttypes.ErrClientNotFound.Error(),
+		},
+		{
+			"success - localhost client",
+			[]interface{}{exported.LocalhostClientID},
+			func(bz []byte) {
+				var out struct {
+					ClientState ibc.ClientState
+				}
+				err := s.precompile.UnpackIntoInterface(&out, ibc.ClientStateMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(exported.Localhost, out.ClientState.ClientType)
+				s.Require().Equal(exported.Active.String(), out.ClientState.Status)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.ClientState(s.network.GetContext(), &method, tc.args)
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNextSequenceSend() {
+	method := s.precompile.Methods[ibc.NextSequenceSendMethod]
+
+	s.Run("fail - sequence not found", func() {
+		s.SetupTest()
+
+		_, err := s.precompile.NextSequenceSend(s.network.GetContext(), &method, []interface{}{transfertypes.PortID, "channel-99"})
+		s.Require().ErrorContains(err, channeltypes.ErrSequenceSendNotFound.Error())
+	})
+
+	s.Run("success", func() {
+		s.SetupTest()
+		s.setupChannel()
+
+		bz, err := s.precompile.NextSequenceSend(s.network.GetContext(), &method, []interface{}{transfertypes.PortID, testChannelID})
+		s.Require().NoError(err)
+
+		out, err := method.Outputs.Unpack(bz)
+		s.Require().NoError(err)
+		s.Require().Equal(testSequence, out[0])
+	})
+}
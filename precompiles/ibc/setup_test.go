@@ -0,0 +1,47 @@
+package ibc_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v15/precompiles/ibc"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the IBC precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *ibc.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := ibc.NewPrecompile(
+		integrationNetwork.App.IBCKeeper.ChannelKeeper,
+		integrationNetwork.App.IBCKeeper.ConnectionKeeper,
+		integrationNetwork.App.IBCKeeper.ClientKeeper,
+		integrationNetwork.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create IBC precompile")
+	s.precompile = precompile
+}
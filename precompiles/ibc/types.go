@@ -0,0 +1,185 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// Channel represents an IBC channel end. The state and ordering are the
+// numeric values of the IBC channel State and Order enums.
+type Channel struct {
+	State                 uint8    `abi:"state"`
+	Ordering              uint8    `abi:"ordering"`
+	CounterpartyPortID    string   `abi:"counterpartyPortId"`
+	CounterpartyChannelID string   `abi:"counterpartyChannelId"`
+	ConnectionHops        []string `abi:"connectionHops"`
+	Version               string   `abi:"version"`
+}
+
+// IdentifiedChannel represents an IBC channel end together with its port and
+// channel identifiers.
+type IdentifiedChannel struct {
+	PortID                string   `abi:"portId"`
+	ChannelID             string   `abi:"channelId"`
+	State                 uint8    `abi:"state"`
+	Ordering              uint8    `abi:"ordering"`
+	CounterpartyPortID    string   `abi:"counterpartyPortId"`
+	CounterpartyChannelID string   `abi:"counterpartyChannelId"`
+	ConnectionHops        []string `abi:"connectionHops"`
+	Version               string   `abi:"version"`
+}
+
+// Connection represents an IBC connection end. The state is the numeric value
+// of the IBC connection State enum and the delay period is in nanoseconds.
+type Connection struct {
+	ClientID                 string `abi:"clientId"`
+	State                    uint8  `abi:"state"`
+	CounterpartyClientID     string `abi:"counterpartyClientId"`
+	CounterpartyConnectionID string `abi:"counterpartyConnectionId"`
+	DelayPeriod              uint64 `abi:"delayPeriod"`
+}
+
+// ClientState represents the key information of an IBC light client: its type,
+// its status (e.g. Active, Frozen or Expired) and the latest height it tracks.
+type ClientState struct {
+	ClientType   string             `abi:"clientType"`
+	Status       string             `abi:"status"`
+	LatestHeight clienttypes.Height `abi:"latestHeight"`
+}
+
+// ChannelsInput is a struct used to parse the arguments of the channels query.
+type ChannelsInput struct {
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// ChannelsOutput is a struct to represent the key information from a Channels response.
+type ChannelsOutput struct {
+	Channels     []IdentifiedChannel
+	PageResponse query.PageResponse
+}
+
+// NewChannelArgs parses the port and channel identifiers used by the channel
+// and nextSequenceSend queries.
+func NewChannelArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	portID, ok := args[0].(string)
+	if !ok || host.PortIdentifierValidator(portID) != nil {
+		return "", "", fmt.Errorf(ErrInvalidPortID, args[0])
+	}
+
+	channelID, ok := args[1].(string)
+	if !ok || host.ChannelIdentifierValidator(channelID) != nil {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, args[1])
+	}
+
+	return portID, channelID, nil
+}
+
+// NewConnectionArgs parses the connection identifier used by the connection query.
+func NewConnectionArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok || host.ConnectionIdentifierValidator(connectionID) != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, args[0])
+	}
+
+	return connectionID, nil
+}
+
+// NewClientArgs parses the client identifier used by the clientState query.
+func NewClientArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	clientID, ok := args[0].(string)
+	if !ok || host.ClientIdentifierValidator(clientID) != nil {
+		return "", fmt.Errorf(ErrInvalidClientID, args[0])
+	}
+
+	return clientID, nil
+}
+
+// NewChannelsRequest creates a new QueryChannelsRequest instance.
+func NewChannelsRequest(method *abi.Method, args []interface{}) (*channeltypes.QueryChannelsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input ChannelsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to ChannelsInput struct: %s", err)
+	}
+
+	return &channeltypes.QueryChannelsRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewChannel converts an IBC channel end into a Channel.
+func NewChannel(channel channeltypes.Channel) Channel {
+	return Channel{
+		State:                 uint8(channel.State),
+		Ordering:              uint8(channel.Ordering),
+		CounterpartyPortID:    channel.Counterparty.PortId,
+		CounterpartyChannelID: channel.Counterparty.ChannelId,
+		ConnectionHops:        channel.ConnectionHops,
+		Version:               channel.Version,
+	}
+}
+
+// NewConnection converts an IBC connection end into a Connection.
+func NewConnection(connection connectiontypes.ConnectionEnd) Connection {
+	return Connection{
+		ClientID:                 connection.ClientId,
+		State:                    uint8(connection.State),
+		CounterpartyClientID:     connection.Counterparty.ClientId,
+		CounterpartyConnectionID: connection.Counterparty.ConnectionId,
+		DelayPeriod:              connection.DelayPeriod,
+	}
+}
+
+// FromResponse populates the ChannelsOutput from a QueryChannelsResponse.
+func (co *ChannelsOutput) FromResponse(res *channeltypes.QueryChannelsResponse) *ChannelsOutput {
+	co.Channels = make([]IdentifiedChannel, len(res.Channels))
+	for i, channel := range res.Channels {
+		co.Channels[i] = IdentifiedChannel{
+			PortID:                channel.PortId,
+			ChannelID:             channel.ChannelId,
+			State:                 uint8(channel.State),
+			Ordering:              uint8(channel.Ordering),
+			CounterpartyPortID:    channel.Counterparty.PortId,
+			CounterpartyChannelID: channel.Counterparty.ChannelId,
+			ConnectionHops:        channel.ConnectionHops,
+			Version:               channel.Version,
+		}
+	}
+
+	if res.Pagination != nil {
+		co.PageResponse.Total = res.Pagination.Total
+		co.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return co
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (co *ChannelsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(co.Channels, co.PageResponse)
+}
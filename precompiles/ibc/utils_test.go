@@ -0,0 +1,33 @@
+package ibc_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+const (
+	// testChannelID is the identifier of the transfer channel set up by setupChannel.
+	testChannelID = "channel-0"
+	// testSequence is the next send sequence of the transfer channel set up by setupChannel.
+	testSequence = uint64(5)
+)
+
+// setupChannel stores an open unordered transfer channel over the localhost
+// connection and sets its next send sequence.
+func (s *PrecompileTestSuite) setupChannel() channeltypes.Channel {
+	ctx := s.network.GetContext()
+	channelKeeper := s.network.App.IBCKeeper.ChannelKeeper
+
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "channel-7"),
+		[]string{exported.LocalhostConnectionID},
+		transfertypes.Version,
+	)
+	channelKeeper.SetChannel(ctx, transfertypes.PortID, testChannelID, channel)
+	channelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, testChannelID, testSequence)
+
+	return channel
+}
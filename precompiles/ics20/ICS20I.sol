@@ -66,6 +66,33 @@ interface ICS20I is IICS20Authorization {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferWithCallback performs an IBC transfer like transfer and registers the
+    /// calling contract to be called back, through the IICS20Callbacks interface, when the
+    /// packet is acknowledged or times out. The calling contract must not be the origin.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutHeight the timeout height relative to the current block height. The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch. The timeout is disabled when set to 0
+    /// @param memo optional memo
+    /// @param callbackGasLimit the gas limit of the callback, paid by the relayer and capped at 1,000,000
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferWithCallback(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo,
+        uint64 callbackGasLimit
+    ) external returns (uint64 nextSequence);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -89,3 +116,35 @@ interface ICS20I is IICS20Authorization {
     ) external view returns (string memory hash);
 
 }
+
+/// @author Evmos Team
+/// @title ICS20 Packet Callbacks
+/// @dev The interface that a contract sending a transfer with transferWithCallback must
+/// implement to be notified of the packet lifecycle. The callbacks are called by the
+/// ICS20 precompile address, after the tokens of a failed or timed out transfer have
+/// been refunded. A failing callback doesn't revert the acknowledgement or the timeout.
+interface IICS20Callbacks {
+    /// @dev Called when the packet is acknowledged by the counterparty chain.
+    /// @param sourcePort the port on which the packet was sent
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence number of the packet
+    /// @param success whether the transfer succeeded on the counterparty chain
+    /// @param acknowledgement the acknowledgement result, or the error if the transfer failed
+    function onPacketAcknowledgement(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence,
+        bool success,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called when the packet times out.
+    /// @param sourcePort the port on which the packet was sent
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence number of the packet
+    function onPacketTimeout(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence
+    ) external;
+}
@@ -24,4 +24,9 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
+	// ErrInvalidCallbackGasLimit is raised when the callback gas limit is invalid.
+	ErrInvalidCallbackGasLimit = "invalid callback gas limit: %v"
+	// ErrCallbackFromOrigin is raised when a packet callback is registered by the origin
+	// instead of a contract.
+	ErrCallbackFromOrigin = "packet callbacks can only be registered by contracts, caller %s is the origin"
 )
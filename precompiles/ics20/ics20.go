@@ -17,6 +17,7 @@ import (
 	"github.com/evmos/evmos/v15/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	transferkeeper "github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
+	evmostransfertypes "github.com/evmos/evmos/v15/x/ibc/transfer/types"
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -65,7 +66,7 @@ func NewPrecompile(
 // Address defines the address of the ICS-20 compile contract.
 // address: 0x0000000000000000000000000000000000000802
 func (Precompile) Address() common.Address {
-	return common.HexToAddress("0x0000000000000000000000000000000000000802")
+	return common.HexToAddress(evmostransfertypes.ICS20PrecompileAddress)
 }
 
 // RequiredGas calculates the precompiled contract's base gas rate.
@@ -106,6 +107,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferWithCallbackMethod:
+		bz, err = p.TransferWithCallback(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -145,6 +148,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case TransferMethod,
+		TransferWithCallbackMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
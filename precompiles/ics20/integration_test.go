@@ -486,12 +486,13 @@ var _ = Describe("IBCTransfer Precompile", func() {
 				Expect(err).To(BeNil())
 
 				// To submit a timeoutMsg, the TimeoutPacket function
-				// uses a default fee amount
-				timeoutMsgFee := math.NewInt(evmostesting.DefaultFeeAmt * 2)
-				fees = fees.Add(timeoutMsgFee)
+				// uses a default fee amount, which is refunded the fees of the unused gas
+				maxTimeoutMsgFee := math.NewInt(evmostesting.DefaultFeeAmt * 2)
 
 				finalBalance = s.app.BankKeeper.GetBalance(s.chainA.GetContext(), s.address.Bytes(), s.bondDenom)
-				Expect(finalBalance.Amount).To(Equal(initialBalance.Amount.Sub(fees)))
+				timeoutMsgFee := initialBalance.Amount.Sub(fees).Sub(finalBalance.Amount)
+				Expect(timeoutMsgFee.IsPositive()).To(BeTrue(), "expected fees to be paid for the timeout msg")
+				Expect(timeoutMsgFee.LTE(maxTimeoutMsgFee)).To(BeTrue(), "expected fees paid %s to be at most %s", timeoutMsgFee, maxTimeoutMsgFee)
 			})
 
 			It("should not transfer other account's balance", func() {
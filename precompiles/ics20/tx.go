@@ -4,8 +4,11 @@
 package ics20
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +19,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferWithCallbackMethod defines the ABI method name for the ICS20
+	// Transfer transaction with a packet callback.
+	TransferWithCallbackMethod = "transferWithCallback"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -32,9 +38,60 @@ func (p Precompile) Transfer(
 		return nil, err
 	}
 
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferWithCallback implements the ICS20 transfer transaction that registers
+// the calling contract to be called back when the packet is acknowledged or
+// times out.
+func (p Precompile) TransferWithCallback(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, callback, err := NewMsgTransferWithCallback(method, args, contract.CallerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// only contracts can be called back, and the origin is always an account
+	if contract.CallerAddress == origin {
+		return nil, fmt.Errorf(ErrCallbackFromOrigin, origin)
+	}
+
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transferKeeper.SetPacketCallback(ctx, msg.SourcePort, msg.SourceChannel, sequence, callback); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// transfer executes the given ICS20 transfer on behalf of the origin and returns
+// the sequence of the packet sent.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) (uint64, error) {
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
-		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
 	}
 
 	// The provided sender address should always be equal to the origin address.
@@ -42,25 +99,25 @@ func (p Precompile) Transfer(
 	// update the sender address to be equal to the origin address.
 	// Otherwise, if the provided delegator address is different from the origin address,
 	// return an error because is a forbidden operation
-	sender, err = CheckOriginAndSender(contract, origin, sender)
+	sender, err := CheckOriginAndSender(contract, origin, sender)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err := UpdateGrantIfNeeded(ctx, contract, p.AuthzKeeper, origin, expiration, resp); err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err = EmitIBCTransferEvent(
@@ -75,8 +132,8 @@ func (p Precompile) Transfer(
 		msg.Token,
 		msg.Memo,
 	); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
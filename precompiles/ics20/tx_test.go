@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
@@ -14,6 +15,7 @@ import (
 	evmosutil "github.com/evmos/evmos/v15/testutil"
 	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
 	"github.com/evmos/evmos/v15/utils"
+	evmostransfertypes "github.com/evmos/evmos/v15/x/ibc/transfer/types"
 )
 
 var (
@@ -357,3 +359,101 @@ func (s *PrecompileTestSuite) TestTransfer() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferWithCallback() {
+	callingContractAddr := differentAddress
+	method := s.precompile.Methods[ics20.TransferWithCallbackMethod]
+
+	// transferArgs returns the transfer arguments of 1 Evmos over a new path, with the
+	// given callback gas limit.
+	transferArgs := func(sender, receiver sdk.AccAddress, gasLimit uint64) (*ibctesting.Path, []interface{}) {
+		path := NewTransferPath(s.chainA, s.chainB)
+		s.coordinator.Setup(path)
+		err := s.NewTransferAuthorization(s.ctx, s.app, callingContractAddr, common.BytesToAddress(sender), path, defaultCoins, nil)
+		s.Require().NoError(err)
+		return path, []interface{}{
+			path.EndpointA.ChannelConfig.PortID,
+			path.EndpointA.ChannelID,
+			utils.BaseDenom,
+			big.NewInt(1e18),
+			common.BytesToAddress(sender.Bytes()),
+			receiver.String(),
+			s.chainB.GetTimeoutHeight(),
+			uint64(0),
+			"memo",
+			gasLimit,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		caller      func(sender sdk.AccAddress) common.Address
+		gasLimit    uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - zero callback gas limit",
+			func(sdk.AccAddress) common.Address { return callingContractAddr },
+			0,
+			true,
+			"invalid callback gas limit",
+		},
+		{
+			"fail - callback gas limit above the maximum",
+			func(sdk.AccAddress) common.Address { return callingContractAddr },
+			evmostransfertypes.MaxCallbackGasLimit + 1,
+			true,
+			"invalid callback gas limit",
+		},
+		{
+			"fail - caller is the origin",
+			func(sender sdk.AccAddress) common.Address { return common.BytesToAddress(sender) },
+			100_000,
+			true,
+			"packet callbacks can only be registered by contracts",
+		},
+		{
+			"pass - register the calling contract as callback",
+			func(sdk.AccAddress) common.Address { return callingContractAddr },
+			100_000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.chainA.SenderAccount.GetAddress()
+			receiver := s.chainB.SenderAccount.GetAddress()
+
+			contract := vm.NewContract(vm.AccountRef(common.BytesToAddress(sender)), s.precompile, big.NewInt(0), 200000)
+			s.ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			path, args := transferArgs(sender, receiver, tc.gasLimit)
+
+			contract.CallerAddress = tc.caller(sender)
+			bz, err := s.precompile.TransferWithCallback(s.ctx, common.BytesToAddress(sender), contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			sequence := out[0].(uint64)
+
+			callback, found := s.app.TransferKeeper.GetPacketCallback(s.ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			s.Require().True(found)
+			s.Require().Equal(evmostransfertypes.PacketCallback{Contract: callingContractAddr, GasLimit: tc.gasLimit}, callback)
+
+			balance := s.app.BankKeeper.GetBalance(s.ctx, sender, utils.BaseDenom)
+			s.Require().Equal(sdk.NewInt(4e18), balance.Amount)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v15/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	evmostransfertypes "github.com/evmos/evmos/v15/x/ibc/transfer/types"
 )
 
 const (
@@ -161,6 +162,39 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// NewMsgTransferWithCallback returns a new transfer message from the given arguments,
+// together with the packet callback of the given contract, whose gas limit is the
+// last argument.
+func NewMsgTransferWithCallback(
+	method *abi.Method,
+	args []interface{},
+	callbackContract common.Address,
+) (*transfertypes.MsgTransfer, common.Address, evmostransfertypes.PacketCallback, error) {
+	if len(args) != 10 {
+		return nil, common.Address{}, evmostransfertypes.PacketCallback{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	gasLimit, ok := args[9].(uint64)
+	if !ok {
+		return nil, common.Address{}, evmostransfertypes.PacketCallback{}, fmt.Errorf(ErrInvalidCallbackGasLimit, args[9])
+	}
+
+	callback := evmostransfertypes.PacketCallback{
+		Contract: callbackContract,
+		GasLimit: gasLimit,
+	}
+	if err := callback.Validate(); err != nil {
+		return nil, common.Address{}, evmostransfertypes.PacketCallback{}, err
+	}
+
+	msg, sender, err := NewMsgTransfer(method, args[:9])
+	if err != nil {
+		return nil, common.Address{}, evmostransfertypes.PacketCallback{}, err
+	}
+
+	return msg, sender, callback, nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IMulticall contract's address.
+address constant MULTICALL_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IMulticall contract's instance.
+IMulticall constant MULTICALL_CONTRACT = IMulticall(MULTICALL_PRECOMPILE_ADDRESS);
+
+/// @dev Call represents a call to a precompiled contract.
+/// @param target The address of the precompiled contract.
+/// @param callData The ABI encoded calldata of the call.
+struct Call {
+    address target;
+    bytes callData;
+}
+
+/// @author Evmos Team
+/// @title Multicall Precompiled Contract
+/// @dev The interface through which solidity contracts will batch calls to the other precompiled contracts.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IMulticall {
+    /// @dev Executes the given calls to precompiled contracts atomically. Transactions can
+    /// only be executed by the origin, on whose behalf the calls are made, while contracts
+    /// can only batch queries in static calls. All the calls are reverted if one fails.
+    /// @param calls The calls to execute in order.
+    /// @return returnData The data returned by each call.
+    function multicall(
+        Call[] calldata calls
+    ) external returns (bytes[] memory returnData);
+}
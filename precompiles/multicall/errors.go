@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+const (
+	// ErrEmptyCalls is raised when the multicall has no calls.
+	ErrEmptyCalls = "calls cannot be empty"
+	// ErrInvalidTarget is raised when the target of a call can't be called through the multicall.
+	ErrInvalidTarget = "invalid target of call %d %s: %v"
+	// ErrNestedMulticall is raised when the target of a call is the multicall precompile.
+	ErrNestedMulticall = "multicall cannot call itself"
+	// ErrInactivePrecompile is raised when the target of a call is not an active precompile.
+	ErrInactivePrecompile = "not an active precompiled contract"
+	// ErrStatelessPrecompile is raised when the target of a call is a stateless precompile.
+	ErrStatelessPrecompile = "not a stateful precompiled contract"
+	// ErrInvalidCallData is raised when the calldata of a call doesn't contain a method ID.
+	ErrInvalidCallData = "invalid calldata length %d"
+	// ErrDifferentOrigin is raised when a contract executes transactions through the multicall.
+	ErrDifferentOrigin = "multicall transactions can only be executed by the origin %s, caller %s"
+	// ErrCallFailed is raised when a call of the multicall fails.
+	ErrCallFailed = "call %d to %s failed: %v"
+)
@@ -0,0 +1,67 @@
+package multicall_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v15/precompiles/bech32"
+	"github.com/evmos/evmos/v15/precompiles/multicall"
+	"github.com/evmos/evmos/v15/precompiles/staking"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the multicall precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	bondDenom string
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+	stateDB *statedb.StateDB
+
+	precompile        *multicall.Precompile
+	stakingPrecompile *staking.Precompile
+	bech32Precompile  *bech32.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	ctx := integrationNetwork.GetContext()
+	bondDenom := integrationNetwork.App.StakingKeeper.BondDenom(ctx)
+	s.Require().NotEmpty(bondDenom, "bond denom cannot be empty")
+
+	s.bondDenom = bondDenom
+	s.keyring = keyring
+	s.network = integrationNetwork
+	s.stateDB = integrationNetwork.GetStateDB()
+
+	precompile, err := multicall.NewPrecompile(integrationNetwork.App.AuthzKeeper)
+	s.Require().NoError(err, "failed to create multicall precompile")
+	s.precompile = precompile
+
+	stakingPrecompile, err := staking.NewPrecompile(
+		integrationNetwork.App.StakingKeeper,
+		integrationNetwork.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create staking precompile")
+	s.stakingPrecompile = stakingPrecompile
+
+	bech32Precompile, err := bech32.NewPrecompile(6000)
+	s.Require().NoError(err, "failed to create bech32 precompile")
+	s.bech32Precompile = bech32Precompile
+}
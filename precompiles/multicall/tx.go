@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+// MulticallMethod defines the ABI method name for the multicall Multicall transaction.
+const MulticallMethod = "multicall"
+
+// statefulPrecompile defines the stateful precompiled contracts that can be called
+// through the multicall. Unlike the stateless ones, their required gas only covers
+// the calldata, which is already charged by the multicall.
+type statefulPrecompile interface {
+	vm.PrecompiledContract
+	IsTransaction(method string) bool
+}
+
+// Multicall executes the given calls to the stateful precompiled contracts in order
+// and returns the data returned by each of them. The caller is authorized once for
+// all the calls: transactions can only be executed by the origin, so the calls run
+// on its behalf without authorization grants, while contracts can only batch queries
+// in read-only calls. The calls run on a single branch of the transaction context,
+// which is discarded as soon as one of them fails.
+func (p Precompile) Multicall(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+	readOnly bool,
+) ([]byte, error) {
+	calls, err := NewCalls(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ calls: %d }",
+			len(calls),
+		),
+	)
+
+	caller := contract.CallerAddress
+	if caller != evm.Origin && !readOnly {
+		return nil, fmt.Errorf(ErrDifferentOrigin, evm.Origin, caller)
+	}
+
+	// NOTE: discard restores the transaction context when a call fails, and is a
+	// no-op once the branch is written.
+	write, discard := stateDB.CacheContext()
+	defer discard()
+
+	returnData := make([][]byte, len(calls))
+	for i, call := range calls {
+		precompile, err := p.precompile(evm, call.Target)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidTarget, i, call.Target, err)
+		}
+
+		bz, err := p.call(evm, contract, caller, precompile, call.CallData, readOnly)
+		if err != nil {
+			return nil, fmt.Errorf(ErrCallFailed, i, call.Target, err)
+		}
+
+		// sync the balances changed through the Cosmos SDK, so that they are not
+		// overwritten when the next call commits the stateDB.
+		stateDB.SyncBalances()
+		returnData[i] = bz
+	}
+
+	write()
+
+	return method.Outputs.Pack(returnData)
+}
+
+// precompile returns the active stateful precompiled contract at the given address.
+// The multicall can't call itself.
+func (p Precompile) precompile(evm *vm.EVM, address common.Address) (statefulPrecompile, error) {
+	if address == p.Address() {
+		return nil, fmt.Errorf(ErrNestedMulticall)
+	}
+
+	precompile, ok := evm.Precompile(address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInactivePrecompile)
+	}
+
+	stateful, ok := precompile.(statefulPrecompile)
+	if !ok {
+		return nil, fmt.Errorf(ErrStatelessPrecompile)
+	}
+
+	return stateful, nil
+}
+
+// call runs the given precompiled contract on behalf of the caller with the remaining
+// gas of the multicall and charges the gas it consumed to the multicall.
+func (p Precompile) call(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	caller common.Address,
+	precompile statefulPrecompile,
+	input []byte,
+	readOnly bool,
+) ([]byte, error) {
+	if len(input) < 4 {
+		return nil, fmt.Errorf(ErrInvalidCallData, len(input))
+	}
+
+	callContract := vm.NewPrecompile(vm.AccountRef(caller), vm.AccountRef(precompile.Address()), common.Big0, contract.Gas)
+	callContract.Input = input
+
+	bz, err := precompile.Run(evm, callContract, readOnly)
+	if !contract.UseGas(contract.Gas - callContract.Gas) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, err
+}
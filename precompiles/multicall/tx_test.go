@@ -0,0 +1,240 @@
+package multicall_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/multicall"
+	"github.com/evmos/evmos/v15/precompiles/staking"
+	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestMulticall() {
+	method := s.precompile.Methods[multicall.MulticallMethod]
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		calls       func(delegator common.Address, validators []string) []multicall.Call
+		readOnly    bool
+		postCheck   func(delegator common.Address, validators []string, returnData [][]byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty calls",
+			func(common.Address, []string) []multicall.Call {
+				return []multicall.Call{}
+			},
+			false,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			multicall.ErrEmptyCalls,
+		},
+		{
+			"fail - nested multicall",
+			func(common.Address, []string) []multicall.Call {
+				return []multicall.Call{{Target: s.precompile.Address(), CallData: []byte{1, 2, 3, 4}}}
+			},
+			false,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			multicall.ErrNestedMulticall,
+		},
+		{
+			"fail - inactive precompile",
+			func(common.Address, []string) []multicall.Call {
+				return []multicall.Call{{Target: testutiltx.GenerateAddress(), CallData: []byte{1, 2, 3, 4}}}
+			},
+			false,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			multicall.ErrInactivePrecompile,
+		},
+		{
+			"fail - stateless precompile",
+			func(common.Address, []string) []multicall.Call {
+				return []multicall.Call{{Target: s.bech32Precompile.Address(), CallData: []byte{1, 2, 3, 4}}}
+			},
+			false,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			multicall.ErrStatelessPrecompile,
+		},
+		{
+			"fail - calldata without method ID",
+			func(common.Address, []string) []multicall.Call {
+				return []multicall.Call{{Target: s.stakingPrecompile.Address(), CallData: []byte{1, 2}}}
+			},
+			false,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			"invalid calldata length 2",
+		},
+		{
+			"fail - transaction in a read-only call",
+			func(delegator common.Address, validators []string) []multicall.Call {
+				return []multicall.Call{s.delegateCall(delegator, validators[0], amount)}
+			},
+			true,
+			func(common.Address, []string, [][]byte) {},
+			true,
+			vm.ErrWriteProtection.Error(),
+		},
+		{
+			"fail - revert all the calls when one fails",
+			func(delegator common.Address, validators []string) []multicall.Call {
+				return []multicall.Call{
+					s.delegateCall(delegator, validators[0], amount),
+					s.delegateCall(delegator, "invalid", amount),
+				}
+			},
+			false,
+			func(delegator common.Address, validators []string, _ [][]byte) {
+				valAddr, err := sdk.ValAddressFromBech32(validators[0])
+				s.Require().NoError(err)
+				delegation, found := s.network.App.StakingKeeper.GetDelegation(s.network.GetContext(), delegator.Bytes(), valAddr)
+				s.Require().True(found, "expected the genesis delegation")
+				s.Require().Equal(sdk.OneDec(), delegation.Shares, "expected the first delegation to be reverted")
+			},
+			true,
+			"call 1 to",
+		},
+		{
+			"pass - queries in a read-only call",
+			func(delegator common.Address, validators []string) []multicall.Call {
+				return []multicall.Call{
+					s.delegationCall(delegator, validators[0]),
+					s.delegationCall(delegator, validators[1]),
+				}
+			},
+			true,
+			func(_ common.Address, _ []string, returnData [][]byte) {
+				s.Require().Len(returnData, 2)
+				for _, bz := range returnData {
+					out, err := s.stakingPrecompile.Unpack(staking.DelegationMethod, bz)
+					s.Require().NoError(err)
+					s.Require().Equal(big.NewInt(1e18), out[0])
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - delegate to two validators and query the delegation",
+			func(delegator common.Address, validators []string) []multicall.Call {
+				return []multicall.Call{
+					s.delegateCall(delegator, validators[0], amount),
+					s.delegateCall(delegator, validators[1], amount),
+					s.delegationCall(delegator, validators[0]),
+				}
+			},
+			false,
+			func(delegator common.Address, validators []string, returnData [][]byte) {
+				s.Require().Len(returnData, 3)
+				out, err := s.stakingPrecompile.Unpack(staking.DelegationMethod, returnData[2])
+				s.Require().NoError(err)
+				s.Require().Equal(big.NewInt(2e18), out[0], "expected the query to see the first delegation")
+
+				for _, validator := range validators[:2] {
+					valAddr, err := sdk.ValAddressFromBech32(validator)
+					s.Require().NoError(err)
+					delegation, found := s.network.App.StakingKeeper.GetDelegation(s.network.GetContext(), delegator.Bytes(), valAddr)
+					s.Require().True(found)
+					s.Require().Equal(sdk.NewDec(2), delegation.Shares)
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			delegator := s.keyring.GetAddr(0)
+			validators := make([]string, 0, 2)
+			for _, validator := range s.network.GetValidators() {
+				validators = append(validators, validator.OperatorAddress)
+			}
+
+			bz, err := s.runMulticall(delegator, tc.calls(delegator, validators), tc.readOnly)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				tc.postCheck(delegator, validators, nil)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			tc.postCheck(delegator, validators, out[0].([][]byte))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMulticallCaller() {
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		readOnly    bool
+		value       *big.Int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - value sent to the multicall",
+			false,
+			big.NewInt(100),
+			true,
+			fmt.Sprintf(cmn.ErrNonPayable, multicall.MulticallMethod),
+		},
+		{
+			"fail - transaction executed by a contract",
+			false,
+			big.NewInt(0),
+			true,
+			"multicall transactions can only be executed by the origin",
+		},
+		{
+			"pass - queries batched by a contract in a read-only call",
+			true,
+			big.NewInt(0),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			origin := s.keyring.GetAddr(0)
+			caller := testutiltx.GenerateAddress()
+			validator := s.network.GetValidators()[0].OperatorAddress
+
+			calls := []multicall.Call{s.delegationCall(origin, validator)}
+			if !tc.readOnly {
+				calls = append(calls, s.delegateCall(origin, validator, amount))
+			}
+
+			bz, err := s.runMulticallFrom(origin, caller, calls, tc.readOnly, tc.value)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package multicall
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// Call defines a call to a precompiled contract.
+type Call struct {
+	Target   common.Address `abi:"target"`
+	CallData []byte         `abi:"callData"`
+}
+
+// MulticallInput defines the input of the multicall transaction.
+type MulticallInput struct {
+	Calls []Call `abi:"calls"`
+}
+
+// NewCalls returns the calls of the multicall from the given arguments.
+func NewCalls(method *abi.Method, args []interface{}) ([]Call, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MulticallInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MulticallInput struct: %s", err)
+	}
+
+	if len(input.Calls) == 0 {
+		return nil, fmt.Errorf(ErrEmptyCalls)
+	}
+
+	return input.Calls, nil
+}
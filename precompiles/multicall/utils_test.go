@@ -0,0 +1,63 @@
+package multicall_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/multicall"
+	"github.com/evmos/evmos/v15/precompiles/staking"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// newEVM returns an EVM for a transaction signed by the given origin, with the
+// multicall, staking and bech32 precompiles active.
+func (s *PrecompileTestSuite) newEVM(origin common.Address) *vm.EVM {
+	chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(s.network.GetEIP155ChainID())
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{Origin: origin}, s.stateDB, chainConfig, vm.Config{})
+
+	precompiles := map[common.Address]vm.PrecompiledContract{
+		s.precompile.Address():        s.precompile,
+		s.stakingPrecompile.Address(): s.stakingPrecompile,
+		s.bech32Precompile.Address():  s.bech32Precompile,
+	}
+	activePrecompiles := []common.Address{s.bech32Precompile.Address(), s.stakingPrecompile.Address(), s.precompile.Address()}
+	evm.WithPrecompiles(precompiles, activePrecompiles)
+	return evm
+}
+
+// delegateCall returns the call to the staking precompile that delegates the given
+// amount from the delegator to the validator.
+func (s *PrecompileTestSuite) delegateCall(delegator common.Address, validator string, amount *big.Int) multicall.Call {
+	input, err := s.stakingPrecompile.Pack(staking.DelegateMethod, delegator, validator, amount)
+	s.Require().NoError(err, "failed to pack delegate call")
+	return multicall.Call{Target: s.stakingPrecompile.Address(), CallData: input}
+}
+
+// delegationCall returns the call to the staking precompile that queries the
+// delegation of the delegator to the validator.
+func (s *PrecompileTestSuite) delegationCall(delegator common.Address, validator string) multicall.Call {
+	input, err := s.stakingPrecompile.Pack(staking.DelegationMethod, delegator, validator)
+	s.Require().NoError(err, "failed to pack delegation call")
+	return multicall.Call{Target: s.stakingPrecompile.Address(), CallData: input}
+}
+
+// runMulticall runs the multicall precompile with the given calls in a transaction
+// or call signed by the origin.
+func (s *PrecompileTestSuite) runMulticall(origin common.Address, calls []multicall.Call, readOnly bool) ([]byte, error) {
+	return s.runMulticallFrom(origin, origin, calls, readOnly, big.NewInt(0))
+}
+
+// runMulticallFrom runs the multicall precompile with the given calls and value,
+// called by the caller in a transaction or call signed by the origin.
+func (s *PrecompileTestSuite) runMulticallFrom(
+	origin, caller common.Address, calls []multicall.Call, readOnly bool, value *big.Int,
+) ([]byte, error) {
+	input, err := s.precompile.Pack(multicall.MulticallMethod, calls)
+	s.Require().NoError(err, "failed to pack multicall")
+
+	contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, value, 1_000_000)
+	contract.Input = input
+
+	return s.precompile.Run(s.newEVM(origin), contract, readOnly)
+}
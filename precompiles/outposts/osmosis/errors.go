@@ -25,4 +25,7 @@ var (
 	// ErrInputTokenNotSupported is raised when the osmosis outpost receives a non-supported
 	// input token for the swap.
 	ErrInputTokenNotSupported = "input not supported, supported tokens: %v" //#nosec G101 -- no hardcoded credentials here
+	// ErrEmptyXCSContract is raised when the XCS contract of the outpost has not
+	// been set in the erc20 module parameters.
+	ErrEmptyXCSContract = "XCS contract not set for outpost %s"
 )
@@ -18,6 +18,7 @@ import (
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	"github.com/evmos/evmos/v15/precompiles/ics20"
 	erc20keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 	transferkeeper "github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
 )
 
@@ -27,10 +28,7 @@ const (
 	OsmosisPrefix = "osmo"
 
 	// OsmosisOutpostAddress is the address of the Osmosis outpost precompile
-	OsmosisOutpostAddress = "0x0000000000000000000000000000000000000901"
-
-	// XCSContract placeholder until the XCS contract is deployed on the Osmosis test chain
-	XCSContract = "placeholder"
+	OsmosisOutpostAddress = erc20types.OsmosisOutpostAddress
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -45,14 +43,9 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	// IBC
-	portID           string
-	channelID        string
 	timeoutHeight    clienttypes.Height
 	timeoutTimestamp uint64
 
-	// Osmosis
-	osmosisXCSContract string
-
 	// Keepers
 	bankKeeper     bankkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
@@ -61,10 +54,9 @@ type Precompile struct {
 }
 
 // NewPrecompile creates a new Osmosis outpost Precompile instance as a
-// PrecompiledContract interface.
+// PrecompiledContract interface. The IBC port, channel and XCS contract used by
+// the outpost are read from the erc20 module parameters on each swap.
 func NewPrecompile(
-	portID, channelID string,
-	osmosisXCSContract string,
 	bankKeeper bankkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	stakingKeeper stakingkeeper.Keeper,
@@ -84,15 +76,12 @@ func NewPrecompile(
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 			AuthzKeeper:          authzKeeper,
 		},
-		portID:             portID,
-		channelID:          channelID,
-		timeoutHeight:      clienttypes.NewHeight(ics20.DefaultTimeoutHeight, ics20.DefaultTimeoutHeight),
-		timeoutTimestamp:   ics20.DefaultTimeoutTimestamp,
-		osmosisXCSContract: osmosisXCSContract,
-		transferKeeper:     transferKeeper,
-		bankKeeper:         bankKeeper,
-		stakingKeeper:      stakingKeeper,
-		erc20Keeper:        erc20Keeper,
+		timeoutHeight:    clienttypes.NewHeight(ics20.DefaultTimeoutHeight, ics20.DefaultTimeoutHeight),
+		timeoutTimestamp: ics20.DefaultTimeoutTimestamp,
+		transferKeeper:   transferKeeper,
+		bankKeeper:       bankKeeper,
+		stakingKeeper:    stakingKeeper,
+		erc20Keeper:      erc20Keeper,
 	}, nil
 }
 
@@ -6,10 +6,13 @@ package osmosis_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v15/precompiles/outposts/osmosis"
 	"github.com/evmos/evmos/v15/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -18,6 +21,10 @@ const (
 	channelID = "channel-0"
 )
 
+// xcsContract is the address used as the XCS contract on the counterparty chain. It has
+// to be a valid address on the dummy chains for the transfer to be received.
+var xcsContract = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
 type PrecompileTestSuite struct {
 	suite.Suite
 
@@ -39,9 +46,6 @@ func (s *PrecompileTestSuite) SetupTest() {
 	)
 
 	precompile, err := osmosis.NewPrecompile(
-		portID,
-		channelID,
-		osmosis.XCSContract,
 		unitNetwork.App.BankKeeper,
 		unitNetwork.App.TransferKeeper,
 		unitNetwork.App.StakingKeeper,
@@ -50,6 +54,14 @@ func (s *PrecompileTestSuite) SetupTest() {
 	)
 	s.Require().NoError(err, "expected no error during precompile creation")
 
+	ctx := unitNetwork.GetContext()
+	erc20Params := unitNetwork.App.Erc20Keeper.GetParams(ctx)
+	erc20Params.Outposts = []erc20types.Outpost{
+		erc20types.NewOutpost(precompile.Address().String(), portID, channelID, xcsContract),
+	}
+	err = unitNetwork.App.Erc20Keeper.SetParams(ctx, erc20Params)
+	s.Require().NoError(err, "expected no error setting the outpost params")
+
 	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
 
 	s.unitNetwork = unitNetwork
@@ -7,6 +7,8 @@
 package osmosis
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -68,11 +70,22 @@ func (p Precompile) Swap(
 		return nil, err
 	}
 
+	// The IBC port, channel and XCS contract are governance controlled
+	// parameters of the erc20 module.
+	outpost, err := p.erc20Keeper.GetOutpost(ctx, p.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	if outpost.CounterpartyContract == "" {
+		return nil, fmt.Errorf(ErrEmptyXCSContract, p.Address())
+	}
+
 	// We need the bonded denom just for the outpost alpha version where the
 	// the only two inputs allowed are aevmos and uosmo.
 	bondDenom := p.stakingKeeper.GetParams(ctx).BondDenom
 
-	err = ValidateInputOutput(inputDenom, outputDenom, bondDenom, p.portID, p.channelID)
+	err = ValidateInputOutput(inputDenom, outputDenom, bondDenom, outpost.PortID, outpost.ChannelID)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +96,7 @@ func (p Precompile) Swap(
 	packet := CreatePacketWithMemo(
 		outputDenom,
 		swapPacketData.SwapReceiver,
-		XCSContract,
+		outpost.CounterpartyContract,
 		swapPacketData.SlippagePercentage,
 		swapPacketData.WindowSeconds,
 		onFailedDelivery,
@@ -98,11 +111,11 @@ func (p Precompile) Swap(
 
 	coin := sdk.Coin{Denom: inputDenom, Amount: sdk.NewIntFromBigInt(amount)}
 	msg, err := ics20.CreateAndValidateMsgTransfer(
-		p.portID,
-		p.channelID,
+		outpost.PortID,
+		outpost.ChannelID,
 		coin,
 		sdk.AccAddress(sender.Bytes()).String(),
-		XCSContract,
+		outpost.CounterpartyContract,
 		p.timeoutHeight,
 		p.timeoutTimestamp,
 		packetString,
@@ -5,6 +5,8 @@ import (
 	"math/big"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/evmos/v15/precompiles/authorization"
@@ -16,6 +18,8 @@ import (
 	"github.com/evmos/evmos/v15/testutil/integration/ibc/coordinator"
 	utiltx "github.com/evmos/evmos/v15/testutil/tx"
 	"github.com/evmos/evmos/v15/utils"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
 )
 
 func (s *PrecompileTestSuite) TestSwap() {
@@ -248,6 +252,58 @@ func (s *PrecompileTestSuite) TestSwap() {
 			},
 			expError:    true,
 			errContains: fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, senderAddress, s.keyring.GetAddr(1)),
+		}, {
+			name:   "fail - outpost not configured",
+			sender: senderAddress,
+			origin: senderAddress,
+			malleate: func() []interface{} {
+				evmosTokenPair, err := testutils.RegisterEvmosERC20Coins(*s.unitNetwork, sender)
+				s.Require().NoError(err, "expected no error during evmos erc20 registration")
+
+				osmoIbcDenomTrace := utils.ComputeIBCDenomTrace(portID, channelID, osmosis.OsmosisDenom)
+				osmoTokenPair, err := testutils.RegisterIBCERC20Coins(*s.unitNetwork, sender, osmoIbcDenomTrace)
+				s.Require().NoError(err, "expected no error during ibc erc20 registration")
+
+				s.setOutposts()
+
+				return []interface{}{
+					senderAddress,
+					osmoTokenPair.GetERC20Contract(),
+					evmosTokenPair.GetERC20Contract(),
+					transferAmount,
+					slippagePercentage,
+					windowSeconds,
+					osmoAddress,
+				}
+			},
+			expError:    true,
+			errContains: erc20types.ErrOutpostNotFound.Error(),
+		}, {
+			name:   "fail - XCS contract not set",
+			sender: senderAddress,
+			origin: senderAddress,
+			malleate: func() []interface{} {
+				evmosTokenPair, err := testutils.RegisterEvmosERC20Coins(*s.unitNetwork, sender)
+				s.Require().NoError(err, "expected no error during evmos erc20 registration")
+
+				osmoIbcDenomTrace := utils.ComputeIBCDenomTrace(portID, channelID, osmosis.OsmosisDenom)
+				osmoTokenPair, err := testutils.RegisterIBCERC20Coins(*s.unitNetwork, sender, osmoIbcDenomTrace)
+				s.Require().NoError(err, "expected no error during ibc erc20 registration")
+
+				s.setOutposts(erc20types.NewOutpost(osmosis.OsmosisOutpostAddress, portID, channelID, ""))
+
+				return []interface{}{
+					senderAddress,
+					osmoTokenPair.GetERC20Contract(),
+					evmosTokenPair.GetERC20Contract(),
+					transferAmount,
+					slippagePercentage,
+					windowSeconds,
+					osmoAddress,
+				}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(osmosis.ErrEmptyXCSContract, s.precompile.Address()),
 		}, {
 			name:   "fail - ibc channel not open",
 			sender: senderAddress,
@@ -341,3 +397,92 @@ func (s *PrecompileTestSuite) TestSwap() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestSwapPacketRoundTrip() {
+	slippagePercentage := uint8(10)
+	windowSeconds := uint64(20)
+	transferAmount := big.NewInt(1e18)
+	osmoAddress := "osmo1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2"
+
+	s.SetupTest()
+
+	sender, senderPrivKey := s.keyring.GetAccAddr(0), s.keyring.GetPrivKey(0)
+	senderAddress := s.keyring.GetAddr(0)
+	chainID := s.unitNetwork.GetChainID()
+
+	// The tokens are registered before opening the channel so that the changes
+	// are committed by the coordinator.
+	evmosTokenPair, err := testutils.RegisterEvmosERC20Coins(*s.unitNetwork, sender)
+	s.Require().NoError(err, "expected no error during evmos erc20 registration")
+
+	osmoIbcDenomTrace := utils.ComputeIBCDenomTrace(portID, channelID, osmosis.OsmosisDenom)
+	osmoTokenPair, err := testutils.RegisterIBCERC20Coins(*s.unitNetwork, sender, osmoIbcDenomTrace)
+	s.Require().NoError(err, "expected no error during ibc erc20 registration")
+
+	ibcAcc, err := s.grpcHandler.GetAccount(sender.String())
+	s.Require().NoError(err)
+
+	coord := coordinator.NewIntegrationCoordinator(
+		s.T(),
+		[]commonnetwork.Network{s.unitNetwork},
+	)
+	coord.SetDefaultSignerForChain(chainID, senderPrivKey, ibcAcc)
+	connection := coord.Setup(chainID, coord.GetDummyChainsIds()[0])
+	s.Require().Equal(channelID, connection.EndpointA.ChannelID)
+
+	err = coord.CommitAll()
+	s.Require().NoError(err)
+
+	// The network context is not kept in sync with the coordinator, so the swap is
+	// executed on the context of the coordinated chain.
+	ctx := coord.GetChain(chainID).GetContext()
+	stateDB := statedb.New(
+		ctx,
+		s.unitNetwork.App.EvmKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())),
+	)
+	contract := vm.NewContract(vm.AccountRef(senderAddress), s.precompile, big.NewInt(0), uint64(2_000))
+	method := s.precompile.Methods[osmosis.SwapMethod]
+
+	_, err = s.precompile.Swap(ctx, senderAddress, stateDB, contract, &method, []interface{}{
+		senderAddress,
+		evmosTokenPair.GetERC20Contract(),
+		osmoTokenPair.GetERC20Contract(),
+		transferAmount,
+		slippagePercentage,
+		windowSeconds,
+		osmoAddress,
+	})
+	s.Require().NoError(err, "expected no error during swap")
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().NoError(err, "expected the swap to send an IBC packet")
+	s.Require().Equal(portID, packet.SourcePort)
+	s.Require().Equal(channelID, packet.SourceChannel)
+
+	var packetData transfertypes.FungibleTokenPacketData
+	err = transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
+	s.Require().NoError(err)
+	s.Require().Equal(xcsContract, packetData.Receiver)
+	err = ValidateAndParseWasmRoutedMemo(packetData.Memo, packetData.Receiver)
+	s.Require().NoError(err, "expected the memo to be routed to the XCS contract")
+
+	// Commit the packet on the source chain, then relay and acknowledge it.
+	err = coord.CommitNBlocks(chainID, 1)
+	s.Require().NoError(err)
+	err = coord.RelayPacket(packet)
+	s.Require().NoError(err, "expected no error relaying the swap packet")
+
+	ctx = coord.GetChain(chainID).GetContext()
+	channelKeeper := s.unitNetwork.App.IBCKeeper.ChannelKeeper
+	s.Require().False(
+		channelKeeper.HasPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence),
+		"expected the packet commitment to be removed after the acknowledgement",
+	)
+
+	dummyChain := coord.GetChain(connection.EndpointB.ChainID)
+	voucherDenom := utils.ComputeIBCDenom(packet.DestinationPort, packet.DestinationChannel, s.unitNetwork.GetDenom())
+	receiver := sdktypes.MustAccAddressFromBech32(xcsContract)
+	balance := dummyChain.GetSimApp().BankKeeper.GetBalance(dummyChain.GetContext(), receiver, voucherDenom)
+	s.Require().Equal(transferAmount.String(), balance.Amount.String(), "expected the XCS contract to receive the swapped tokens")
+}
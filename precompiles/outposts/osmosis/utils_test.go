@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 )
 
 // ParseStringAsJSON parses the given string into a JSON object. Returns an error if the string is
@@ -88,3 +89,12 @@ func ValidateAndParseWasmRoutedMemo(
 
 	return nil
 }
+
+// setOutposts replaces the outposts configuration of the erc20 module parameters.
+func (s *PrecompileTestSuite) setOutposts(outposts ...erc20types.Outpost) {
+	ctx := s.unitNetwork.GetContext()
+	params := s.unitNetwork.App.Erc20Keeper.GetParams(ctx)
+	params.Outposts = outposts
+	err := s.unitNetwork.App.Erc20Keeper.SetParams(ctx, params)
+	s.Require().NoError(err, "expected no error setting the outpost params")
+}
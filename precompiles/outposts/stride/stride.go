@@ -18,6 +18,7 @@ import (
 	cmn "github.com/evmos/evmos/v15/precompiles/common"
 	"github.com/evmos/evmos/v15/precompiles/ics20"
 	erc20keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 	transferkeeper "github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
 )
 
@@ -30,8 +31,6 @@ var f embed.FS
 
 type Precompile struct {
 	cmn.Precompile
-	portID         string
-	channelID      string
 	timeoutHeight  clienttypes.Height
 	transferKeeper transferkeeper.Keeper
 	erc20Keeper    erc20keeper.Keeper
@@ -39,9 +38,9 @@ type Precompile struct {
 }
 
 // NewPrecompile creates a new Stride outpost Precompile instance as a
-// PrecompiledContract interface.
+// PrecompiledContract interface. The IBC port and channel used by the outpost
+// are read from the erc20 module parameters on each transaction.
 func NewPrecompile(
-	portID, channelID string,
 	transferKeeper transferkeeper.Keeper,
 	erc20Keeper erc20keeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
@@ -60,8 +59,6 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
-		portID:         portID,
-		channelID:      channelID,
 		timeoutHeight:  clienttypes.NewHeight(ics20.DefaultTimeoutHeight, ics20.DefaultTimeoutHeight),
 		transferKeeper: transferKeeper,
 		erc20Keeper:    erc20Keeper,
@@ -77,7 +74,7 @@ func LoadABI() (abi.ABI, error) {
 
 // Address defines the address of the Stride Outpost precompile contract.
 func (Precompile) Address() common.Address {
-	return common.HexToAddress("0x0000000000000000000000000000000000000900")
+	return common.HexToAddress(erc20types.StrideOutpostAddress)
 }
 
 // IsStateful returns true since the precompile contract has access to the
@@ -68,6 +68,13 @@ func (p Precompile) LiquidStake(
 		return nil, fmt.Errorf(ErrUnsupportedToken, token, tokenPair.Erc20Address)
 	}
 
+	// The IBC port and channel are governance controlled parameters of the
+	// erc20 module.
+	outpost, err := p.erc20Keeper.GetOutpost(ctx, p.Address())
+	if err != nil {
+		return nil, err
+	}
+
 	coin := sdk.Coin{Denom: tokenPair.Denom, Amount: sdk.NewIntFromBigInt(amount)}
 
 	// Create the memo for the ICS20 transfer packet
@@ -78,8 +85,8 @@ func (p Precompile) LiquidStake(
 
 	// Build the MsgTransfer with the memo and coin
 	msg, err := ics20.CreateAndValidateMsgTransfer(
-		p.portID,
-		p.channelID,
+		outpost.PortID,
+		outpost.ChannelID,
 		coin,
 		sdk.AccAddress(sender.Bytes()).String(),
 		receiver,
@@ -159,10 +166,17 @@ func (p Precompile) RedeemStake(
 		return nil, err
 	}
 
+	// The IBC port and channel are governance controlled parameters of the
+	// erc20 module.
+	outpost, err := p.erc20Keeper.GetOutpost(ctx, p.Address())
+	if err != nil {
+		return nil, err
+	}
+
 	bondDenom := p.stakingKeeper.BondDenom(ctx)
 	stToken := "st" + bondDenom
 
-	ibcDenom := utils.ComputeIBCDenom(p.portID, p.channelID, stToken)
+	ibcDenom := utils.ComputeIBCDenom(outpost.PortID, outpost.ChannelID, stToken)
 
 	tokenPairID := p.erc20Keeper.GetDenomMap(ctx, ibcDenom)
 	tokenPair, found := p.erc20Keeper.GetTokenPair(ctx, tokenPairID)
@@ -184,8 +198,8 @@ func (p Precompile) RedeemStake(
 
 	// Build the MsgTransfer with the memo and coin
 	msg, err := ics20.CreateAndValidateMsgTransfer(
-		p.portID,
-		p.channelID,
+		outpost.PortID,
+		outpost.ChannelID,
 		coin,
 		sdk.AccAddress(sender.Bytes()).String(),
 		strideForwarder,
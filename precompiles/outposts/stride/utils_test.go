@@ -244,10 +244,18 @@ func (s *PrecompileTestSuite) NewTestChainWithValSet(coord *ibctesting.Coordinat
 	s.app.FeeMarketKeeper.SetBlockGasWanted(s.ctx, 0)
 	s.app.FeeMarketKeeper.SetTransientBlockGasWanted(s.ctx, 0)
 
-	precompile, err := stride.NewPrecompile(portID, channelID, s.app.TransferKeeper, s.app.Erc20Keeper, s.app.AuthzKeeper, s.app.StakingKeeper)
+	precompile, err := stride.NewPrecompile(s.app.TransferKeeper, s.app.Erc20Keeper, s.app.AuthzKeeper, s.app.StakingKeeper)
 	s.Require().NoError(err)
 	s.precompile = precompile
 
+	// Route the outpost packets through the channel opened with the test chain
+	erc20Params := s.app.Erc20Keeper.GetParams(s.ctx)
+	erc20Params.Outposts = []erc20types.Outpost{
+		erc20types.NewOutpost(precompile.Address().String(), portID, channelID, ""),
+	}
+	err = s.app.Erc20Keeper.SetParams(s.ctx, erc20Params)
+	s.Require().NoError(err)
+
 	queryHelperEvm := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
 	evmtypes.RegisterQueryServer(queryHelperEvm, s.app.EvmKeeper)
 	s.queryClientEVM = evmtypes.NewQueryClient(queryHelperEvm)
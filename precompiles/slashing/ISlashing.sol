@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The ISlashing contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The ISlashing contract's instance.
+ISlashing constant SLASHING_CONTRACT = ISlashing(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev SigningInfo represents the signing info of a validator, identified by
+/// its consensus address. The jailedUntil time is a UNIX timestamp.
+struct SigningInfo {
+    address validatorAddress;
+    int64 startHeight;
+    int64 indexOffset;
+    int64 jailedUntil;
+    bool tombstoned;
+    int64 missedBlocksCounter;
+}
+
+/// @dev Params represents the slashing module parameters. The decimals are
+/// returned as strings and the downtime jail duration in seconds.
+struct Params {
+    int64 signedBlocksWindow;
+    string minSignedPerWindow;
+    int64 downtimeJailDuration;
+    string slashFractionDoubleSign;
+    string slashFractionDowntime;
+}
+
+/// @author Evmos Team
+/// @title Slashing Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the slashing module.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface ISlashing {
+    /// @dev ValidatorUnjailed defines an Event emitted when a validator is unjailed.
+    /// @param validator The operator address of the unjailed validator.
+    event ValidatorUnjailed(address indexed validator);
+
+    /// TRANSACTIONS
+
+    /// @dev Unjails a validator after its jail period has ended. The transaction
+    /// must be signed by the validator operator.
+    /// @param validatorAddress The operator address of the validator.
+    /// @return success Whether the validator was unjailed.
+    function unjail(address validatorAddress) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Queries the signing info of a validator.
+    /// @param consAddress The consensus address of the validator.
+    /// @return signingInfo The signing info of the validator.
+    function signingInfo(
+        address consAddress
+    ) external view returns (SigningInfo memory signingInfo);
+
+    /// @dev Queries the signing info of all the validators.
+    /// @param pagination The pagination options.
+    /// @return signingInfos The signing info of the validators.
+    /// @return pageResponse The pagination response.
+    function signingInfos(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            SigningInfo[] memory signingInfos,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Queries the slashing module parameters.
+    /// @return params The slashing module parameters.
+    function params() external view returns (Params memory params);
+}
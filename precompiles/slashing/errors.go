@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+const (
+	// ErrDifferentOriginFromValidator is raised when the origin address is not the same as the
+	// validator operator address.
+	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator operator address %s"
+	// ErrInvalidValidator is raised when the validator operator address is not valid.
+	ErrInvalidValidator = "invalid validator address: %v"
+	// ErrInvalidConsAddress is raised when the consensus address is not valid.
+	ErrInvalidConsAddress = "invalid consensus address: %v"
+)
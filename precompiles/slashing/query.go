@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+const (
+	// SigningInfoMethod defines the ABI method name for the slashing SigningInfo query.
+	SigningInfoMethod = "signingInfo"
+	// SigningInfosMethod defines the ABI method name for the slashing SigningInfos query.
+	SigningInfosMethod = "signingInfos"
+	// ParamsMethod defines the ABI method name for the slashing Params query.
+	ParamsMethod = "params"
+)
+
+// SigningInfo returns the signing info of the validator with the given consensus address.
+func (p Precompile) SigningInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfoRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.slashingKeeper.SigningInfo(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(SigningInfo).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// SigningInfos returns the signing info of all the validators.
+func (p Precompile) SigningInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewSigningInfosRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.slashingKeeper.SigningInfos(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(SigningInfosOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Pack(method.Outputs)
+}
+
+// Params returns the slashing module parameters.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	res, err := p.slashingKeeper.Params(sdk.WrapSDKContext(ctx), &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(new(Params).FromResponse(res))
+}
@@ -0,0 +1,128 @@
+package slashing_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/slashing"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestSigningInfo() {
+	method := s.precompile.Methods[slashing.SigningInfoMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - signing info not found",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress()}
+			},
+			func([]byte) {},
+			true,
+			"not found",
+		},
+		{
+			"success",
+			func() []interface{} {
+				_, consAddr := s.jailValidator()
+				return []interface{}{common.BytesToAddress(consAddr)}
+			},
+			func(bz []byte) {
+				var out struct {
+					SigningInfo slashing.SigningInfo
+				}
+				err := s.precompile.UnpackIntoInterface(&out, slashing.SigningInfoMethod, bz)
+				s.Require().NoError(err)
+
+				s.Require().Equal(s.network.GetContext().BlockHeight(), out.SigningInfo.StartHeight)
+				s.Require().Equal(s.network.GetContext().BlockTime().Unix(), out.SigningInfo.JailedUntil)
+				s.Require().False(out.SigningInfo.Tombstoned)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.SigningInfo(s.network.GetContext(), &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSigningInfos() {
+	method := s.precompile.Methods[slashing.SigningInfosMethod]
+
+	s.Run("success - paginated signing infos", func() {
+		s.SetupTest()
+		_, consAddr := s.jailValidator()
+
+		bz, err := s.precompile.SigningInfos(s.network.GetContext(), &method, []interface{}{
+			query.PageRequest{Limit: 10, CountTotal: true},
+		})
+		s.Require().NoError(err)
+
+		var out slashing.SigningInfosOutput
+		err = s.precompile.UnpackIntoInterface(&out, slashing.SigningInfosMethod, bz)
+		s.Require().NoError(err)
+
+		s.Require().NotEmpty(out.SigningInfos)
+		s.Require().Equal(uint64(len(out.SigningInfos)), out.PageResponse.Total)
+
+		addresses := make([]common.Address, len(out.SigningInfos))
+		for i, info := range out.SigningInfos {
+			addresses[i] = info.ValidatorAddress
+		}
+		s.Require().Contains(addresses, common.BytesToAddress(consAddr))
+	})
+}
+
+func (s *PrecompileTestSuite) TestParams() {
+	method := s.precompile.Methods[slashing.ParamsMethod]
+
+	s.Run("success", func() {
+		s.SetupTest()
+
+		bz, err := s.precompile.Params(s.network.GetContext(), &method, []interface{}{})
+		s.Require().NoError(err)
+
+		var out struct {
+			Params slashing.Params
+		}
+		err = s.precompile.UnpackIntoInterface(&out, slashing.ParamsMethod, bz)
+		s.Require().NoError(err)
+
+		params := s.network.App.SlashingKeeper.GetParams(s.network.GetContext())
+		s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+		s.Require().Equal(params.MinSignedPerWindow.String(), out.Params.MinSignedPerWindow)
+		s.Require().Equal(int64(params.DowntimeJailDuration.Seconds()), out.Params.DowntimeJailDuration)
+		s.Require().Equal(params.SlashFractionDoubleSign.String(), out.Params.SlashFractionDoubleSign)
+		s.Require().Equal(params.SlashFractionDowntime.String(), out.Params.SlashFractionDowntime)
+	})
+}
@@ -0,0 +1,55 @@
+package slashing_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v15/precompiles/slashing"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the slashing precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	bondDenom string
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+	stateDB *statedb.StateDB
+
+	precompile *slashing.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	ctx := integrationNetwork.GetContext()
+	bondDenom := integrationNetwork.App.StakingKeeper.BondDenom(ctx)
+	s.Require().NotEmpty(bondDenom, "bond denom cannot be empty")
+
+	s.bondDenom = bondDenom
+	s.keyring = keyring
+	s.network = integrationNetwork
+	s.stateDB = integrationNetwork.GetStateDB()
+
+	precompile, err := slashing.NewPrecompile(
+		integrationNetwork.App.SlashingKeeper,
+		integrationNetwork.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create slashing precompile")
+	s.precompile = precompile
+}
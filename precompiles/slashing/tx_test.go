@@ -0,0 +1,89 @@
+package slashing_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/slashing"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin common.Address, args []interface{})
+		postCheck   func(validator common.Address, data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "fail - invalid number of args",
+			malleate: func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			name: "fail - empty validator address",
+			malleate: func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{common.Address{}}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(slashing.ErrInvalidValidator, common.Address{}),
+		},
+		{
+			name: "fail - origin is not the validator operator",
+			malleate: func() (common.Address, []interface{}) {
+				validator, _ := s.jailValidator()
+				return s.keyring.GetAddr(0), []interface{}{validator}
+			},
+			expError:    true,
+			errContains: "is not the same as validator operator address",
+		},
+		{
+			name: "fail - validator does not exist",
+			malleate: func() (common.Address, []interface{}) {
+				validator := utiltx.GenerateAddress()
+				return validator, []interface{}{validator}
+			},
+			expError:    true,
+			errContains: "address is not associated with any known validator",
+		},
+		{
+			name: "success",
+			malleate: func() (common.Address, []interface{}) {
+				validator, _ := s.jailValidator()
+				return validator, []interface{}{validator}
+			},
+			postCheck: func(validator common.Address, data []byte) {
+				s.Require().Equal(cmn.TrueValue, data)
+
+				val, found := s.network.App.StakingKeeper.GetValidator(s.network.GetContext(), validator.Bytes())
+				s.Require().True(found)
+				s.Require().False(val.IsJailed())
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			origin, args := tc.malleate()
+			bz, err := s.precompile.Unjail(s.network.GetContext(), origin, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(origin, bz)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// SigningInfo represents the signing info of a validator. The validator is
+// identified by its consensus address and the jailed until time is a UNIX timestamp.
+type SigningInfo struct {
+	ValidatorAddress    common.Address `abi:"validatorAddress"`
+	StartHeight         int64          `abi:"startHeight"`
+	IndexOffset         int64          `abi:"indexOffset"`
+	JailedUntil         int64          `abi:"jailedUntil"`
+	Tombstoned          bool           `abi:"tombstoned"`
+	MissedBlocksCounter int64          `abi:"missedBlocksCounter"`
+}
+
+// Params represents the slashing module parameters. The decimals are returned
+// as strings and the downtime jail duration in seconds.
+type Params struct {
+	SignedBlocksWindow      int64  `abi:"signedBlocksWindow"`
+	MinSignedPerWindow      string `abi:"minSignedPerWindow"`
+	DowntimeJailDuration    int64  `abi:"downtimeJailDuration"`
+	SlashFractionDoubleSign string `abi:"slashFractionDoubleSign"`
+	SlashFractionDowntime   string `abi:"slashFractionDowntime"`
+}
+
+// SigningInfosInput is a struct used to parse the arguments of the signingInfos query.
+type SigningInfosInput struct {
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// SigningInfosOutput is a struct to represent the key information from a SigningInfos response.
+type SigningInfosOutput struct {
+	SigningInfos []SigningInfo
+	PageResponse query.PageResponse
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance for the validator whose operator
+// account is the given address.
+func NewMsgUnjail(args []interface{}) (*slashingtypes.MsgUnjail, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorHexAddr, ok := args[0].(common.Address)
+	if !ok || validatorHexAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidValidator, args[0])
+	}
+
+	msg := slashingtypes.NewMsgUnjail(sdk.ValAddress(validatorHexAddr.Bytes()))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, validatorHexAddr, nil
+}
+
+// NewSigningInfoRequest creates a new QuerySigningInfoRequest instance.
+func NewSigningInfoRequest(args []interface{}) (*slashingtypes.QuerySigningInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consAddress, ok := args[0].(common.Address)
+	if !ok || consAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consAddress.Bytes()).String(),
+	}, nil
+}
+
+// NewSigningInfosRequest creates a new QuerySigningInfosRequest instance.
+func NewSigningInfosRequest(method *abi.Method, args []interface{}) (*slashingtypes.QuerySigningInfosRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input SigningInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SigningInfosInput struct: %s", err)
+	}
+
+	return &slashingtypes.QuerySigningInfosRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the SigningInfo from a QuerySigningInfoResponse.
+func (si *SigningInfo) FromResponse(res *slashingtypes.QuerySigningInfoResponse) (*SigningInfo, error) {
+	info, err := newSigningInfo(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	*si = info
+	return si, nil
+}
+
+// FromResponse populates the SigningInfosOutput from a QuerySigningInfosResponse.
+func (sio *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfosResponse) (*SigningInfosOutput, error) {
+	sio.SigningInfos = make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfo, err := newSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		sio.SigningInfos[i] = signingInfo
+	}
+
+	if res.Pagination != nil {
+		sio.PageResponse.Total = res.Pagination.Total
+		sio.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return sio, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (sio *SigningInfosOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(sio.SigningInfos, sio.PageResponse)
+}
+
+// FromResponse populates the Params from a QueryParamsResponse.
+func (sp *Params) FromResponse(res *slashingtypes.QueryParamsResponse) *Params {
+	sp.SignedBlocksWindow = res.Params.SignedBlocksWindow
+	sp.MinSignedPerWindow = res.Params.MinSignedPerWindow.String()
+	sp.DowntimeJailDuration = int64(res.Params.DowntimeJailDuration.Seconds())
+	sp.SlashFractionDoubleSign = res.Params.SlashFractionDoubleSign.String()
+	sp.SlashFractionDowntime = res.Params.SlashFractionDowntime.String()
+	return sp
+}
+
+// newSigningInfo converts the signing info of the slashing module into a SigningInfo.
+func newSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddress, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, err
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddress),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
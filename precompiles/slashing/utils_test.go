@@ -0,0 +1,39 @@
+package slashing_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// jailValidator self-delegates to the first validator of the network and jails it
+// until the current block time, so that it can be unjailed right away. It returns
+// the hex address of the validator operator and the validator consensus address.
+func (s *PrecompileTestSuite) jailValidator() (common.Address, sdk.ConsAddress) {
+	ctx := s.network.GetContext()
+	app := s.network.App
+
+	validator := s.network.GetValidators()[0]
+	valAddr := validator.GetOperator()
+	operator := sdk.AccAddress(valAddr)
+
+	amount := sdk.NewInt64Coin(s.bondDenom, 1e18)
+	err := app.BankKeeper.SendCoins(ctx, s.keyring.GetAccAddr(0), operator, sdk.NewCoins(amount))
+	s.Require().NoError(err, "failed to fund the validator operator")
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&app.StakingKeeper)
+	_, err = msgSrv.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(operator, valAddr, amount))
+	s.Require().NoError(err, "failed to self-delegate")
+
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+
+	app.StakingKeeper.Jail(ctx, consAddr)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, ctx.BlockHeight(), 0, ctx.BlockTime(), false, 0,
+	))
+
+	return common.BytesToAddress(valAddr), consAddr
+}
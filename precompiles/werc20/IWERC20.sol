@@ -2,13 +2,14 @@
 pragma solidity >=0.8.18;
 
 import "./../erc20/IERC20MetadataAllowance.sol";
+import "./../erc20/IERC20Permit.sol";
 
 /**
  * @author Evmos Team
  * @title Wrapped ERC20 Interface
  * @dev Interface for representing the native EVM token as ERC20 standard.
  */
-interface IWERC20 is IERC20MetadataAllowance {
+interface IWERC20 is IERC20MetadataAllowance, IERC20Permit {
 		/// @dev Emitted when the native tokens are deposited in exchange for the wrapped ERC20.
     /// @param dst The account for which the deposit is made.
     /// @param wad The amount of native tokens deposited.
@@ -26,14 +27,14 @@ interface IWERC20 is IERC20MetadataAllowance {
     receive() external payable;
 
     /// @dev Deposits native tokens in exchange for wrapped ERC20 token.
-    /// @dev After execution of this function the SetBalance function
-    /// @dev burns the tokens and increases the contract balance of the ERC20 tokens.
+    /// @dev The native tokens are held by the precompile and the same amount
+    /// @dev of wrapped tokens is minted to the caller.
     /// @dev Emits a Deposit Event.
     function deposit() external payable;
 
     /// @dev Withdraws native tokens from wrapped ERC20 token.
-    /// @dev After execution of this function the SetBalance function
-    /// @dev decreases the contract balance of the ERC20 tokens and mints the tokens.
+    /// @dev The wrapped tokens of the caller are burned and the same amount
+    /// @dev of native tokens held by the precompile is sent to the caller.
     /// @dev Emits a Withdrawal Event.
     /// @param wad The amount of native tokens to be withdrawn.
     function withdraw(uint256 wad) external;
@@ -18,8 +18,8 @@ import (
 const (
 	// EventTypeDeposit defines the event type for the Deposit transaction.
 	EventTypeDeposit = "Deposit"
-	// EventTypeWithdraw defines the event type for the Withdraw transaction.
-	EventTypeWithdraw = "Withdraw"
+	// EventTypeWithdrawal defines the event type for the Withdraw transaction.
+	EventTypeWithdrawal = "Withdrawal"
 )
 
 // EmitDepositEvent creates a new Deposit event emitted on a Deposit transaction.
@@ -28,9 +28,9 @@ func (p Precompile) EmitDepositEvent(ctx sdk.Context, stateDB vm.StateDB, dst co
 	return p.createWERC20Event(ctx, stateDB, event, dst, amount)
 }
 
-// EmitDepositEvent creates a new Withdraw event emitted on Withdraw transaction.
-func (p Precompile) EmitWithdrawEvent(ctx sdk.Context, stateDB vm.StateDB, src common.Address, amount *big.Int) error {
-	event := p.ABI.Events[EventTypeWithdraw]
+// EmitWithdrawalEvent creates a new Withdrawal event emitted on Withdraw transaction.
+func (p Precompile) EmitWithdrawalEvent(ctx sdk.Context, stateDB vm.StateDB, src common.Address, amount *big.Int) error {
+	event := p.ABI.Events[EventTypeWithdrawal]
 	return p.createWERC20Event(ctx, stateDB, event, src, amount)
 }
 
@@ -53,8 +53,7 @@ func (p Precompile) createWERC20Event(
 		return err
 	}
 
-	arguments := abi.Arguments{event.Inputs[2]}
-	packed, err := arguments.Pack(amount)
+	packed, err := event.Inputs.NonIndexed().Pack(amount)
 	if err != nil {
 		return err
 	}
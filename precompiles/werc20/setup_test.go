@@ -0,0 +1,59 @@
+package werc20_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v15/precompiles/werc20"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the WERC20
+// precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	// evmDenom is the native coin wrapped by the precompile.
+	evmDenom string
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *werc20.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	ctx := integrationNetwork.GetContext()
+	evmDenom := integrationNetwork.App.EvmKeeper.GetParams(ctx).EvmDenom
+	s.Require().NotEmpty(evmDenom, "evm denom cannot be empty")
+
+	s.evmDenom = evmDenom
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	tokenPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), evmDenom, erc20types.OWNER_MODULE)
+	precompile, err := werc20.NewPrecompile(
+		tokenPair,
+		integrationNetwork.App.BankKeeper,
+		integrationNetwork.App.AuthzKeeper,
+		integrationNetwork.App.TransferKeeper,
+		integrationNetwork.App.Erc20Keeper,
+	)
+	s.Require().NoError(err, "failed to create werc20 precompile")
+	s.precompile = precompile
+}
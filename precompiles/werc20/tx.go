@@ -4,9 +4,16 @@
 package werc20
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
 )
 
 const (
@@ -18,9 +25,10 @@ const (
 	WithdrawMethod = "withdraw"
 )
 
-// Deposit is a no-op and mock function that provides the same interface as the
-// WETH contract to support equality between the native coin and its wrapped
-// ERC-20 (eg. EVMOS and WEVMOS). It only emits the Deposit event.
+// Deposit wraps the native coins sent with the call, like the WETH contract. The
+// EVM transfers the value to the precompile address, which holds it, before
+// running the precompile, and the same amount of the wrapped coin is minted to
+// the caller.
 func (p Precompile) Deposit(
 	ctx sdk.Context,
 	contract *vm.Contract,
@@ -31,6 +39,16 @@ func (p Precompile) Deposit(
 	dst := contract.Caller()
 	amount := contract.Value()
 
+	if amount.Sign() > 0 {
+		coins := sdk.Coins{{Denom: p.wrappedDenom, Amount: sdk.NewIntFromBigInt(amount)}}
+		if err := p.bankKeeper.MintCoins(ctx, erc20types.ModuleName, coins); err != nil {
+			return nil, err
+		}
+		if err := p.bankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, dst.Bytes(), coins); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := p.EmitDepositEvent(ctx, stateDB, dst, amount); err != nil {
 		return nil, err
 	}
@@ -38,20 +56,54 @@ func (p Precompile) Deposit(
 	return nil, nil
 }
 
-// Withdraw is a no-op and mock function that provides the same interface as the
-// WETH contract to support equality between the native coin and its wrapped
-// ERC-20 (eg. EVMOS and WEVMOS). It only emits the Withdraw event.
+// Withdraw unwraps the given amount of the wrapped coin, like the WETH contract.
+// The wrapped coins of the caller are burned and the same amount of native coins
+// held by the precompile is sent back to the caller.
 func (p Precompile) Withdraw(
 	ctx sdk.Context,
 	contract *vm.Contract,
 	stateDB vm.StateDB,
 	_ *abi.Method,
-	_ []interface{},
+	args []interface{},
 ) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "wad", &big.Int{}, args[0])
+	}
+
+	// the balances of the EVM denomination are synced with the stateDB
+	evmStateDB, ok := stateDB.(*statedb.StateDB)
+	if !ok {
+		return nil, errors.New(cmn.ErrNotRunInEvm)
+	}
+
 	src := contract.Caller()
-	amount := contract.Value()
 
-	if err := p.EmitWithdrawEvent(ctx, stateDB, src, amount); err != nil {
+	if amount.Sign() > 0 {
+		wrappedCoins := sdk.Coins{{Denom: p.wrappedDenom, Amount: sdk.NewIntFromBigInt(amount)}}
+		if err := p.bankKeeper.SendCoinsFromAccountToModule(ctx, src.Bytes(), erc20types.ModuleName, wrappedCoins); err != nil {
+			return nil, err
+		}
+		if err := p.bankKeeper.BurnCoins(ctx, erc20types.ModuleName, wrappedCoins); err != nil {
+			return nil, err
+		}
+
+		nativeCoins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: sdk.NewIntFromBigInt(amount)}}
+		if err := p.bankKeeper.SendCoins(ctx, p.Address().Bytes(), src.Bytes(), nativeCoins); err != nil {
+			return nil, err
+		}
+
+		// the native coin is the EVM denomination, so the balances in the
+		// stateDB must reflect the coins sent through the bank keeper
+		evmStateDB.SyncBalance(p.Address())
+		evmStateDB.SyncBalance(src)
+	}
+
+	if err := p.EmitWithdrawalEvent(ctx, stateDB, src, amount); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,379 @@
+package werc20_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+	auth "github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/erc20"
+	"github.com/evmos/evmos/v15/precompiles/werc20"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// deposit wraps the given amount of native coins of the caller. As the EVM does
+// before running the precompile, it first sends the value to the precompile.
+func (s *PrecompileTestSuite) deposit(stateDB *statedb.StateDB, caller common.Address, amount *big.Int) {
+	ctx := s.network.GetContext()
+	coins := sdk.NewCoins(sdk.NewCoin(s.evmDenom, sdk.NewIntFromBigInt(amount)))
+	err := s.network.App.BankKeeper.SendCoins(ctx, caller.Bytes(), s.precompile.Address().Bytes(), coins)
+	s.Require().NoError(err)
+
+	contract := vm.NewContract(vm.AccountRef(caller), s.precompile, amount, 200_000)
+	method := s.precompile.Methods[werc20.DepositMethod]
+	_, err = s.precompile.Deposit(ctx, contract, stateDB, &method, []interface{}{})
+	s.Require().NoError(err)
+}
+
+// requireSupplyInvariant checks that the supply of the wrapped coin is equal to
+// the native coins held by the precompile.
+func (s *PrecompileTestSuite) requireSupplyInvariant(expSupply int64) {
+	ctx := s.network.GetContext()
+	supply := s.network.App.BankKeeper.GetSupply(ctx, werc20.WrappedDenom(s.evmDenom))
+	escrow := s.network.App.BankKeeper.GetBalance(ctx, s.precompile.Address().Bytes(), s.evmDenom)
+
+	s.Require().Equal(expSupply, supply.Amount.Int64(), "unexpected wrapped supply")
+	s.Require().Equal(supply.Amount, escrow.Amount, "wrapped supply doesn't match the native coins held by the precompile")
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	testCases := []struct {
+		name   string
+		amount *big.Int
+	}{
+		{"pass - zero value", big.NewInt(0)},
+		{"pass - wrap the value", big.NewInt(1000)},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			caller := s.keyring.GetAddr(0)
+			nativeBalance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), caller.Bytes(), s.evmDenom)
+
+			s.deposit(stateDB, caller, tc.amount)
+
+			ctx := s.network.GetContext()
+			wrappedBalance := s.network.App.BankKeeper.GetBalance(ctx, caller.Bytes(), werc20.WrappedDenom(s.evmDenom))
+			s.Require().Equal(tc.amount.Int64(), wrappedBalance.Amount.Int64())
+			s.Require().Equal(
+				nativeBalance.Amount.Sub(sdk.NewIntFromBigInt(tc.amount)),
+				s.network.App.BankKeeper.GetBalance(ctx, caller.Bytes(), s.evmDenom).Amount,
+			)
+			s.requireSupplyInvariant(tc.amount.Int64())
+			s.Require().Len(stateDB.Logs(), 1)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestWithdraw() {
+	method := s.precompile.Methods[werc20.WithdrawMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expSupply   int64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			1000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - more than the wrapped balance",
+			func() []interface{} {
+				return []interface{}{big.NewInt(1001)}
+			},
+			1000,
+			true,
+			"insufficient funds",
+		},
+		{
+			"pass - partial withdrawal",
+			func() []interface{} {
+				return []interface{}{big.NewInt(400)}
+			},
+			600,
+			false,
+			"",
+		},
+		{
+			"pass - full withdrawal",
+			func() []interface{} {
+				return []interface{}{big.NewInt(1000)}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			caller := s.keyring.GetAddr(0)
+
+			s.deposit(stateDB, caller, big.NewInt(1000))
+			// load the caller account in the stateDB cache
+			nativeBalance := stateDB.GetBalance(caller)
+
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, common.Big0, 200_000)
+			args := tc.malleate()
+			_, err := s.precompile.Withdraw(s.network.GetContext(), contract, stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				withdrawn := args[0].(*big.Int)
+				expBalance := new(big.Int).Add(nativeBalance, withdrawn)
+				s.Require().Equal(expBalance, stateDB.GetBalance(caller), "expected the stateDB balance to be synced")
+
+				// committing the stateDB doesn't overwrite the bank balances
+				s.Require().NoError(stateDB.Commit())
+				s.Require().Equal(expBalance, s.network.App.BankKeeper.GetBalance(s.network.GetContext(), caller.Bytes(), s.evmDenom).Amount.BigInt())
+				s.Require().Len(stateDB.Logs(), 2)
+			}
+
+			s.requireSupplyInvariant(tc.expSupply)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferWrapped() {
+	method := s.precompile.Methods[erc20.TransferMethod]
+	stateDB := s.network.GetStateDB()
+	sender := s.keyring.GetAddr(0)
+	recipient := utiltx.GenerateAddress()
+
+	s.deposit(stateDB, sender, big.NewInt(1000))
+
+	contract := vm.NewContract(vm.AccountRef(sender), s.precompile, common.Big0, 200_000)
+	_, err := s.precompile.Transfer(s.network.GetContext(), contract, stateDB, &method, []interface{}{recipient, big.NewInt(300)})
+	s.Require().NoError(err)
+
+	ctx := s.network.GetContext()
+	wrappedDenom := werc20.WrappedDenom(s.evmDenom)
+	s.Require().Equal(int64(700), s.network.App.BankKeeper.GetBalance(ctx, sender.Bytes(), wrappedDenom).Amount.Int64())
+	s.Require().Equal(int64(300), s.network.App.BankKeeper.GetBalance(ctx, recipient.Bytes(), wrappedDenom).Amount.Int64())
+	// the transfer doesn't move native coins
+	s.Require().True(s.network.App.BankKeeper.GetBalance(ctx, recipient.Bytes(), s.evmDenom).IsZero())
+	s.requireSupplyInvariant(1000)
+}
+
+func (s *PrecompileTestSuite) TestRequiredGasReceiveAndFallback() {
+	depositGas := s.precompile.RequiredGas(s.precompile.Methods[werc20.DepositMethod].ID)
+
+	s.Require().Equal(depositGas, s.precompile.RequiredGas([]byte{}), "receive")
+	s.Require().Equal(depositGas, s.precompile.RequiredGas([]byte{0x1, 0x2, 0x3, 0x4}), "fallback")
+}
+
+func (s *PrecompileTestSuite) TestRunValue() {
+	recipient := utiltx.GenerateAddress()
+
+	transferInput, err := s.precompile.Pack(erc20.TransferMethod, recipient, big.NewInt(1))
+	s.Require().NoError(err)
+	withdrawInput, err := s.precompile.Pack(werc20.WithdrawMethod, big.NewInt(1))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		input       []byte
+		value       *big.Int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - value sent to transfer",
+			transferInput,
+			big.NewInt(100),
+			true,
+			fmt.Sprintf(cmn.ErrNonPayable, erc20.TransferMethod),
+		},
+		{
+			"fail - value sent to withdraw",
+			withdrawInput,
+			big.NewInt(100),
+			true,
+			fmt.Sprintf(cmn.ErrNonPayable, werc20.WithdrawMethod),
+		},
+		{
+			"pass - value sent to deposit",
+			s.precompile.Methods[werc20.DepositMethod].ID,
+			big.NewInt(100),
+			false,
+			"",
+		},
+		{
+			"pass - value sent to receive",
+			[]byte{},
+			big.NewInt(100),
+			false,
+			"",
+		},
+		{
+			"pass - value sent to fallback",
+			[]byte{0x1, 0x2, 0x3, 0x4},
+			big.NewInt(100),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			caller := s.keyring.GetAddr(0)
+
+			chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(s.network.GetEIP155ChainID())
+			evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{Origin: caller}, stateDB, chainConfig, vm.Config{})
+
+			// the EVM transfers the value to the precompile before running it
+			coins := sdk.NewCoins(sdk.NewCoin(s.evmDenom, sdk.NewIntFromBigInt(tc.value)))
+			err := s.network.App.BankKeeper.SendCoins(s.network.GetContext(), caller.Bytes(), s.precompile.Address().Bytes(), coins)
+			s.Require().NoError(err)
+
+			contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, tc.value, 200_000)
+			contract.Input = tc.input
+
+			_, err = s.precompile.Run(evm, contract, false)
+			s.Require().Equal(tc.input, contract.Input, "expected the contract input to be unchanged")
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.requireSupplyInvariant(tc.value.Int64())
+			}
+		})
+	}
+}
+
+// run runs the given input on the precompile, called by the given caller without value.
+func (s *PrecompileTestSuite) run(stateDB *statedb.StateDB, caller common.Address, input []byte) ([]byte, error) {
+	chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(s.network.GetEIP155ChainID())
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{Origin: caller}, stateDB, chainConfig, vm.Config{})
+
+	contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, common.Big0, 500_000)
+	contract.Input = input
+
+	return s.precompile.Run(evm, contract, false)
+}
+
+func (s *PrecompileTestSuite) TestPermitWrapped() {
+	owner := s.keyring.GetAddr(0)
+	spender := s.keyring.GetAddr(1)
+	amount := big.NewInt(300)
+
+	stateDB := s.network.GetStateDB()
+	deadline := big.NewInt(time.Now().Add(time.Hour).Unix())
+
+	s.deposit(stateDB, owner, big.NewInt(1000))
+
+	// the permits are signed for the name of the native coin
+	bz, err := s.run(stateDB, owner, s.precompile.Methods[erc20.NameMethod].ID)
+	s.Require().NoError(err)
+	out, err := s.precompile.Unpack(erc20.NameMethod, bz)
+	s.Require().NoError(err)
+	name, ok := out[0].(string)
+	s.Require().True(ok, "expected string name")
+	s.Require().NotEmpty(name)
+
+	bz, err = s.run(stateDB, owner, s.precompile.Methods[erc20.DomainSeparatorMethod].ID)
+	s.Require().NoError(err)
+	expDomainSeparator, err := eip712.PermitDomainSeparator(s.network.GetEIP155ChainID(), name, s.precompile.Address())
+	s.Require().NoError(err)
+	s.Require().Equal(expDomainSeparator.Bytes(), bz)
+
+	privKey, ok := s.keyring.GetPrivKey(0).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected eth_secp256k1 private key")
+	ecdsaPrivKey, err := privKey.ToECDSA()
+	s.Require().NoError(err)
+
+	typedData := eip712.WrapPermitToTypedData(
+		s.network.GetEIP155ChainID(), name, s.precompile.Address(), owner, spender, amount, common.Big0, deadline,
+	)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err)
+	signature, err := crypto.Sign(sigHash, ecdsaPrivKey)
+	s.Require().NoError(err)
+
+	var r, sig [32]byte
+	copy(r[:], signature[:32])
+	copy(sig[:], signature[32:64])
+	v := signature[crypto.RecoveryIDOffset] + 27
+
+	// NOTE: the permit is submitted by the spender, not the owner
+	permitInput, err := s.precompile.Pack(erc20.PermitMethod, owner, spender, amount, deadline, v, r, sig)
+	s.Require().NoError(err)
+	_, err = s.run(stateDB, spender, permitInput)
+	s.Require().NoError(err)
+
+	noncesInput, err := s.precompile.Pack(erc20.NoncesMethod, owner)
+	s.Require().NoError(err)
+	bz, err = s.run(stateDB, owner, noncesInput)
+	s.Require().NoError(err)
+	s.Require().Equal(common.BigToHash(common.Big1).Bytes(), bz, "expected the nonce to be incremented")
+
+	// the allowance is granted over the wrapped coin
+	allowanceInput, err := s.precompile.Pack(auth.AllowanceMethod, owner, spender)
+	s.Require().NoError(err)
+	bz, err = s.run(stateDB, owner, allowanceInput)
+	s.Require().NoError(err)
+	s.Require().Equal(common.BigToHash(amount).Bytes(), bz)
+
+	// the permit can't be replayed
+	_, err = s.run(stateDB, spender, permitInput)
+	s.Require().ErrorContains(err, "invalid permit signer")
+}
+
+func (s *PrecompileTestSuite) TestPreUpgradeHolder() {
+	method := s.precompile.Methods[erc20.BalanceOfMethod]
+	stateDB := s.network.GetStateDB()
+	holder := s.keyring.GetAddr(0)
+
+	balanceOf := func() *big.Int {
+		contract := vm.NewContract(vm.AccountRef(holder), s.precompile, common.Big0, 200_000)
+		bz, err := s.precompile.BalanceOf(s.network.GetContext(), contract, stateDB, &method, []interface{}{holder})
+		s.Require().NoError(err)
+		out, err := s.precompile.Unpack(erc20.BalanceOfMethod, bz)
+		s.Require().NoError(err)
+		return out[0].(*big.Int)
+	}
+
+	// the WEVMOS balance of a holder was its native balance before the upgrade,
+	// which is kept but no longer counted as wrapped
+	ctx := s.network.GetContext()
+	nativeBalance := s.network.App.BankKeeper.GetBalance(ctx, holder.Bytes(), s.evmDenom)
+	s.Require().True(nativeBalance.IsPositive())
+	s.Require().Zero(balanceOf().Sign())
+
+	// the holder gets the wrapped balance back by depositing the native coins
+	s.deposit(stateDB, holder, big.NewInt(1000))
+	s.Require().Equal(int64(1000), balanceOf().Int64())
+	s.Require().Equal(
+		nativeBalance.Amount.SubRaw(1000),
+		s.network.App.BankKeeper.GetBalance(s.network.GetContext(), holder.Bytes(), s.evmDenom).Amount,
+	)
+	s.requireSupplyInvariant(1000)
+}
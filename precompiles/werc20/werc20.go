@@ -5,6 +5,7 @@ package werc20
 
 import (
 	"embed"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -17,8 +18,14 @@ import (
 	transferkeeper "github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
 )
 
-// abiPath defines the path to the WERC-20 precompile ABI JSON file.
-const abiPath = "abi.json"
+const (
+	// abiPath defines the path to the WERC-20 precompile ABI JSON file.
+	abiPath = "abi.json"
+
+	// WrappedDenomPrefix defines the prefix of the bank denomination of the
+	// wrapped coin.
+	WrappedDenomPrefix = erc20.WrappedDenomPrefix
+)
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
 //
@@ -27,9 +34,23 @@ var f embed.FS
 
 var _ vm.PrecompiledContract = &Precompile{}
 
-// Precompile defines the precompiled contract for WERC20.
+// Precompile defines the precompiled contract for WERC20. The embedded ERC-20
+// precompile operates on the wrapped coin, which is a separate bank denomination
+// backed by the native coins held by the precompile address.
 type Precompile struct {
 	*erc20.Precompile
+	// native is the ERC-20 precompile of the native coin, used for the token
+	// metadata queries.
+	native       *erc20.Precompile
+	tokenPair    erc20types.TokenPair
+	wrappedDenom string
+	bankKeeper   bankkeeper.Keeper
+}
+
+// WrappedDenom returns the bank denomination of the wrapped coin for the given
+// native denomination.
+func WrappedDenom(denom string) string {
+	return WrappedDenomPrefix + denom
 }
 
 // NewPrecompile creates a new WERC20 Precompile instance as a
@@ -39,13 +60,23 @@ func NewPrecompile(
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
+	erc20Keeper erc20.Erc20Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, abiPath)
 	if err != nil {
 		return nil, err
 	}
 
-	erc20Precompile, err := erc20.NewPrecompile(tokenPair, bankKeeper, authzKeeper, transferKeeper)
+	nativePrecompile, err := erc20.NewPrecompile(tokenPair, bankKeeper, authzKeeper, transferKeeper, erc20Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	// the ERC-20 transactions and queries operate on the wrapped coin
+	wrappedPair := tokenPair
+	wrappedPair.Denom = WrappedDenom(tokenPair.Denom)
+
+	erc20Precompile, err := erc20.NewPrecompile(wrappedPair, bankKeeper, authzKeeper, transferKeeper, erc20Keeper)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +85,11 @@ func NewPrecompile(
 	erc20Precompile.Precompile.ABI = newABI
 
 	return &Precompile{
-		Precompile: erc20Precompile,
+		Precompile:   erc20Precompile,
+		native:       nativePrecompile,
+		tokenPair:    tokenPair,
+		wrappedDenom: wrappedPair.Denom,
+		bankKeeper:   bankKeeper,
 	}, nil
 }
 
@@ -65,6 +100,12 @@ func (p Precompile) Address() common.Address {
 
 // RequiredGas calculates the contract gas use.
 func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: the receive and fallback functions call the deposit method, so they
+	// require the gas of the deposit method
+	if p.isReceiveOrFallback(input) {
+		input = p.Methods[DepositMethod].ID
+	}
+
 	methodID := input[:4]
 	method, err := p.MethodById(methodID)
 	if err != nil {
@@ -75,7 +116,7 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	// We should execute the transactions from Evmos testnet
 	// to ensure parity in the values.
 	switch method.Name {
-	case cmn.FallbackMethod, cmn.ReceiveMethod, DepositMethod:
+	case DepositMethod:
 		return 28_799
 	case WithdrawMethod:
 		return 3_000_000
@@ -86,21 +127,44 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 
 // Run executes the precompiled contract WERC20 methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, stateDB, method, initialGas, args, err := p.Precompile.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	// NOTE: the receive and fallback functions call the deposit method, so their
+	// input is replaced to run it
+	input := contract.Input
+	if p.isReceiveOrFallback(input) {
+		input = p.Methods[DepositMethod].ID
+	}
+
+	ctx, stateDB, method, initialGas, args, err := p.Precompile.RunSetupWithInput(evm, contract, input, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
+	// only the deposit method wraps the value sent with the call, which would
+	// otherwise be held by the precompile without minting the wrapped coin
+	if method.Name != DepositMethod && contract.Value().Sign() > 0 {
+		return nil, fmt.Errorf(cmn.ErrNonPayable, method.Name)
+	}
+
 	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
 	switch method.Name {
 	// WERC20 transactions
-	case cmn.FallbackMethod, cmn.ReceiveMethod, DepositMethod:
+	case DepositMethod:
 		bz, err = p.Deposit(ctx, contract, stateDB, method, args)
 	case WithdrawMethod:
 		bz, err = p.Withdraw(ctx, contract, stateDB, method, args)
+	// ERC20 metadata queries of the native coin
+	case erc20.NameMethod, erc20.SymbolMethod, erc20.DecimalsMethod:
+		bz, err = p.native.HandleMethod(ctx, contract, stateDB, method, args)
+	// EIP-2612 permits of the wrapped coin
+	case erc20.PermitMethod, erc20.NoncesMethod, erc20.DomainSeparatorMethod:
+		bz, err = p.Precompile.HandleMethod(ctx, contract, stateDB, method, args)
 
 	default:
 		// ERC20 transactions and queries
@@ -132,3 +196,14 @@ func (p Precompile) IsTransaction(methodID string) bool {
 		return p.Precompile.IsTransaction(methodID)
 	}
 }
+
+// isReceiveOrFallback checks if the given input calls the receive or fallback
+// functions, i.e. if it has no method ID or an unknown one.
+func (p Precompile) isReceiveOrFallback(input []byte) bool {
+	if len(input) < 4 {
+		return true
+	}
+
+	_, err := p.MethodById(input[:4])
+	return err != nil
+}
@@ -149,21 +149,31 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	req := &evmtypes.QueryBalanceRequest{
-		Address: address.String(),
-	}
-
 	_, err = b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
-	if err != nil {
-		return nil, err
+	var balance string
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		res, err := b.pendingAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		balance = res.Balance
+	} else {
+		req := &evmtypes.QueryBalanceRequest{
+			Address: address.String(),
+		}
+
+		res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+		if err != nil {
+			return nil, err
+		}
+		balance = res.Balance
 	}
 
-	val, ok := sdkmath.NewIntFromString(res.Balance)
+	val, ok := sdkmath.NewIntFromString(balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
 	}
@@ -203,8 +213,16 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 		return &n, nil
 	}
 
-	includePending := blockNum == rpctypes.EthPendingBlockNumber
-	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.logger)
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		res, err := b.pendingAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		n = hexutil.Uint64(res.Nonce)
+		return &n, nil
+	}
+
+	nonce, err := b.getAccountNonce(address, false, blockNum.Int64(), b.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -212,3 +230,20 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	n = hexutil.Uint64(nonce)
 	return &n, nil
 }
+
+// pendingAccount returns the EVM account of the given address at the pending
+// block, i.e. after replaying the pending transactions of the mempool on top of
+// the latest state.
+func (b *Backend) pendingAccount(address common.Address) (*evmtypes.QueryAccountResponse, error) {
+	pendingTxs, err := b.pendingEthTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryAccountRequest{
+		Address:    address.String(),
+		PendingTxs: pendingTxs,
+	}
+
+	return b.queryClient.Account(b.ctx, req)
+}
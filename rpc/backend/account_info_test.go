@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -247,6 +248,10 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	pendingNr := rpctypes.EthPendingBlockNumber
+	msgEthTx, bz := suite.buildEthereumTx()
+	ethTxBz, err := msgEthTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name          string
@@ -332,6 +337,23 @@ func (suite *BackendTestSuite) TestGetBalance() {
 			true,
 			(*hexutil.Big)(big.NewInt(1)),
 		},
+		{
+			"pass - pending block replays the pending transactions",
+			utiltx.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterPendingAccount(queryClient, addr, [][]byte{ethTxBz}, "2", 1)
+			},
+			true,
+			(*hexutil.Big)(big.NewInt(2)),
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -354,6 +376,10 @@ func (suite *BackendTestSuite) TestGetBalance() {
 }
 
 func (suite *BackendTestSuite) TestGetTransactionCount() {
+	msgEthTx, bz := suite.buildEthereumTx()
+	ethTxBz, err := msgEthTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
 		accExists    bool
@@ -386,6 +412,36 @@ func (suite *BackendTestSuite) TestGetTransactionCount() {
 			false,
 			hexutil.Uint64(0),
 		},
+		{
+			"fail - query client failed to get pending account",
+			true,
+			rpctypes.EthPendingBlockNumber,
+			func(addr common.Address, bn rpctypes.BlockNumber) {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterPendingAccountError(queryClient, addr, nil)
+			},
+			false,
+			hexutil.Uint64(0),
+		},
+		{
+			"pass - pending block replays the pending transactions",
+			true,
+			rpctypes.EthPendingBlockNumber,
+			func(addr common.Address, bn rpctypes.BlockNumber) {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterPendingAccount(queryClient, addr, [][]byte{ethTxBz}, "0", 1)
+			},
+			true,
+			hexutil.Uint64(1),
+		},
 		// TODO: Error mocking the GetAccount call - problem with Any type
 		// {
 		//	"pass - returns the number of transactions at the given address up to the given block number",
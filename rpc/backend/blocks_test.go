@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"google.golang.org/grpc/metadata"
 
@@ -1524,6 +1525,13 @@ func (suite *BackendTestSuite) TestEthBlockByNumber() {
 					suite.Require().Equal(tx.Data(), ethBlock.Transactions()[i].Data())
 				}
 
+				// the RLP encoding served by debug_getRawBlock round-trips
+				bz, err := rlp.EncodeToBytes(ethBlock)
+				suite.Require().NoError(err)
+				var decoded ethtypes.Block
+				suite.Require().NoError(rlp.DecodeBytes(bz, &decoded))
+				suite.Require().Equal(ethBlock.Hash(), decoded.Hash())
+				suite.Require().Equal(ethBlock.Transactions().Len(), decoded.Transactions().Len())
 			} else {
 				suite.Require().Error(err)
 			}
@@ -327,10 +327,62 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
-// estimated gas used on the operation or an error if fails.
+// estimated gas used on the operation or an error if fails. The optional state
+// and block overrides are applied before the execution of the call.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
+	req, err := b.newEthCallRequest(args, blockNr, overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	// Make sure the context is canceled when the call has completed
+	// this makes sure resources are cleaned up.
+	defer cancel()
+
+	res, err := b.queryClient.EthCall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		if res.VmError != vm.ErrExecutionReverted.Error() {
+			return nil, status.Error(codes.Internal, res.VmError)
+		}
+		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
+	}
+
+	return res, nil
+}
+
+// newEthCallRequest returns the EthCallRequest for the given call arguments at
+// the given block, including the JSON encoded state and block overrides. At the
+// pending block, the request also includes the pending transactions to replay.
+func (b *Backend) newEthCallRequest(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (*evmtypes.EthCallRequest, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
@@ -341,45 +393,141 @@ func (b *Backend) DoCall(
 		return nil, errors.New("header not found")
 	}
 
-	req := evmtypes.EthCallRequest{
+	req := &evmtypes.EthCallRequest{
 		Args:            bz,
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
 
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		if req.PendingTxs, err = b.pendingEthTxs(); err != nil {
+			return nil, err
+		}
+	}
+
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+// SimulateV1 executes the calls of the given blocks in order on top of the state
+// of the given block, each call observing the changes made by the previous ones.
+// Unlike DoCall, failed calls don't return an error but are reported in their
+// result.
+func (b *Backend) SimulateV1(
+	opts rpctypes.SimulateOptions,
+	blockNr rpctypes.BlockNumber,
+) ([]rpctypes.SimulateBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := &evmtypes.EthSimulateRequest{
+		Blocks:          make([]evmtypes.EthSimulateBlock, len(opts.BlockStateCalls)),
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	for i, block := range opts.BlockStateCalls {
+		for _, args := range block.Calls {
+			bz, err := json.Marshal(&args)
+			if err != nil {
+				return nil, err
+			}
+			req.Blocks[i].Calls = append(req.Blocks[i].Calls, bz)
+		}
+		if block.StateOverrides != nil {
+			if req.Blocks[i].Overrides, err = json.Marshal(block.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+		if block.BlockOverrides != nil {
+			if req.Blocks[i].BlockOverrides, err = json.Marshal(block.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
 	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
 	timeout := b.RPCEVMTimeout()
 
-	// Setup context so it may be canceled the call has completed
-	// or, in case of unmetered gas, setup a context with a timeout.
 	var cancel context.CancelFunc
 	if timeout > 0 {
 		ctx, cancel = context.WithTimeout(ctx, timeout)
 	} else {
 		ctx, cancel = context.WithCancel(ctx)
 	}
-
-	// Make sure the context is canceled when the call has completed
-	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.queryClient.EthCall(ctx, &req)
+	res, err := b.queryClient.EthSimulate(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	results := make([]rpctypes.SimulateBlockResult, len(res.Blocks))
+	for i, block := range res.Blocks {
+		results[i].Calls = make([]rpctypes.SimulateCallResult, len(block.Calls))
+		for j, call := range block.Calls {
+			results[i].Calls[j] = newSimulateCallResult(call)
+		}
+	}
+
+	return results, nil
+}
+
+// simulateVMErrorCode is the JSON-RPC error code of the simulated calls that
+// failed with an EVM error other than a revert.
+const simulateVMErrorCode = -32015
+
+// newSimulateCallResult returns the eth_simulateV1 representation of the result
+// of a simulated call.
+func newSimulateCallResult(res *evmtypes.MsgEthereumTxResponse) rpctypes.SimulateCallResult {
+	result := rpctypes.SimulateCallResult{
+		ReturnData: res.Ret,
+		// the logs are always returned as an array, even if empty
+		Logs:    append([]*ethtypes.Log{}, evmtypes.LogsToEthereum(res.Logs)...),
+		GasUsed: hexutil.Uint64(res.GasUsed),
+		Status:  hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
 	if res.Failed() {
-		if res.VmError != vm.ErrExecutionReverted.Error() {
-			return nil, status.Error(codes.Internal, res.VmError)
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimulateCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &rpctypes.SimulateCallError{
+				Code:    simulateVMErrorCode,
+				Message: res.VmError,
+			}
 		}
-		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
 	}
 
-	return res, nil
+	return result
 }
 
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/evmos/v15/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v15/rpc/types"
@@ -386,7 +388,9 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 }
 
 func (suite *BackendTestSuite) TestDoCall() {
-	_, bz := suite.buildEthereumTx()
+	msgEthTx, bz := suite.buildEthereumTx()
+	ethTxBz, err := msgEthTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
 	toAddr := utiltx.GenerateAddress()
 	chainID := (*hexutil.Big)(suite.backend.chainID)
@@ -442,6 +446,65 @@ func (suite *BackendTestSuite) TestDoCall() {
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
+		{
+			"pass - pending block replays the pending transactions",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterEthCallPending(queryClient, &evmtypes.EthCallRequest{
+					Args:       argsBz,
+					ChainId:    suite.backend.chainID.Int64(),
+					PendingTxs: [][]byte{ethTxBz},
+				})
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - pending transactions exceeding the RPC gas cap are dropped",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.cfg.JSONRPC.GasCap = 1
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterEthCallPending(queryClient, &evmtypes.EthCallRequest{
+					Args:    argsBz,
+					GasCap:  1,
+					ChainId: suite.backend.chainID.Int64(),
+				})
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"fail - pending transactions can't be fetched",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			rpctypes.EthPendingBlockNumber,
+			callArgs,
+			nil,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -449,7 +512,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -460,6 +523,117 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	from := utiltx.GenerateAddress()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{From: &from, To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	opts := rpctypes.SimulateOptions{
+		BlockStateCalls: []rpctypes.SimulateBlock{{Calls: []evmtypes.TransactionArgs{callArgs, callArgs}}},
+	}
+	request := &evmtypes.EthSimulateRequest{
+		Blocks:  []evmtypes.EthSimulateBlock{{Calls: [][]byte{argsBz, argsBz}}},
+		ChainId: suite.backend.chainID.Int64(),
+	}
+
+	ret := common.BigToHash(big.NewInt(1)).Bytes()
+	log := &evmtypes.Log{Address: toAddr.Hex(), Topics: []string{common.BigToHash(big.NewInt(2)).Hex()}, Data: []byte{}}
+	// Error(string) with "fail" as reason
+	revertData := common.FromHex("0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000004" +
+		"6661696c00000000000000000000000000000000000000000000000000000000")
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		opts         rpctypes.SimulateOptions
+		expResults   []rpctypes.SimulateBlockResult
+		expPass      bool
+	}{
+		{
+			"fail - empty input",
+			func() {},
+			rpctypes.SimulateOptions{},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthSimulateError(queryClient, request)
+			},
+			opts,
+			nil,
+			false,
+		},
+		{
+			"pass - successful and reverted calls",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthSimulate(queryClient, request, &evmtypes.EthSimulateResponse{
+					Blocks: []evmtypes.EthSimulateBlockResult{
+						{
+							Calls: []*evmtypes.MsgEthereumTxResponse{
+								{Ret: ret, Logs: []*evmtypes.Log{log}, GasUsed: 30000},
+								{Ret: revertData, VmError: vm.ErrExecutionReverted.Error(), GasUsed: 25000},
+							},
+						},
+					},
+				})
+			},
+			opts,
+			[]rpctypes.SimulateBlockResult{
+				{
+					Calls: []rpctypes.SimulateCallResult{
+						{
+							ReturnData: ret,
+							Logs:       []*ethtypes.Log{log.ToEthereum()},
+							GasUsed:    30000,
+							Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+						},
+						{
+							ReturnData: revertData,
+							Logs:       []*ethtypes.Log{},
+							GasUsed:    25000,
+							Status:     hexutil.Uint64(ethtypes.ReceiptStatusFailed),
+							Error: &rpctypes.SimulateCallError{
+								Code:    3,
+								Message: "execution reverted: fail",
+								Data:    hexutil.Encode(revertData),
+							},
+						},
+					},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := suite.backend.SimulateV1(tc.opts, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
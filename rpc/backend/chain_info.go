@@ -123,6 +123,47 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
+// pendingEthTxs returns the binary encoding of the ethereum transactions in the
+// mempool, in mempool order. They are replayed on top of the latest state to
+// serve the queries at the pending block, so only the transactions that fit
+// within the RPC gas cap are kept and the remaining ones are dropped.
+func (b *Backend) pendingEthTxs() ([][]byte, error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		txs      [][]byte
+		totalGas uint64
+	)
+	gasCap := b.RPCGasCap()
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			// NOTE: a gas cap of 0 means that the gas is not capped
+			if gasCap != 0 && totalGas+ethMsg.GetGas() > gasCap {
+				return txs, nil
+			}
+
+			bz, err := ethMsg.AsTransaction().MarshalBinary()
+			if err != nil {
+				b.logger.Debug("failed to encode pending transaction", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			totalGas += ethMsg.GetGas()
+			txs = append(txs, bz)
+		}
+	}
+
+	return txs, nil
+}
+
 // GetCoinbase is the address that staking rewards will be send to (alias for Etherbase).
 func (b *Backend) GetCoinbase() (sdk.AccAddress, error) {
 	node, err := b.clientCtx.GetNode()
@@ -190,6 +231,16 @@ func (b *Backend) FeeHistory(
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
 
+	// read the fee history from the feemarket module state when no rewards are
+	// requested, as it doesn't require to fetch the block results
+	if !calculateRewards && b.feeHistoryFromState(blockStart, blockEnd, thisBaseFee, thisGasUsedRatio) {
+		return &rpctypes.FeeHistoryResult{
+			OldestBlock:  oldestBlock,
+			BaseFee:      thisBaseFee,
+			GasUsedRatio: thisGasUsedRatio,
+		}, nil
+	}
+
 	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G701
@@ -189,6 +189,92 @@ func (suite *BackendTestSuite) TestChainId() {
 	}
 }
 
+func (suite *BackendTestSuite) TestFeeHistoryFromState() {
+	// mocks required to fetch the current header
+	registerHeaderMocks := func() {
+		var header metadata.MD
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+		suite.Require().NoError(err)
+		_, err = RegisterBlockResults(client, 1)
+		suite.Require().NoError(err)
+		RegisterBaseFee(queryClient, sdk.NewInt(1))
+		RegisterParams(queryClient, &header, 1)
+		RegisterParamsWithoutHeader(queryClient, 1)
+	}
+
+	testCases := []struct {
+		name          string
+		registerMock  func(validator sdk.AccAddress)
+		expFeeHistory *rpc.FeeHistoryResult
+	}{
+		{
+			"pass - fee history read from the feemarket state",
+			func(validator sdk.AccAddress) {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketFeeHistory(feeMarketClient, 2, 2, []feemarkettypes.BlockFeeInfo{
+					{Height: 1, BaseFee: sdk.NewInt(5), GasWanted: 60, GasUsed: 50, GasLimit: 100},
+					{Height: 2, BaseFee: sdk.NewInt(7), GasWanted: 0, GasUsed: 0, GasLimit: 100},
+				})
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(5)), (*hexutil.Big)(big.NewInt(7))},
+				GasUsedRatio: []float64{0.5},
+			},
+		},
+		{
+			"pass - fallback to the block results when the next block is not on the feemarket state",
+			func(validator sdk.AccAddress) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				registerHeaderMocks()
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterFeeMarketFeeHistory(feeMarketClient, 2, 2, []feemarkettypes.BlockFeeInfo{
+					{Height: 1, BaseFee: sdk.NewInt(5), GasWanted: 60, GasUsed: 50, GasLimit: 100},
+				})
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0},
+			},
+		},
+		{
+			"pass - fallback to the block results when the fee history query fails",
+			func(validator sdk.AccAddress) {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				registerHeaderMocks()
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterFeeMarketFeeHistoryError(feeMarketClient)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+			tc.registerMock(sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+
+			feeHistory, err := suite.backend.FeeHistory(1, 1, nil)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFeeHistory, feeHistory)
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetCoinbase() {
 	validatorAcc := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
 	testCases := []struct {
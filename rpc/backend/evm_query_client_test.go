@@ -64,6 +64,17 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -151,6 +162,24 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// RegisterEthCallPending registers an EthCall at the pending block, which is
+// queried without height.
+func RegisterEthCallPending(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("EthCall", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
+}
+
+// EthSimulate
+func RegisterEthSimulate(queryClient *mocks.EVMQueryClient, request *evmtypes.EthSimulateRequest, response *evmtypes.EthSimulateResponse) {
+	queryClient.On("EthSimulate", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(response, nil)
+}
+
+func RegisterEthSimulateError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthSimulateRequest) {
+	queryClient.On("EthSimulate", mock.AnythingOfType("*context.cancelCtx"), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -255,6 +284,22 @@ func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		)
 }
 
+func RegisterPendingAccount(queryClient *mocks.EVMQueryClient, addr common.Address, pendingTxs [][]byte, balance string, nonce uint64) {
+	queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: addr.String(), PendingTxs: pendingTxs}).
+		Return(&evmtypes.QueryAccountResponse{
+			Balance:  balance,
+			CodeHash: "",
+			Nonce:    nonce,
+		},
+			nil,
+		)
+}
+
+func RegisterPendingAccountError(queryClient *mocks.EVMQueryClient, addr common.Address, pendingTxs [][]byte) {
+	queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: addr.String(), PendingTxs: pendingTxs}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Balance
 func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
@@ -2,6 +2,8 @@ package backend
 
 import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/mock"
+
 	"github.com/evmos/evmos/v15/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v15/rpc/types"
 	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
@@ -19,3 +21,15 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// FeeHistory
+func RegisterFeeMarketFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, lastBlock int64, blockCount uint64, blocks []feemarkettypes.BlockFeeInfo) {
+	req := &feemarkettypes.QueryFeeHistoryRequest{LastBlock: lastBlock, BlockCount: blockCount}
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(1), req).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{Blocks: blocks}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(1), mock.AnythingOfType("*types.QueryFeeHistoryRequest")).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
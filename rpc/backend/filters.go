@@ -5,6 +5,7 @@ package backend
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/evmos/evmos/v15/types"
 	"github.com/pkg/errors"
 )
 
@@ -31,6 +32,37 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns the logs of the [from, to] block range that match the
+// given addresses and topics from the log index of the custom indexer. It returns
+// false if the log index is disabled or any block of the range is missing from it.
+func (b *Backend) GetIndexedLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, bool, error) {
+	logIndexer, ok := b.indexer.(evmostypes.EVMLogIndexer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	// the blocks can be missing from the middle of the index, e.g. when the
+	// indexer was disabled for a while, so every block of the range is checked
+	indexed, err := logIndexer.IsLogIndexedRange(from, to)
+	if err != nil {
+		return nil, false, err
+	}
+	if !indexed {
+		return nil, false, nil
+	}
+
+	logs, err := logIndexer.GetLogs(from, to, addresses, topics, limit)
+	if err != nil {
+		return nil, true, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
-	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
@@ -259,14 +257,8 @@ func (suite *BackendTestSuite) TestSetEtherbase() {
 				delAddr, _ := suite.backend.GetCoinbase()
 				// account, _ := suite.backend.clientCtx.AccountRetriever.GetAccount(suite.backend.clientCtx, delAddr)
 				delCommonAddr := common.BytesToAddress(delAddr.Bytes())
-				request := &authtypes.QueryAccountRequest{Address: sdk.AccAddress(delCommonAddr.Bytes()).String()}
-				requestMarshal, _ := request.Marshal()
-				RegisterABCIQueryWithOptionsError(
-					client,
-					"/cosmos.auth.v1beta1.Query/Account",
-					requestMarshal,
-					tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
-				)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterPendingAccountError(queryClient, delCommonAddr, nil)
 			},
 			common.Address{},
 			false,
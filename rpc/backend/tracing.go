@@ -209,3 +209,46 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall executes the given call on top of the state of the given block, with
+// the optional state and block overrides applied, and returns the tracer dependent
+// result as a JSON object.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	var (
+		traceConfig    *evmtypes.TraceConfig
+		overrides      *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+	)
+	if config != nil {
+		traceConfig = &config.TraceConfig
+		overrides = config.StateOverrides
+		blockOverrides = config.BlockOverrides
+	}
+
+	req, err := b.newEthCallRequest(args, blockNr, overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Call:        req,
+		TraceConfig: traceConfig,
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
@@ -160,106 +160,324 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
-		return nil, err
-	}
-
-	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
 		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
+
+	cumulativeGasUsed := uint64(0)
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
 	}
-	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hexTx {
+				res.EthTxIndex = int32(i) // #nosec G701
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+		baseFee = b.receiptBaseFee(blockRes)
+	}
+
+	return b.formatTxReceipt(ethMsg, res, resBlock, blockRes, cumulativeGasUsed, chainID.ToInt(), baseFee)
+}
+
+// GetBlockReceipts returns the receipts of all the Ethereum transactions included
+// in the given block. The block and its results are only queried once, and the
+// transaction positions and cumulative gas are read from the tx indexer.
+func (b *Backend) GetBlockReceipts(blockNum rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64())
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
 	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]map[string]interface{}, 0, len(msgs))
+	if len(msgs) == 0 {
+		return receipts, nil
+	}
+
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
 	}
+	baseFee := b.receiptBaseFee(blockRes)
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
+	err = b.iterateBlockTxResults(msgs, blockRes, func(ethMsg *evmtypes.MsgEthereumTx, res *types.TxResult, cumulativeGasUsed uint64) error {
+		receipt, err := b.formatTxReceipt(ethMsg, res, resBlock, blockRes, cumulativeGasUsed, chainID.ToInt(), baseFee)
+		if err != nil {
+			return err
+		}
+		receipts = append(receipts, receipt)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// parse tx logs from events
-	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	return receipts, nil
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of all the
+// Ethereum transactions included in the given block, in the order they are
+// committed to the receipts root.
+func (b *Backend) GetRawReceipts(blockNum rpctypes.BlockNumber) ([]hexutil.Bytes, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum.Int64())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
+		return nil, fmt.Errorf("failed to retrieve block results of block %d: %w", resBlock.Block.Height, err)
 	}
 
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				res.EthTxIndex = int32(i) // #nosec G701
-				break
-			}
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]hexutil.Bytes, 0, len(msgs))
+	if len(msgs) == 0 {
+		return receipts, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.iterateBlockTxResults(msgs, blockRes, func(ethMsg *evmtypes.MsgEthereumTx, res *types.TxResult, cumulativeGasUsed uint64) error {
+		receipt, _, err := b.newTxReceipt(ethMsg, res, resBlock, blockRes, cumulativeGasUsed, chainID.ToInt())
+		if err != nil {
+			return err
+		}
+		bz, err := receipt.MarshalBinary()
+		if err != nil {
+			return err
 		}
+		receipts = append(receipts, bz)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
+
+	return receipts, nil
+}
+
+// iterateBlockTxResults calls the given function with the tx result of each of
+// the Ethereum transactions of a block, in order, and the gas used by the Cosmos
+// transactions that precede it in the block.
+func (b *Backend) iterateBlockTxResults(
+	msgs []*evmtypes.MsgEthereumTx,
+	blockRes *tmrpctypes.ResultBlockResults,
+	cb func(ethMsg *evmtypes.MsgEthereumTx, res *types.TxResult, cumulativeGasUsed uint64) error,
+) error {
+	// gas used by all the preceding Cosmos transactions of the block, indexed
+	// by the position of the Cosmos transaction
+	blockGasUsed := make([]uint64, len(blockRes.TxsResults)+1)
+	for i, txResult := range blockRes.TxsResults {
+		blockGasUsed[i+1] = blockGasUsed[i] + uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+	}
+
+	for i, ethMsg := range msgs {
+		res, err := b.GetTxByEthHash(common.HexToHash(ethMsg.Hash))
+		if err != nil {
+			b.logger.Debug("tx not found", "hash", ethMsg.Hash, "error", err.Error())
+			return fmt.Errorf("failed to get tx result of %s: %w", ethMsg.Hash, err)
+		}
+		if res.TxIndex >= uint32(len(blockRes.TxsResults)) {
+			return fmt.Errorf("tx index %d of %s out of bounds", res.TxIndex, ethMsg.Hash)
+		}
+		// the msgs are sorted by their position in the block
+		res.EthTxIndex = int32(i) // #nosec G701
+
+		if err := cb(ethMsg, res, blockGasUsed[res.TxIndex]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// receiptBaseFee returns the base fee of the block to compute the effective gas
+// price of the receipts, or nil if it can't be retrieved.
+func (b *Backend) receiptBaseFee(blockRes *tmrpctypes.ResultBlockResults) *big.Int {
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", blockRes.Height, "error", err)
+		return nil
+	}
+	return baseFee
+}
+
+// formatTxReceipt returns the JSON-RPC representation of the receipt of the
+// given Ethereum transaction. The cumulativeGasUsed argument is the gas used by
+// the Cosmos transactions that precede it in the block.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	cumulativeGasUsed uint64,
+	chainID *big.Int,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	receipt, from, err := b.newTxReceipt(ethMsg, res, resBlock, blockRes, cumulativeGasUsed, chainID)
+	if err != nil {
+		return nil, err
 	}
 
-	receipt := map[string]interface{}{
+	tx := ethMsg.AsTransaction()
+	result := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
-		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
-		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
-		"logs":              logs,
+		"status":            hexutil.Uint(receipt.Status),
+		"cumulativeGasUsed": hexutil.Uint64(receipt.CumulativeGasUsed),
+		"logsBloom":         receipt.Bloom,
+		"logs":              receipt.Logs,
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": receipt.TxHash,
 		"contractAddress": nil,
-		"gasUsed":         hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
+		"gasUsed":         hexutil.Uint64(receipt.GasUsed),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
-		"blockNumber":      hexutil.Uint64(res.Height),
-		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
+		"blockHash":        receipt.BlockHash.Hex(),
+		"blockNumber":      hexutil.Uint64(receipt.BlockNumber.Uint64()),
+		"transactionIndex": hexutil.Uint64(receipt.TransactionIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
-		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"to":   tx.To(),
+		"type": hexutil.Uint(receipt.Type),
 	}
 
-	if logs == nil {
-		receipt["logs"] = [][]*ethtypes.Log{}
+	if receipt.Logs == nil {
+		result["logs"] = [][]*ethtypes.Log{}
 	}
 
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
+	if tx.To() == nil {
+		result["contractAddress"] = receipt.ContractAddress
+	}
+
+	if tx.Type() == ethtypes.DynamicFeeTxType && baseFee != nil {
+		result["effectiveGasPrice"] = hexutil.Big(*evmtypes.EffectiveGasPrice(baseFee, tx.GasFeeCap(), tx.GasTipCap()))
+	}
+
+	return result, nil
+}
+
+// newTxReceipt returns the receipt of the given Ethereum transaction along with
+// its sender. The cumulativeGasUsed argument is the gas used by the Cosmos
+// transactions that precede it in the block.
+func (b *Backend) newTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	cumulativeGasUsed uint64,
+	chainID *big.Int,
+) (*ethtypes.Receipt, common.Address, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, common.Address{}, err
+	}
+
+	from, err := ethMsg.GetSender(chainID)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	// parse tx logs from events
+	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
+	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	if err != nil {
+		b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+	}
+
+	receipt := &ethtypes.Receipt{
+		Type:              ethMsg.AsTransaction().Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: cumulativeGasUsed + res.CumulativeGasUsed,
+		Bloom:             ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:              logs,
+		TxHash:            common.HexToHash(ethMsg.Hash),
+		GasUsed:           b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas()),
+		BlockHash:         common.BytesToHash(resBlock.Block.Header.Hash()),
+		BlockNumber:       big.NewInt(res.Height),
+		TransactionIndex:  uint(res.EthTxIndex), // #nosec G701 -- checked for int overflow already
+	}
+	if res.Failed {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+	}
 	if txData.GetTo() == nil {
-		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
+		receipt.ContractAddress = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	return receipt, from, nil
+}
+
+// GetRawTransaction returns the consensus encoding of the Ethereum transaction
+// identified by hash, or nil if it is not found.
+func (b *Backend) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", res.Height)
+		return nil, nil
+	}
+	if res.TxIndex >= uint32(len(resBlock.Block.Txs)) {
+		return nil, fmt.Errorf("tx index %d of %s out of bounds", res.TxIndex, hash.Hex())
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+	msgs := tx.GetMsgs()
+	if int(res.MsgIndex) >= len(msgs) {
+		return nil, fmt.Errorf("msg index %d of %s out of bounds", res.MsgIndex, hash.Hex())
+	}
+	ethMsg, ok := msgs[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid ethereum tx")
 	}
 
-	return receipt, nil
+	return ethMsg.AsTransaction().MarshalBinary()
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/evmos/evmos/v15/indexer"
 	"github.com/evmos/evmos/v15/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v15/rpc/types"
@@ -613,6 +615,108 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		block        *types.Block
+		blockResult  []*abci.ResponseDeliverTx
+		expReceipts  int
+		expNil       bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			0,
+			true,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			0,
+			false,
+		},
+		{
+			"pass - receipts of the block transactions",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			1,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.blockResult)
+			suite.Require().NoError(err)
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumber(1))
+			suite.Require().NoError(err)
+			if tc.expNil {
+				suite.Require().Nil(receipts)
+				return
+			}
+
+			suite.Require().Len(receipts, tc.expReceipts)
+			for i, receipt := range receipts {
+				suite.Require().Equal(txHash, receipt["transactionHash"])
+				suite.Require().Equal(hexutil.Uint64(i), receipt["transactionIndex"])
+				suite.Require().Equal(hexutil.Uint64(21000), receipt["cumulativeGasUsed"])
+				suite.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -668,3 +772,201 @@ func (suite *BackendTestSuite) TestGetGasUsed() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		block        *types.Block
+		blockResult  []*abci.ResponseDeliverTx
+		expReceipts  int
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			0,
+			false,
+		},
+		{
+			"fail - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			0,
+			false,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			0,
+			true,
+		},
+		{
+			"pass - receipts of the block transactions",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+						}},
+					},
+				},
+			},
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.blockResult)
+			suite.Require().NoError(err)
+
+			rawReceipts, err := suite.backend.GetRawReceipts(rpctypes.BlockNumber(1))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(rawReceipts, tc.expReceipts)
+
+			receipts := make(ethtypes.Receipts, len(rawReceipts))
+			for i, bz := range rawReceipts {
+				receipt := new(ethtypes.Receipt)
+				suite.Require().NoError(receipt.UnmarshalBinary(bz))
+				suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+				suite.Require().Equal(uint64(21000), receipt.CumulativeGasUsed)
+				suite.Require().Equal(msgEthereumTx.AsTransaction().Type(), receipt.Type)
+
+				// the consensus encoding round-trips
+				reencoded, err := receipt.MarshalBinary()
+				suite.Require().NoError(err)
+				suite.Require().Equal([]byte(bz), reencoded)
+				receipts[i] = receipt
+			}
+
+			// the receipts root can be recomputed from the raw receipts
+			suite.Require().NotEqual(common.Hash{}, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawTransaction() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := common.HexToHash(msgEthereumTx.Hash)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		block        *types.Block
+		blockResult  []*abci.ResponseDeliverTx
+		expNil       bool
+	}{
+		{
+			"pass - transaction not found",
+			func() {},
+			&types.Block{Header: types.Header{Height: 1}},
+			nil,
+			true,
+		},
+		{
+			"pass - transaction found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+			},
+			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events: []abci.Event{
+						{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "txGasUsed", Value: "21000"},
+						}},
+					},
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(tc.block, tc.blockResult)
+			suite.Require().NoError(err)
+
+			rawTx, err := suite.backend.GetRawTransaction(txHash)
+			suite.Require().NoError(err)
+			if tc.expNil {
+				suite.Require().Nil(rawTx)
+				return
+			}
+
+			// the consensus encoding round-trips
+			tx := new(ethtypes.Transaction)
+			suite.Require().NoError(tx.UnmarshalBinary(rawTx))
+			suite.Require().Equal(txHash, tx.Hash())
+			reencoded, err := tx.MarshalBinary()
+			suite.Require().NoError(err)
+			suite.Require().Equal([]byte(rawTx), reencoded)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions contained in the node's mempool,
+// grouped by sender address and nonce. Transactions that can be executed on top of
+// the committed account nonce are returned as pending, while the ones that are
+// waiting on a nonce gap to be filled are returned as queued.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bySender := make(map[common.Address][]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			// use zero block values since it's not included in a block yet
+			rpctx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			bySender[sender] = append(bySender[sender], rpctx)
+		}
+	}
+
+	for sender, senderTxs := range bySender {
+		nonce, err := b.getAccountNonce(sender, false, 0, b.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sort.SliceStable(senderTxs, func(i, j int) bool {
+			return senderTxs[i].Nonce < senderTxs[j].Nonce
+		})
+
+		for _, rpctx := range senderTxs {
+			txNonce := uint64(rpctx.Nonce)
+			switch {
+			case txNonce < nonce:
+				// stale tx that is waiting to be evicted on recheck, or a
+				// duplicate of a nonce that is already pending
+				continue
+			case txNonce == nonce:
+				// the tx is executable, so the next nonce is expected after it
+				addPoolTx(pending, sender, rpctx)
+				nonce++
+			default:
+				if _, found := queued[sender][txNonce]; found {
+					continue
+				}
+				addPoolTx(queued, sender, rpctx)
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// addPoolTx adds the given transaction to the sender's nonce mapping of a tx pool set.
+func addPoolTx(
+	set map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	sender common.Address,
+	tx *rpctypes.RPCTransaction,
+) {
+	if set[sender] == nil {
+		set[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+	}
+	set[sender][uint64(tx.Nonce)] = tx
+}
@@ -0,0 +1,136 @@
+package backend
+
+import (
+	"math/big"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v15/app"
+	"github.com/evmos/evmos/v15/encoding"
+	"github.com/evmos/evmos/v15/rpc/backend/mocks"
+	"github.com/evmos/evmos/v15/utils"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name         string
+		nonces       []uint64
+		registerMock func(txs types.Txs)
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions returns error",
+			nil,
+			func(_ types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			nil,
+			func(txs types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, txs)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - txs are grouped into pending and queued",
+			[]uint64{4, 2, 0, 1},
+			func(txs types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, txs)
+				suite.registerAccountSequence(client, suite.from, 1)
+			},
+			[]uint64{1, 2},
+			[]uint64{4},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			txs := make(types.Txs, 0, len(tc.nonces))
+			for _, nonce := range tc.nonces {
+				txs = append(txs, suite.signAndEncodeEthTxWithNonce(nonce))
+			}
+			tc.registerMock(txs)
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(pending[suite.from], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Contains(pending[suite.from], nonce)
+			}
+			suite.Require().Len(queued[suite.from], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Contains(queued[suite.from], nonce)
+			}
+		})
+	}
+}
+
+// signAndEncodeEthTxWithNonce returns an encoded legacy Ethereum transaction
+// with the given nonce, signed by the suite sender.
+func (suite *BackendTestSuite) signAndEncodeEthTxWithNonce(nonce uint64) []byte {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	msgEthereumTx.From = suite.from.String()
+	err := msgEthereumTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	return txBz
+}
+
+// registerAccountSequence mocks the auth account query for the given address.
+func (suite *BackendTestSuite) registerAccountSequence(client *mocks.Client, address common.Address, seq uint64) {
+	accAddr := sdk.AccAddress(address.Bytes())
+	request := &authtypes.QueryAccountRequest{Address: accAddr.String()}
+	requestBz, err := request.Marshal()
+	suite.Require().NoError(err)
+
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	suite.backend.clientCtx = suite.backend.clientCtx.WithInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	RegisterABCIQueryAccount(
+		client,
+		requestBz,
+		tmrpcclient.ABCIQueryOptions{Height: int64(1), Prove: false},
+		authtypes.NewBaseAccount(accAddr, nil, 1, seq),
+	)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/evmos/evmos/v15/rpc/types"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 )
 
 type txGasAndReward struct {
@@ -103,6 +104,56 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// feeHistoryFromState fills the base fees and gas used ratios of the blocks
+// between blockStart and blockEnd from the feemarket module fee history, along
+// with the base fee of the block following the range. It returns false if any
+// of these blocks isn't available on the module state, which is the case for
+// the block following the latest one, in which case the blocks have to be
+// processed one by one.
+// output: baseFees, gasUsedRatios
+func (b *Backend) feeHistoryFromState(
+	blockStart, blockEnd int64,
+	baseFees []*hexutil.Big,
+	gasUsedRatios []float64,
+) bool {
+	// NOTE: the block following the range is queried for its stored base fee
+	blockCount := uint64(blockEnd - blockStart + 2) // #nosec G701 -- blockEnd >= blockStart
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		LastBlock:  blockEnd + 1,
+		BlockCount: blockCount,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query the fee history", "error", err.Error())
+		return false
+	}
+
+	if uint64(len(res.Blocks)) != blockCount {
+		return false
+	}
+
+	for i, block := range res.Blocks {
+		if block.Height != blockStart+int64(i) {
+			return false
+		}
+	}
+
+	blocks, next := res.Blocks[:len(res.Blocks)-1], res.Blocks[len(res.Blocks)-1]
+	for _, block := range blocks {
+		// the gas used ratio is undefined for blocks with unlimited gas
+		if block.GasLimit == 0 {
+			return false
+		}
+	}
+
+	for i, block := range blocks {
+		baseFees[i] = (*hexutil.Big)(block.BaseFee.BigInt())
+		gasUsedRatios[i] = float64(block.GasUsed) / float64(block.GasLimit)
+	}
+	baseFees[len(blocks)] = (*hexutil.Big)(next.BaseFee.BigInt())
+
+	return true
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
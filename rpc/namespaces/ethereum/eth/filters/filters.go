@@ -146,6 +146,21 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
+	// the range queries covered by the log index are served without walking the
+	// blocks
+	if from, to := f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64(); from <= head {
+		if to > head {
+			to = head
+		}
+		logs, ok, err := f.backend.GetIndexedLogs(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return logs, nil
+		}
+	}
+
 	// check bounds
 	if f.criteria.FromBlock.Int64() > head {
 		return []*ethtypes.Log{}, nil
@@ -3,51 +3,148 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/evmos/v15/rpc/backend"
 	"github.com/evmos/evmos/v15/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The pool content is read from the CometBFT mempool of the node, so only the Ethereum transactions
+// that passed the CheckTx stage are returned.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
-		"pending": make(map[string]map[string]*types.RPCTransaction),
-		"queued":  make(map[string]map[string]*types.RPCTransaction),
+		"pending": make(map[string]map[string]*types.RPCTransaction, len(pending)),
+		"queued":  make(map[string]map[string]*types.RPCTransaction, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = flattenTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = flattenTxs(txs)
 	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": flattenTxs(pending[address]),
+		"queued":  flattenTxs(queued[address]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
-		"pending": make(map[string]map[string]string),
-		"queued":  make(map[string]map[string]string),
+		"pending": make(map[string]map[string]string, len(pending)),
+		"queued":  make(map[string]map[string]string, len(queued)),
+	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectTxs(txs)
 	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// flattenTxs converts the nonce mapping of an account into the string keyed
+// representation used by the txpool namespace.
+func flattenTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	dump := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		dump[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return dump
+}
+
+// inspectTxs returns a short summary of each of the transactions of an account
+// keyed by nonce.
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	dump := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		dump[fmt.Sprintf("%d", nonce)] = formatTx(tx)
+	}
+	return dump
+}
+
+// formatTx returns the textual summary of a transaction, following the format
+// used by go-ethereum for the txpool_inspect endpoint.
+func formatTx(tx *types.RPCTransaction) string {
+	gasPrice := tx.GasPrice
+	if tx.GasFeeCap != nil {
+		gasPrice = tx.GasFeeCap
+	}
+
+	if tx.To == nil {
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt())
+	}
+	return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt())
+}
+
+// countTxs returns the total amount of transactions of a tx pool set.
+func countTxs(set map[common.Address]map[uint64]*types.RPCTransaction) int {
+	count := 0
+	for _, txs := range set {
+		count += len(txs)
 	}
+	return count
 }
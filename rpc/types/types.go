@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -55,20 +57,56 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override while executing a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config for the debug_traceCall API. It extends the trace
+// configuration with the state and block overrides of the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// SimulateOptions is the input of the eth_simulateV1 API.
+type SimulateOptions struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+}
+
+// SimulateBlock is a batch of calls to simulate on top of the state left by the
+// previous blocks, with its own state and block overrides.
+type SimulateBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride             `json:"stateOverrides,omitempty"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// SimulateBlockResult is the result of a simulated block.
+type SimulateBlockResult struct {
+	Calls []SimulateCallResult `json:"calls"`
+}
+
+// SimulateCallResult is the result of a simulated call.
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethtypes.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateCallError is the error of a failed simulated call. Reverted calls use
+// the same code and data as eth_call.
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
 }
 
 type FeeHistoryResult struct {
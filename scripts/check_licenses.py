@@ -29,6 +29,7 @@ EXEMPT_FILES: List[str] = [
 # List of folders that should be ignored.
 IGNORED_FOLDERS: List[str] = [
     r"tests/solidity/node_modules",
+    r"third_party/go-ethereum",  # go-ethereum fork with its own LGPL3 license
 ]
 
 LGPL3_LICENSE = [
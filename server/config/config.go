@@ -17,6 +17,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/crypto-org-chain/cronos/memiavl"
 	memiavlcfg "github.com/crypto-org-chain/cronos/store/config"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -53,6 +54,15 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
+	// DefaultEVMTracerOutput is the default output of the EVM traces (standard output and error)
+	DefaultEVMTracerOutput = ""
+
+	// DefaultEVMTracerDir is the default directory of the EVM trace files, relative to the node home
+	DefaultEVMTracerDir = "data/traces"
+
+	// DefaultEVMTracerMaxFileSize is the default size in bytes after which the JSON lines trace file is rotated
+	DefaultEVMTracerMaxFileSize int64 = 100 << 20
+
 	// DefaultFixRevertGasRefundHeight is the default height at which to overwrite gas refund
 	DefaultFixRevertGasRefundHeight = 0
 
@@ -119,6 +129,8 @@ const (
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+var evmTracerOutputs = []string{"file", "jsonl"}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -136,6 +148,30 @@ type EVMConfig struct {
 	// Tracer defines vm.Tracer type that the EVM will use if the node is run in
 	// trace mode. Default: 'json'.
 	Tracer string `mapstructure:"tracer"`
+	// TracerOutput defines where the traces are written: to the standard output
+	// and error if empty, to a file per transaction named after its hash ('file')
+	// or to a rotating JSON lines file ('jsonl').
+	TracerOutput string `mapstructure:"tracer-output"`
+	// TracerDir defines the directory of the trace files. Relative paths are
+	// resolved from the node home directory.
+	TracerDir string `mapstructure:"tracer-dir"`
+	// TracerMaxFileSize defines the size in bytes after which the JSON lines
+	// trace file is rotated. Zero disables the rotation.
+	TracerMaxFileSize int64 `mapstructure:"tracer-max-file-size"`
+	// TracerSenders restricts the traced transactions to the ones sent by the
+	// given addresses.
+	TracerSenders []string `mapstructure:"tracer-senders"`
+	// TracerContracts restricts the traced transactions to the ones sent to the
+	// given addresses.
+	TracerContracts []string `mapstructure:"tracer-contracts"`
+	// TracerEnableMemory enables the memory capture of the tracer.
+	TracerEnableMemory bool `mapstructure:"tracer-enable-memory"`
+	// TracerDisableStack disables the stack capture of the tracer.
+	TracerDisableStack bool `mapstructure:"tracer-disable-stack"`
+	// TracerDisableStorage disables the storage capture of the tracer.
+	TracerDisableStorage bool `mapstructure:"tracer-disable-storage"`
+	// TracerEnableReturnData enables the return data capture of the tracer.
+	TracerEnableReturnData bool `mapstructure:"tracer-enable-return-data"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
 }
@@ -176,6 +212,9 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if the custom indexer also indexes the logs by
+	// address and topics, to serve `eth_getLogs` range queries.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -244,17 +283,40 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:            DefaultEVMTracer,
+		TracerOutput:      DefaultEVMTracerOutput,
+		TracerDir:         DefaultEVMTracerDir,
+		TracerMaxFileSize: DefaultEVMTracerMaxFileSize,
+		MaxTxGasWanted:    DefaultMaxTxGasWanted,
 	}
 }
 
-// Validate returns an error if the tracer type is invalid.
+// Validate returns an error if the tracer configuration is invalid.
 func (c EVMConfig) Validate() error {
 	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.TracerOutput != "" && !strings.StringInSlice(c.TracerOutput, evmTracerOutputs) {
+		return fmt.Errorf("invalid tracer output %s, available outputs: %v", c.TracerOutput, evmTracerOutputs)
+	}
+
+	if c.TracerOutput != "" && c.TracerDir == "" {
+		return errors.New("tracer directory cannot be empty")
+	}
+
+	if c.TracerMaxFileSize < 0 {
+		return errors.New("tracer max file size cannot be negative")
+	}
+
+	for _, addrs := range [][]string{c.TracerSenders, c.TracerContracts} {
+		for _, addr := range addrs {
+			if !common.IsHexAddress(addr) {
+				return fmt.Errorf("invalid tracer filter address %s", addr)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -287,6 +349,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -322,6 +385,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.EnableLogIndexer && !c.EnableIndexer {
+		return errors.New("JSON-RPC log indexer requires the custom indexer to be enabled")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
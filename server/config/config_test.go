@@ -68,3 +68,46 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestEVMConfigValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(cfg *EVMConfig)
+		wantErr  bool
+	}{
+		{"default", func(*EVMConfig) {}, false},
+		{
+			"valid jsonl output",
+			func(cfg *EVMConfig) {
+				cfg.Tracer = "struct"
+				cfg.TracerOutput = "jsonl"
+				cfg.TracerSenders = []string{"0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"}
+			},
+			false,
+		},
+		{"invalid tracer", func(cfg *EVMConfig) { cfg.Tracer = "invalid" }, true},
+		{"invalid output", func(cfg *EVMConfig) { cfg.TracerOutput = "invalid" }, true},
+		{
+			"empty directory",
+			func(cfg *EVMConfig) {
+				cfg.TracerOutput = "file"
+				cfg.TracerDir = ""
+			},
+			true,
+		},
+		{"negative max file size", func(cfg *EVMConfig) { cfg.TracerMaxFileSize = -1 }, true},
+		{"invalid contract address", func(cfg *EVMConfig) { cfg.TracerContracts = []string{"0x0000"} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultEVMConfig()
+			tt.malleate(cfg)
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -19,6 +19,32 @@ const DefaultConfigTemplate = `
 # Valid types are: json|struct|access_list|markdown
 tracer = "{{ .EVM.Tracer }}"
 
+# TracerOutput defines where the traces are written. Valid outputs are:
+#   - "": the standard output and error
+#   - "file": a file per transaction, named after the transaction hash
+#   - "jsonl": a JSON lines file, rotated once it exceeds 'tracer-max-file-size'
+tracer-output = "{{ .EVM.TracerOutput }}"
+
+# TracerDir defines the directory of the trace files. Relative paths are resolved
+# from the node home directory.
+tracer-dir = "{{ .EVM.TracerDir }}"
+
+# TracerMaxFileSize defines the size in bytes after which the JSON lines trace file
+# is rotated (0=disabled).
+tracer-max-file-size = {{ .EVM.TracerMaxFileSize }}
+
+# TracerSenders and TracerContracts restrict the traced transactions to the ones
+# sent by one of the senders or to one of the contracts. All the transactions are
+# traced if both are empty.
+tracer-senders = [{{range $index, $elmt := .EVM.TracerSenders}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+tracer-contracts = [{{range $index, $elmt := .EVM.TracerContracts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# Options of the json, struct and markdown tracers.
+tracer-enable-memory = {{ .EVM.TracerEnableMemory }}
+tracer-disable-stack = {{ .EVM.TracerDisableStack }}
+tracer-disable-storage = {{ .EVM.TracerDisableStorage }}
+tracer-enable-return-data = {{ .EVM.TracerEnableReturnData }}
+
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
@@ -79,6 +105,11 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the address and topic keyed log index of the custom indexer.
+# The 'eth_getLogs' queries within the indexed block range are not limited by the block-range-cap.
+# Use the 'index-eth-tx' command to backfill the log index of the historical blocks.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
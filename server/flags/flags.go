@@ -52,6 +52,7 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndexer    = "json-rpc.enable-log-indexer"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -61,8 +62,17 @@ const (
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                 = "evm.tracer"
+	EVMTracerOutput           = "evm.tracer-output"
+	EVMTracerDir              = "evm.tracer-dir"
+	EVMTracerMaxFileSize      = "evm.tracer-max-file-size"
+	EVMTracerSenders          = "evm.tracer-senders"
+	EVMTracerContracts        = "evm.tracer-contracts"
+	EVMTracerEnableMemory     = "evm.tracer-enable-memory"
+	EVMTracerDisableStack     = "evm.tracer-disable-stack"
+	EVMTracerDisableStorage   = "evm.tracer-disable-storage"
+	EVMTracerEnableReturnData = "evm.tracer-enable-return-data"
+	EVMMaxTxGasWanted         = "evm.max-tx-gas-wanted"
 )
 
 // TLS flags
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/evmos/v15/indexer"
+	srvflags "github.com/evmos/evmos/v15/server/flags"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -25,6 +26,9 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		If the log indexer is enabled, the traverse starts from the first or latest block of the log index instead,
+		so the logs of the blocks indexed before enabling it are backfilled.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -49,6 +53,12 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
+			firstIndexedBlock, lastIndexedBlock := idxer.FirstIndexedBlock, idxer.LastIndexedBlock
+			if serverCtx.Viper.GetBool(srvflags.JSONRPCEnableLogIndexer) {
+				idxer = idxer.WithLogIndex()
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstLogIndexedBlock, idxer.LastLogIndexedBlock
+			}
+
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
 			if err != nil {
@@ -82,7 +92,7 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := firstIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -96,7 +106,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := lastIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -116,5 +126,7 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Index the logs by address and topics, and traverse from the log index boundaries")
 	return cmd
 }
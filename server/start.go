@@ -198,10 +198,20 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the address and topic keyed log index of the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
-	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
-	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")                                 //nolint:lll
+	cmd.Flags().String(srvflags.EVMTracerOutput, config.DefaultEVMTracerOutput, "the output of the EVM traces: the standard output and error if empty, a file per transaction (file) or a rotating JSON lines file (jsonl)") //nolint:lll
+	cmd.Flags().String(srvflags.EVMTracerDir, config.DefaultEVMTracerDir, "the directory of the EVM trace files, relative to the node home if not absolute")
+	cmd.Flags().Int64(srvflags.EVMTracerMaxFileSize, config.DefaultEVMTracerMaxFileSize, "the size in bytes after which the JSON lines trace file is rotated (0=disabled)")
+	cmd.Flags().StringSlice(srvflags.EVMTracerSenders, nil, "trace only the transactions sent by the given addresses (or to the given contracts)")
+	cmd.Flags().StringSlice(srvflags.EVMTracerContracts, nil, "trace only the transactions sent to the given contracts (or by the given senders)")
+	cmd.Flags().Bool(srvflags.EVMTracerEnableMemory, false, "enable the memory capture of the EVM tracer")
+	cmd.Flags().Bool(srvflags.EVMTracerDisableStack, false, "disable the stack capture of the EVM tracer")
+	cmd.Flags().Bool(srvflags.EVMTracerDisableStorage, false, "disable the storage capture of the EVM tracer")
+	cmd.Flags().Bool(srvflags.EVMTracerEnableReturnData, false, "enable the return data capture of the EVM tracer")
+	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode") //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -357,6 +367,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		logger.Info("starting node in query only mode; Tendermint is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.EnableLogIndexer = false
 	} else {
 		logger.Info("starting node with ABCI Tendermint in-process")
 
@@ -418,7 +429,11 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		}
 
 		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIndexer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if config.JSONRPC.EnableLogIndexer {
+			kvIndexer = kvIndexer.WithLogIndex()
+		}
+		idxer = kvIndexer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
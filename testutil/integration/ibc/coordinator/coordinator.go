@@ -3,11 +3,13 @@
 package coordinator
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	evmosibc "github.com/evmos/evmos/v15/ibc/testing"
 	"github.com/evmos/evmos/v15/testutil/integration/common/network"
@@ -38,6 +40,9 @@ type Coordinator interface {
 	CommitNBlocks(chainID string, n uint64) error
 	// CommitAll commits 1 blocks on all chains within the coordinator.
 	CommitAll() error
+	// RelayPacket relays the given packet through one of the paths created during Setup
+	// and acknowledges it on the source chain.
+	RelayPacket(packet channeltypes.Packet) error
 }
 
 // TODO: Replace for a config
@@ -56,6 +61,7 @@ var _ Coordinator = (*IntegrationCoordinator)(nil)
 type IntegrationCoordinator struct {
 	coord          *ibctesting.Coordinator
 	dummyChainsIds []string
+	paths          []*evmosibc.Path
 }
 
 // NewIntegrationCoordinator returns a new IntegrationCoordinator with N TestChain's.
@@ -118,6 +124,7 @@ func (c *IntegrationCoordinator) Setup(a, b string) IBCConnection {
 
 	path := evmosibc.NewTransferPath(chainA, chainB)
 	evmosibc.SetupPath(c.coord, path)
+	c.paths = append(c.paths, path)
 
 	return IBCConnection{
 		EndpointA: Endpoint{
@@ -151,3 +158,17 @@ func (c *IntegrationCoordinator) CommitAll() error {
 	}
 	return nil
 }
+
+// RelayPacket relays the given packet through the path that connects its source and
+// destination channels and acknowledges it on the source chain. The source chain must
+// have committed the block that contains the packet commitment.
+func (c *IntegrationCoordinator) RelayPacket(packet channeltypes.Packet) error {
+	for _, path := range c.paths {
+		if connectsPacketEndpoints(path.EndpointA, path.EndpointB, packet) ||
+			connectsPacketEndpoints(path.EndpointB, path.EndpointA, packet) {
+			return path.RelayPacket(packet)
+		}
+	}
+	return fmt.Errorf("no path found for packet from %s/%s to %s/%s",
+		packet.SourcePort, packet.SourceChannel, packet.DestinationPort, packet.DestinationChannel)
+}
@@ -6,7 +6,9 @@ import (
 	"strconv"
 	"testing"
 
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	evmosibc "github.com/evmos/evmos/v15/ibc/testing"
 	"github.com/evmos/evmos/v15/testutil/integration/common/network"
 )
 
@@ -38,3 +40,12 @@ func mergeMaps(m1, m2 map[string]*ibctesting.TestChain) map[string]*ibctesting.T
 	}
 	return m1
 }
+
+// connectsPacketEndpoints returns true if the packet is sent from the source endpoint
+// to the destination endpoint.
+func connectsPacketEndpoints(src, dst *evmosibc.Endpoint, packet channeltypes.Packet) bool {
+	return src.ChannelConfig.PortID == packet.SourcePort &&
+		src.ChannelID == packet.SourceChannel &&
+		dst.ChannelConfig.PortID == packet.DestinationPort &&
+		dst.ChannelID == packet.DestinationChannel
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 
 	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -22,6 +23,16 @@ var (
 	DefaultFee = sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(uint64(feeAmt))) // 0.01 EVMOS
 )
 
+// GetFeesPaid returns the fees paid by a delivered Cosmos transaction, i.e. the
+// provided fees minus the refund of the fees for the gas left unused.
+func GetFeesPaid(fees sdk.Coin, res abci.ResponseDeliverTx) sdk.Coin {
+	if res.GasWanted <= 0 || res.GasUsed >= res.GasWanted {
+		return fees
+	}
+	refund := fees.Amount.MulRaw(res.GasWanted - res.GasUsed).QuoRaw(res.GasWanted)
+	return fees.SubAmount(refund)
+}
+
 // CosmosTxArgs contains the params to create a cosmos tx
 type CosmosTxArgs struct {
 	// TxCfg is the client transaction config
@@ -0,0 +1,6 @@
+package metrics
+
+const (
+	epsilon           = 0.0000000000000001
+	epsilonPercentile = .00000000001
+)
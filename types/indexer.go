@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -19,3 +20,18 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of the optional log index, used to serve
+// eth_getLogs range queries without walking the blocks.
+type EVMLogIndexer interface {
+	// FirstLogIndexedBlock returns -1 if the log index is empty
+	FirstLogIndexedBlock() (int64, error)
+	// LastLogIndexedBlock returns -1 if the log index is empty
+	LastLogIndexedBlock() (int64, error)
+	// IsLogIndexedRange returns false if any block of the range is missing from
+	// the log index
+	IsLogIndexedRange(from, to int64) (bool, error)
+	// GetLogs returns the logs of the block range matching the addresses and
+	// topics, or an error if more than limit logs match.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
@@ -130,12 +130,13 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("can claim ActionDelegate", func() {
 			addr := getAddr(privs[0])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Delegate(s.ctx, s.app, privs[0], delegateAmount, s.validator)
+			res, err := testutil.Delegate(s.ctx, s.app, privs[0], delegateAmount, s.validator)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(delegateAmount).Sub(tx.DefaultFee)))
-			Expect(balance.Amount).To(Equal(initClaimsAmount.Add(initBalanceAmount).Add(actionV.Amount).Sub(delegateAmount.Amount).Sub(tx.DefaultFee.Amount)))
-			fees[0] = tx.DefaultFee
+			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(delegateAmount).Sub(fee)))
+			Expect(balance.Amount).To(Equal(initClaimsAmount.Add(initBalanceAmount).Add(actionV.Amount).Sub(delegateAmount.Amount).Sub(fee.Amount)))
+			fees[0] = fee
 		})
 
 		It("can claim ActionEVM", func() {
@@ -151,11 +152,12 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("can claim ActionVote", func() {
 			addr := getAddr(privs[1])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Vote(s.ctx, s.app, privs[1], proposalID, govv1beta1.OptionAbstain)
+			res, err := testutil.Vote(s.ctx, s.app, privs[1], proposalID, govv1beta1.OptionAbstain)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(tx.DefaultFee)))
-			fees[1] = tx.DefaultFee
+			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(fee)))
+			fees[1] = fee
 		})
 
 		It("did not clawback to the community pool", func() {
@@ -200,12 +202,13 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("can claim ActionDelegate", func() {
 			addr := getAddr(privs[1])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Delegate(s.ctx, s.app, privs[1], delegateAmount, s.validator)
+			res, err := testutil.Delegate(s.ctx, s.app, privs[1], delegateAmount, s.validator)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(delegateAmount).Sub(tx.DefaultFee)))
-			fees[1] = fees[1].Add(tx.DefaultFee)
+			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(delegateAmount).Sub(fee)))
+			fees[1] = fees[1].Add(fee)
 		})
 
 		It("can claim ActionEVM", func() {
@@ -224,23 +227,25 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("can claim ActionVote", func() {
 			addr := getAddr(privs[0])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Vote(s.ctx, s.app, privs[0], proposalID, govv1beta1.OptionAbstain)
+			res, err := testutil.Vote(s.ctx, s.app, privs[0], proposalID, govv1beta1.OptionAbstain)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(tx.DefaultFee)))
-			fees[0] = fees[0].Add(tx.DefaultFee)
+			Expect(balance).To(Equal(prebalance.Add(actionV).Sub(fee)))
+			fees[0] = fees[0].Add(fee)
 		})
 
 		It("cannot claim ActionDelegate a second time", func() {
 			addr := getAddr(privs[1])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Delegate(s.ctx, s.app, privs[1], delegateAmount, s.validator)
+			res, err := testutil.Delegate(s.ctx, s.app, privs[1], delegateAmount, s.validator)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Sub(delegateAmount).Sub(tx.DefaultFee)))
-			fees[1] = fees[1].Add(tx.DefaultFee)
+			Expect(balance).To(Equal(prebalance.Sub(delegateAmount).Sub(fee)))
+			fees[1] = fees[1].Add(fee)
 		})
 
 		It("cannot claim ActionEVM a second time", func() {
@@ -256,12 +261,13 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("cannot claim ActionVote a second time", func() {
 			addr := getAddr(privs[0])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Vote(s.ctx, s.app, privs[0], proposalID, govv1beta1.OptionAbstain)
+			res, err := testutil.Vote(s.ctx, s.app, privs[0], proposalID, govv1beta1.OptionAbstain)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Sub(tx.DefaultFee)))
-			fees[0] = fees[0].Add(tx.DefaultFee)
+			Expect(balance).To(Equal(prebalance.Sub(fee)))
+			fees[0] = fees[0].Add(fee)
 		})
 
 		It("did not clawback to the community pool", func() {
@@ -293,12 +299,13 @@ var _ = Describe("Claiming", Ordered, func() {
 		It("cannot claim additional actions", func() {
 			addr := getAddr(privs[2])
 			prebalance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			_, err := testutil.Delegate(s.ctx, s.app, privs[2], delegateAmount, s.validator)
+			res, err := testutil.Delegate(s.ctx, s.app, privs[2], delegateAmount, s.validator)
 			s.Require().NoError(err)
+			fee := tx.GetFeesPaid(tx.DefaultFee, res)
 
 			balance := s.app.BankKeeper.GetBalance(s.ctx, addr, utils.BaseDenom)
-			Expect(balance).To(Equal(prebalance.Sub(delegateAmount).Sub(tx.DefaultFee)))
-			fees[2] = fees[2].Add(tx.DefaultFee)
+			Expect(balance).To(Equal(prebalance.Sub(delegateAmount).Sub(fee)))
+			fees[2] = fees[2].Add(fee)
 		})
 
 		It("cannot clawback already claimed actions", func() {
@@ -162,7 +162,8 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			s.SendAndReceiveMessage(s.pathOsmosisEvmos, s.EvmosChain, utils.BaseDenom, amount, receiver, sender, 1, "")
 
 			aevmosAfterBalance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), receiverAcc, utils.BaseDenom)
-			s.Require().Equal(aevmosInitialBalance.Amount.Sub(math.NewInt(amount)).Sub(sendAndReceiveMsgFee), aevmosAfterBalance.Amount)
+			sendFees := aevmosInitialBalance.Amount.Sub(math.NewInt(amount)).Sub(aevmosAfterBalance.Amount)
+			s.Require().True(sendFees.IsPositive() && sendFees.LTE(sendAndReceiveMsgFee), "unexpected fees paid: %s", sendFees)
 
 			// check ibc aevmos coins balance on Osmosis
 			aevmosIBCBalanceBefore := s.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(s.IBCOsmosisChain.GetContext(), senderAcc, teststypes.AevmosIbcdenom)
@@ -179,8 +180,8 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			// check aevmos balance after transfer - should be equal to initial balance
 			aevmosFinalBalance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), receiverAcc, utils.BaseDenom)
 
-			totalFees := sendBackCoinsFee.Add(sendAndReceiveMsgFee)
-			s.Require().Equal(aevmosInitialBalance.Amount.Sub(totalFees), aevmosFinalBalance.Amount)
+			totalFees := aevmosInitialBalance.Amount.Sub(aevmosFinalBalance.Amount)
+			s.Require().True(totalFees.GT(sendFees) && totalFees.LTE(sendFees.Add(sendBackCoinsFee)), "unexpected fees paid: %s", totalFees)
 
 			// check IBC Coin balance - should be zero
 			ibcCoinsBalance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), receiverAcc, teststypes.AevmosIbcdenom)
@@ -326,7 +327,8 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			// validate that Receiver address on Evmos got the claims tokens
 			receiverFinalAevmosBalance := s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), receiverAcc, utils.BaseDenom)
 
-			s.Require().Equal(receiverInitialAevmosBalance.Amount.Add(claimableAmount).Sub(sendBackCoinsFee), receiverFinalAevmosBalance.Amount)
+			fees := receiverInitialAevmosBalance.Amount.Add(claimableAmount).Sub(receiverFinalAevmosBalance.Amount)
+			s.Require().True(fees.IsPositive() && fees.LTE(sendBackCoinsFee), "unexpected fees paid: %s", fees)
 		})
 	})
 	Describe("registered erc20", func() {
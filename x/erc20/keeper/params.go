@@ -4,7 +4,10 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v15/x/erc20/types"
 )
 
@@ -14,8 +17,9 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	outposts := k.GetOutposts(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(enableErc20, enableEvmHook, outposts)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -26,6 +30,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
+	k.setOutposts(ctx, params.Outposts)
 
 	return nil
 }
@@ -61,3 +66,53 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// GetOutposts returns the IBC configuration of all the outpost precompiles
+func (k Keeper) GetOutposts(ctx sdk.Context) []types.Outpost {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyOutposts)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var outposts []types.Outpost
+	for ; iterator.Valid(); iterator.Next() {
+		var outpost types.Outpost
+		k.cdc.MustUnmarshal(iterator.Value(), &outpost)
+		outposts = append(outposts, outpost)
+	}
+
+	return outposts
+}
+
+// GetOutpost returns the IBC configuration of the outpost precompile deployed
+// at the given address. It returns an error if the outpost is not configured.
+func (k Keeper) GetOutpost(ctx sdk.Context, address common.Address) (types.Outpost, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyOutposts)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return types.Outpost{}, errorsmod.Wrapf(types.ErrOutpostNotFound, "address %s", address)
+	}
+
+	var outpost types.Outpost
+	k.cdc.MustUnmarshal(bz, &outpost)
+	return outpost, nil
+}
+
+// setOutposts replaces the IBC configuration of the outpost precompiles in the store
+func (k Keeper) setOutposts(ctx sdk.Context, outposts []types.Outpost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamStoreKeyOutposts)
+
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for i := range outposts {
+		store.Set(outposts[i].GetHexAddress().Bytes(), k.cdc.MustMarshal(&outposts[i]))
+	}
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"reflect"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v15/x/erc20/types"
 )
 
@@ -26,6 +27,24 @@ func (suite *KeeperTestSuite) TestParams() {
 			},
 			true,
 		},
+		{
+			"success - Checks if the outposts are replaced",
+			func() interface{} {
+				return []types.Outpost{
+					types.NewOutpost(types.OsmosisOutpostAddress, "transfer", "channel-1", "osmo1contract"),
+				}
+			},
+			func() interface{} {
+				params := types.DefaultParams()
+				params.Outposts = []types.Outpost{
+					types.NewOutpost(types.OsmosisOutpostAddress, "transfer", "channel-1", "osmo1contract"),
+				}
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+				return suite.app.Erc20Keeper.GetOutposts(suite.ctx)
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -35,3 +54,14 @@ func (suite *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGetOutpost() {
+	suite.SetupTest()
+
+	outpost, err := suite.app.Erc20Keeper.GetOutpost(suite.ctx, common.HexToAddress(types.StrideOutpostAddress))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultOutposts()[0], outpost)
+
+	_, err = suite.app.Erc20Keeper.GetOutpost(suite.ctx, common.HexToAddress("0x0000000000000000000000000000000000000999"))
+	suite.Require().ErrorIs(err, types.ErrOutpostNotFound)
+}
@@ -0,0 +1,36 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/x/erc20/types"
+)
+
+// GetPermitNonce returns the EIP-2612 permit nonce of the owner for the ERC-20
+// precompile at the given contract address.
+func (k Keeper) GetPermitNonce(ctx sdk.Context, contract, owner common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(permitNonceKey(contract, owner))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the owner for the ERC-20
+// precompile at the given contract address.
+func (k Keeper) SetPermitNonce(ctx sdk.Context, contract, owner common.Address, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(permitNonceKey(contract, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// permitNonceKey returns the store key of the permit nonce of the owner for the
+// given contract.
+func permitNonceKey(contract, owner common.Address) []byte {
+	return append(contract.Bytes(), owner.Bytes()...)
+}
@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestPermitNonce() {
+	contract := utiltx.GenerateAddress()
+	owner := utiltx.GenerateAddress()
+
+	suite.Require().Equal(uint64(0), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, owner))
+
+	suite.app.Erc20Keeper.SetPermitNonce(suite.ctx, contract, owner, 2)
+	suite.Require().Equal(uint64(2), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, owner))
+
+	// the nonces are scoped by contract and owner
+	suite.Require().Equal(uint64(0), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, utiltx.GenerateAddress(), owner))
+	suite.Require().Equal(uint64(0), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, contract, utiltx.GenerateAddress()))
+}
@@ -55,12 +55,12 @@ type KeeperTestSuite struct {
 
 var (
 	s *KeeperTestSuite
-	// sendAndReceiveMsgFee corresponds to the fees paid on Evmos chain when calling the SendAndReceive function
-	// This function makes 3 cosmos txs under the hood
+	// sendAndReceiveMsgFee corresponds to the maximum fees paid on Evmos chain when calling the SendAndReceive function
+	// This function makes 3 cosmos txs under the hood, which are refunded the fees of their unused gas
 	sendAndReceiveMsgFee = sdk.NewInt(ibctesting.DefaultFeeAmt * 3)
-	// sendBackCoinsFee corresponds to the fees paid on Evmos chain when calling the SendBackCoins function
+	// sendBackCoinsFee corresponds to the maximum fees paid on Evmos chain when calling the SendBackCoins function
 	// or calling the SendAndReceive from another chain to Evmos
-	// This function makes 2 cosmos txs under the hood
+	// This function makes 2 cosmos txs under the hood, which are refunded the fees of their unused gas
 	sendBackCoinsFee = sdk.NewInt(ibctesting.DefaultFeeAmt * 2)
 )
 
@@ -85,6 +85,8 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// outposts defines the IBC configuration of the outpost precompiles
+	Outposts []Outpost `protobuf:"bytes,3,rep,name=outposts,proto3" json:"outposts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,34 +136,125 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetOutposts() []Outpost {
+	if m != nil {
+		return m.Outposts
+	}
+	return nil
+}
+
+// Outpost defines the IBC configuration used by an outpost precompile to reach
+// its counterparty chain
+type Outpost struct {
+	// address is the hex address of the outpost precompile
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// port_id is the IBC port used to send the outpost packets
+	PortID string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the IBC channel used to send the outpost packets
+	ChannelID string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// counterparty_contract is the address of the contract on the counterparty
+	// chain that handles the outpost packets
+	CounterpartyContract string `protobuf:"bytes,4,opt,name=counterparty_contract,json=counterpartyContract,proto3" json:"counterparty_contract,omitempty"`
+}
+
+func (m *Outpost) Reset()         { *m = Outpost{} }
+func (m *Outpost) String() string { return proto.CompactTextString(m) }
+func (*Outpost) ProtoMessage()    {}
+func (*Outpost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f4674601b0d6987, []int{2}
+}
+func (m *Outpost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Outpost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Outpost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Outpost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outpost.Merge(m, src)
+}
+func (m *Outpost) XXX_Size() int {
+	return m.Size()
+}
+func (m *Outpost) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outpost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outpost proto.InternalMessageInfo
+
+func (m *Outpost) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Outpost) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *Outpost) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *Outpost) GetCounterpartyContract() string {
+	if m != nil {
+		return m.CounterpartyContract
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
+	proto.RegisterType((*Outpost)(nil), "evmos.erc20.v1.Outpost")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x54, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x8e, 0x7d, 0x15, 0x9d, 0x01, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x75, 0x4a, 0xd7, 0xaf, 0x2b, 0x08, 0x6b, 0x40, 0xa8, 0x50, 0x3a, 0xca, 0xa5,
+	0x07, 0x94, 0xd0, 0x0e, 0x0e, 0xbb, 0xa1, 0x6c, 0x13, 0xf4, 0x80, 0xa8, 0x02, 0xe2, 0xc0, 0x25,
+	0x72, 0x13, 0xab, 0x8d, 0xba, 0xe4, 0x8b, 0x6c, 0x37, 0x62, 0x2f, 0xc0, 0x99, 0x57, 0xe0, 0xcc,
+	0x8b, 0xec, 0xb8, 0x23, 0xa7, 0x08, 0xa5, 0x2f, 0x82, 0x62, 0xbb, 0x08, 0x7a, 0x89, 0xec, 0xff,
+	0xef, 0xf7, 0xd9, 0x7f, 0x45, 0x86, 0xa7, 0xac, 0xcc, 0x50, 0xf8, 0x8c, 0xc7, 0xd3, 0x97, 0x7e,
+	0x39, 0xf1, 0x97, 0x2c, 0x67, 0x22, 0x15, 0x5e, 0xc1, 0x51, 0x22, 0xb9, 0xa7, 0xa8, 0xa7, 0xa8,
+	0x57, 0x4e, 0x06, 0x83, 0x3d, 0x5b, 0x03, 0xe5, 0x0e, 0x4e, 0x96, 0xb8, 0x44, 0xb5, 0xf4, 0x9b,
+	0x95, 0x4e, 0x47, 0xdf, 0x2c, 0x38, 0x7e, 0xab, 0xcf, 0xfc, 0x28, 0xa9, 0x64, 0xe4, 0x15, 0xd8,
+	0x05, 0xe5, 0x34, 0x13, 0x8e, 0x75, 0x6a, 0x8d, 0x7b, 0xd3, 0x47, 0xde, 0xff, 0x77, 0x78, 0x73,
+	0x45, 0x83, 0xc3, 0xdb, 0x6a, 0xd8, 0x0a, 0x8d, 0x4b, 0xde, 0x40, 0x4f, 0xe2, 0x9a, 0xe5, 0x51,
+	0x41, 0x53, 0x2e, 0x9c, 0x83, 0xd3, 0xf6, 0xb8, 0x37, 0x7d, 0xb2, 0x3f, 0xfa, 0xa9, 0x51, 0xe6,
+	0x34, 0xe5, 0x66, 0x1a, 0xe4, 0x2e, 0x10, 0xa3, 0x1f, 0x16, 0xd8, 0xfa, 0x68, 0xf2, 0x0c, 0x8e,
+	0x59, 0x4e, 0x17, 0xd7, 0x2c, 0x52, 0x93, 0xaa, 0xc8, 0x51, 0xd8, 0xd3, 0xd9, 0x55, 0x13, 0x91,
+	0x73, 0xb8, 0xbf, 0x53, 0xca, 0x2c, 0x5a, 0x21, 0xae, 0x9d, 0x83, 0xc6, 0x0a, 0x1e, 0xd4, 0xd5,
+	0xb0, 0x7f, 0xa5, 0xcd, 0xcf, 0xef, 0xdf, 0x21, 0xae, 0xc3, 0xbe, 0x19, 0x2c, 0xb3, 0x66, 0x4b,
+	0xce, 0xe1, 0x08, 0x37, 0xb2, 0x40, 0x21, 0x85, 0xd3, 0x56, 0x3d, 0x1f, 0xef, 0xf7, 0xfc, 0xa0,
+	0xb9, 0x69, 0xf9, 0x57, 0x1f, 0xfd, 0xb4, 0xa0, 0x63, 0x18, 0x71, 0xa0, 0x43, 0x93, 0x84, 0x33,
+	0xa1, 0x7f, 0x54, 0x37, 0xdc, 0x6d, 0xc9, 0x73, 0xe8, 0x14, 0xc8, 0x65, 0x94, 0x26, 0xaa, 0x53,
+	0x37, 0x80, 0xba, 0x1a, 0xda, 0x73, 0xe4, 0x72, 0x76, 0x19, 0xda, 0x0d, 0x9a, 0x25, 0xe4, 0x05,
+	0x40, 0xbc, 0xa2, 0x79, 0xce, 0xae, 0x1b, 0xaf, 0xad, 0xbc, 0x7e, 0x5d, 0x0d, 0xbb, 0x17, 0x3a,
+	0x9d, 0x5d, 0x86, 0x5d, 0x23, 0xcc, 0x12, 0x72, 0x06, 0x0f, 0x63, 0xdc, 0xe4, 0x92, 0xf1, 0x82,
+	0x72, 0x79, 0x13, 0xc5, 0x98, 0x4b, 0x4e, 0x63, 0xe9, 0x1c, 0xaa, 0xab, 0x4f, 0xfe, 0x85, 0x17,
+	0x86, 0x05, 0xc1, 0x6d, 0xed, 0x5a, 0x77, 0xb5, 0x6b, 0xfd, 0xae, 0x5d, 0xeb, 0xfb, 0xd6, 0x6d,
+	0xdd, 0x6d, 0xdd, 0xd6, 0xaf, 0xad, 0xdb, 0xfa, 0x32, 0x5e, 0xa6, 0x72, 0xb5, 0x59, 0x78, 0x31,
+	0x66, 0xbe, 0x79, 0x31, 0xea, 0x5b, 0x4e, 0x5e, 0xfb, 0x5f, 0xcd, 0xeb, 0x91, 0x37, 0x05, 0x13,
+	0x0b, 0x5b, 0xbd, 0x92, 0xb3, 0x3f, 0x03, 0x00, 0x9b, 0xd6, 0xa8, 0x25, 0x87, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -231,6 +324,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Outposts) > 0 {
+		for iNdEx := len(m.Outposts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outposts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -254,6 +361,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Outpost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Outpost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Outpost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyContract) > 0 {
+		i -= len(m.CounterpartyContract)
+		copy(dAtA[i:], m.CounterpartyContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CounterpartyContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -294,6 +452,37 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.Outposts) > 0 {
+		for _, e := range m.Outposts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Outpost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CounterpartyContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -489,6 +678,218 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outposts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outposts = append(m.Outposts, Outpost{})
+			if err := m.Outposts[len(m.Outposts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outpost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outpost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outpost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,22 +5,37 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Parameter store key
 var (
 	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
 	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyOutposts      = []byte("Outposts")
+)
+
+const (
+	// StrideOutpostAddress is the address of the Stride outpost precompile
+	StrideOutpostAddress = "0x0000000000000000000000000000000000000900"
+	// OsmosisOutpostAddress is the address of the Osmosis outpost precompile
+	OsmosisOutpostAddress = "0x0000000000000000000000000000000000000901"
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	outposts []Outpost,
 ) Params {
 	return Params{
 		EnableErc20:   enableErc20,
 		EnableEVMHook: enableEVMHook,
+		Outposts:      outposts,
 	}
 }
 
@@ -28,6 +43,17 @@ func DefaultParams() Params {
 	return Params{
 		EnableErc20:   true,
 		EnableEVMHook: true,
+		Outposts:      DefaultOutposts(),
+	}
+}
+
+// DefaultOutposts returns the default IBC configuration of the Stride and
+// Osmosis outposts. The Osmosis cross-chain swap contract is left empty and
+// has to be set through governance before the outpost can be used.
+func DefaultOutposts() []Outpost {
+	return []Outpost{
+		NewOutpost(StrideOutpostAddress, transfertypes.PortID, "channel-25", ""),
+		NewOutpost(OsmosisOutpostAddress, transfertypes.PortID, "channel-0", ""),
 	}
 }
 
@@ -40,10 +66,74 @@ func ValidateBool(i interface{}) error {
 	return nil
 }
 
+// ValidateOutposts validates the outposts configuration and checks that no
+// outpost address is duplicated.
+func ValidateOutposts(i interface{}) error {
+	outposts, ok := i.([]Outpost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool, len(outposts))
+	for _, outpost := range outposts {
+		if err := outpost.Validate(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(outpost.Address)
+		if seen[address] {
+			return fmt.Errorf("duplicate outpost address %s", outpost.Address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateBool(p.EnableEVMHook); err != nil {
 		return err
 	}
 
-	return ValidateBool(p.EnableErc20)
+	if err := ValidateBool(p.EnableErc20); err != nil {
+		return err
+	}
+
+	return ValidateOutposts(p.Outposts)
+}
+
+// NewOutpost creates a new Outpost instance
+func NewOutpost(address, portID, channelID, counterpartyContract string) Outpost {
+	return Outpost{
+		Address:              address,
+		PortID:               portID,
+		ChannelID:            channelID,
+		CounterpartyContract: counterpartyContract,
+	}
+}
+
+// Validate performs a stateless validation of the outpost IBC configuration.
+func (o Outpost) Validate() error {
+	if !common.IsHexAddress(o.Address) {
+		return fmt.Errorf("invalid outpost address %s", o.Address)
+	}
+
+	if err := host.PortIdentifierValidator(o.PortID); err != nil {
+		return fmt.Errorf("invalid port ID for outpost %s: %w", o.Address, err)
+	}
+
+	if err := host.ChannelIdentifierValidator(o.ChannelID); err != nil {
+		return fmt.Errorf("invalid channel ID for outpost %s: %w", o.Address, err)
+	}
+
+	if o.CounterpartyContract != strings.TrimSpace(o.CounterpartyContract) {
+		return fmt.Errorf("invalid counterparty contract for outpost %s: contains leading or trailing whitespaces", o.Address)
+	}
+
+	return nil
+}
+
+// GetHexAddress returns the hex address of the outpost precompile.
+func (o Outpost) GetHexAddress() common.Address {
+	return common.HexToAddress(o.Address)
 }
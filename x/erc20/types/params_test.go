@@ -24,7 +24,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true),
+			types.NewParams(true, true, types.DefaultOutposts()),
 			false,
 		},
 		{
@@ -32,6 +32,42 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			types.Params{},
 			false,
 		},
+		{
+			"invalid outpost address",
+			types.NewParams(true, true, []types.Outpost{
+				types.NewOutpost("0x123", "transfer", "channel-0", ""),
+			}),
+			true,
+		},
+		{
+			"invalid outpost port",
+			types.NewParams(true, true, []types.Outpost{
+				types.NewOutpost(types.StrideOutpostAddress, "", "channel-0", ""),
+			}),
+			true,
+		},
+		{
+			"invalid outpost channel",
+			types.NewParams(true, true, []types.Outpost{
+				types.NewOutpost(types.StrideOutpostAddress, "transfer", "channel 0", ""),
+			}),
+			true,
+		},
+		{
+			"invalid outpost counterparty contract",
+			types.NewParams(true, true, []types.Outpost{
+				types.NewOutpost(types.OsmosisOutpostAddress, "transfer", "channel-0", " osmo1contract"),
+			}),
+			true,
+		},
+		{
+			"duplicate outpost address",
+			types.NewParams(true, true, []types.Outpost{
+				types.NewOutpost(types.StrideOutpostAddress, "transfer", "channel-0", ""),
+				types.NewOutpost(types.StrideOutpostAddress, "transfer", "channel-1", ""),
+			}),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -48,4 +84,6 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(types.ValidateBool(1))
 	suite.Require().NoError(types.ValidateBool(true))
+	suite.Require().Error(types.ValidateOutposts(true))
+	suite.Require().NoError(types.ValidateOutposts([]types.Outpost{}))
 }
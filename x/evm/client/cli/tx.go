@@ -10,11 +10,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmostypes "github.com/evmos/evmos/v15/types"
 	"github.com/evmos/evmos/v15/x/evm/types"
 )
 
@@ -27,7 +32,11 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSetSponsorPolicyCmd(),
+		NewRemoveSponsorPolicyCmd(),
+	)
 	return cmd
 }
 
@@ -111,3 +120,78 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// flagMethods defines the flag to restrict the sponsored contract methods
+const flagMethods = "methods"
+
+// NewSetSponsorPolicyCmd returns a CLI command handler for creating or updating the policy under which
+// the sender pays for the fees of the Ethereum transactions sent to a contract
+func NewSetSponsorPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-sponsor-policy CONTRACT_HEX BUDGET_PER_USER",
+		Short:   "Pay for the fees of the Ethereum transactions sent to a contract, up to a budget per user in the EVM denomination",
+		Example: "evmosd tx evm set-sponsor-policy 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd 1000000000000000000 --methods 0xa9059cbb,0x095ea7b3 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := evmostypes.ValidateNonZeroAddress(args[0]); err != nil {
+				return fmt.Errorf("invalid contract hex address %w", err)
+			}
+
+			budgetPerUser, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid budget per user %s", args[1])
+			}
+
+			methods, err := cmd.Flags().GetStringSlice(flagMethods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSponsorPolicy(cliCtx.GetFromAddress(), common.HexToAddress(args[0]), methods, budgetPerUser)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagMethods, []string{}, "hex encoded 4-byte selectors of the sponsored methods (all methods are sponsored if empty)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveSponsorPolicyCmd returns a CLI command handler for removing the sponsor policy of the sender
+// for a contract
+func NewRemoveSponsorPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-sponsor-policy CONTRACT_HEX",
+		Short: "Stop paying for the fees of the Ethereum transactions sent to a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := evmostypes.ValidateNonZeroAddress(args[0]); err != nil {
+				return fmt.Errorf("invalid contract hex address %w", err)
+			}
+
+			msg := types.NewMsgRemoveSponsorPolicy(cliCtx.GetFromAddress(), common.HexToAddress(args[0]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -26,14 +27,27 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// RefundGas transfers the leftover gas to the fee payer of the message, i.e. the sender or the
+// sponsor of the transaction, caped to half of the total gas consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// AnteHandler. The fees swapped from whitelisted fee tokens are not refunded, so that the refund
+// never exceeds the fees paid by the fee payer in the EVM denomination, and the refund to a
+// sponsor is credited back to the sponsor budget of the sender.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
+	if swapped := k.GetFeeTokenSwapTransient(ctx, msg.From(), msg.Nonce()); swapped.IsPositive() {
+		paid := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+		refundable := paid.Sub(paid, swapped.BigInt())
+		if refundable.Sign() < 0 {
+			refundable.SetInt64(0)
+		}
+		if remaining.Cmp(refundable) > 0 {
+			remaining = refundable
+		}
+	}
+
 	switch remaining.Sign() {
 	case -1:
 		// negative refund errors
@@ -42,13 +56,19 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		feePayer := k.GetFeePayerTransient(ctx, msg.From(), msg.Nonce())
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		// the budget of the user is only charged with the fees of the gas used
+		if sender := sdk.AccAddress(msg.From().Bytes()); !feePayer.Equals(sender) && msg.To() != nil {
+			k.RefundSponsorBudget(ctx, *msg.To(), feePayer, sender, refundedCoins.AmountOf(denom))
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -56,6 +76,26 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// SetFeeTokenSwapTransient sets the amount of the EVM denomination swapped from whitelisted fee
+// tokens to pay for the fees of the Ethereum transaction identified by the sender and nonce. This
+// value is reset on every block.
+func (k Keeper) SetFeeTokenSwapTransient(ctx sdk.Context, sender common.Address, nonce uint64, amount sdkmath.Int) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientFeeTokenSwapKey(sender, nonce), amount.BigInt().Bytes())
+}
+
+// GetFeeTokenSwapTransient returns the amount of the EVM denomination swapped from whitelisted fee
+// tokens to pay for the fees of the Ethereum transaction identified by the sender and nonce.
+func (k Keeper) GetFeeTokenSwapTransient(ctx sdk.Context, sender common.Address, nonce uint64) sdkmath.Int {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TransientFeeTokenSwapKey(sender, nonce))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	return sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(bz))
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
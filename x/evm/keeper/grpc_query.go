@@ -18,8 +18,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -55,6 +57,15 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 	addr := common.HexToAddress(req.Address)
 
 	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.PendingTxs) > 0 {
+		// the pending transactions are discarded once the query finishes
+		ctx, _ = ctx.CacheContext()
+		if _, _, err := k.prepareCallEnv(ctx, GetProposerAddress(ctx, nil), 0, req.PendingTxs, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
 	acct := k.GetAccountOrEmpty(ctx, addr)
 
 	return &types.QueryAccountResponse{
@@ -222,35 +233,94 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var args types.TransactionArgs
-	err := json.Unmarshal(req.Args, &args)
+	ctx, cfg, msg, err := k.prepareEthCall(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-	chainID, err := getChainID(ctx, req.ChainId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		// error will be returned with detail status from prepareEthCall
+		return nil, err
 	}
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
-	args.Nonce = (*hexutil.Uint64)(&nonce)
+	return res, nil
+}
 
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+// EthSimulate implements eth_simulateV1 rpc api. The calls of all the blocks are
+// executed in order on a single branch of the state, so each call observes the
+// changes of the previous ones, including the ones made by stateful precompiles.
+// The gas cap of the request is shared by all the calls.
+func (k Keeper) EthSimulate(c context.Context, req *types.EthSimulateRequest) (*types.EthSimulateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Blocks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no blocks to simulate")
 	}
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	ctx := sdk.UnwrapSDKContext(c)
+	// the calls commit their changes to this branch, which is discarded once the
+	// query finishes
+	ctx, _ = ctx.CacheContext()
+	proposerAddress := GetProposerAddress(ctx, req.ProposerAddress)
+	gasCap := req.GasCap
 
-	// pass false to not commit StateDB
-	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	res := &types.EthSimulateResponse{
+		Blocks: make([]types.EthSimulateBlockResult, 0, len(req.Blocks)),
+	}
+
+	for i, block := range req.Blocks {
+		blockCtx, cfg, err := k.prepareCallEnv(ctx, proposerAddress, req.ChainId, nil, block.Overrides, block.BlockOverrides)
+		if err != nil {
+			// error will be returned with detail status from prepareCallEnv
+			return nil, err
+		}
+
+		txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(blockCtx.HeaderHash()))
+		blockRes := types.EthSimulateBlockResult{
+			Calls: make([]*types.MsgEthereumTxResponse, 0, len(block.Calls)),
+		}
+
+		for j, bz := range block.Calls {
+			var args types.TransactionArgs
+			if err := json.Unmarshal(bz, &args); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d call %d: %s", i, j, err.Error())
+			}
+
+			if req.GasCap != 0 && gasCap == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d call %d: gas cap of %d exhausted", i, j, req.GasCap)
+			}
+
+			// ApplyMessageWithConfig expect correct nonce set in msg
+			nonce := k.GetNonce(blockCtx, args.GetFrom())
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+
+			msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "block %d call %d: %s", i, j, err.Error())
+			}
+
+			txConfig.TxIndex = uint(j)
+			// pass true to commit the StateDB to the branched context, so the
+			// next calls are executed on top of it
+			callRes, err := k.ApplyMessageWithConfig(blockCtx, msg, nil, true, cfg, txConfig)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "block %d call %d: %s", i, j, err.Error())
+			}
+
+			txConfig.LogIndex += uint(len(callRes.Logs))
+			if req.GasCap != 0 {
+				gasCap -= callRes.GasUsed
+			}
+
+			blockRes.Calls = append(blockRes.Calls, callRes)
+		}
+
+		res.Blocks = append(res.Blocks, blockRes)
 	}
 
 	return res, nil
@@ -482,6 +552,12 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 			continue
 		}
 		txConfig.LogIndex += uint(len(rsp.Logs))
+
+		if req.TraceConfig != nil && req.TraceConfig.Tracer == types.TracerStateDiff {
+			if err := k.chargeTracedMsg(ctx, msg, rsp.GasUsed, cfg.Params.EvmDenom); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
 	}
 
 	tx := req.Msg.AsTransaction()
@@ -512,6 +588,50 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call on top of the queried state, with the optional state and
+// block overrides applied. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil || req.Call == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ctx, cfg, msg, err := k.prepareEthCall(ctx, req.Call)
+	if err != nil {
+		// error will be returned with detail status from prepareEthCall
+		return nil, err
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment for all the transactions in the queried block.
 // The return value will be tracer dependent.
@@ -563,12 +683,18 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -598,6 +724,24 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -606,10 +750,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -637,7 +777,19 @@ func (k *Keeper) traceTx(
 		TxHash:    txConfig.TxHash,
 	}
 
-	if traceConfig.Tracer != "" {
+	switch traceConfig.Tracer {
+	case "":
+	case types.TracerCall:
+		// use the native call tracer, which is able to describe
+		// the stateful precompile calls
+		if tracer, err = types.NewCallTracer(tCtx, tracerJSONConfig); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+	case types.TracerStateDiff:
+		// use the native state diff tracer, which includes the balance changes
+		// made through the Cosmos SDK by the stateful precompiles
+		tracer = types.NewStateDiffTracer(tCtx, tracerJSONConfig, cfg.Params.EvmDenom)
+	default:
 		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
@@ -661,14 +813,26 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
+	// the state diff tracer reads the final balances and nonces from the keeper,
+	// so the message is always committed on the query context and charged as
+	// on chain, which also lets the next transactions of the block start from
+	// the same state
+	stateDiff := traceConfig.Tracer == types.TracerStateDiff
+
 	// reset gas meter for tx
 	// to be consistent with tx execution gas meter
 	ctx = ctx.WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
-	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, commitMessage || stateDiff, cfg, txConfig)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
+	if stateDiff {
+		if err := k.chargeTracedMsg(ctx, msg, res.GasUsed, cfg.Params.EvmDenom); err != nil {
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	var result interface{}
 	result, err = tracer.GetResult()
 	if err != nil {
@@ -678,6 +842,27 @@ func (k *Keeper) traceTx(
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
 
+// chargeTracedMsg applies the changes made by the ante handler and the gas refund
+// around the execution of a traced message, which are skipped while tracing: the
+// sender nonce is incremented and the fees of the used gas are sent to the fee
+// collector.
+func (k *Keeper) chargeTracedMsg(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string) error {
+	acc := k.accountKeeper.GetAccount(ctx, msg.From().Bytes())
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", msg.From())
+	}
+	if err := acc.SetSequence(msg.Nonce() + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(gasUsed))
+	if fee.Sign() <= 0 {
+		return nil
+	}
+	return k.DeductTxCostsFromUserBalance(ctx, sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fee))}, msg.From())
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -4,17 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	distributionprecompile "github.com/evmos/evmos/v15/precompiles/distribution"
 	"github.com/evmos/evmos/v15/server/config"
 	utiltx "github.com/evmos/evmos/v15/testutil/tx"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
@@ -25,10 +29,10 @@ import (
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7328
+const expGasConsumed = 8282
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7322
+const expGasConsumedWithFeeMkt = 8276
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -937,7 +941,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   25244, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   36362, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1184,6 +1188,78 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceBlockStateDiff() {
+	suite.SetupTest()
+
+	recipient := utiltx.GenerateAddress()
+	chainID := suite.app.EvmKeeper.ChainID()
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+
+	amt := sdk.Coins{sdk.NewInt64Coin(suite.EvmDenom(), 1e18)}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt)
+	suite.Require().NoError(err)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	balance := suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address)
+	feeCollectorBalance := suite.app.EvmKeeper.GetBalance(suite.ctx, feeCollector)
+
+	txs := make([]*types.MsgEthereumTx, 2)
+	for i := range txs {
+		tx := types.NewTx(&types.EvmTxArgs{
+			ChainID:  chainID,
+			Nonce:    nonce + uint64(i),
+			To:       &recipient,
+			Amount:   big.NewInt(1000),
+			GasLimit: ethparams.TxGas,
+			GasPrice: big.NewInt(1),
+		})
+		tx.From = suite.address.Hex()
+		err := tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
+		suite.Require().NoError(err)
+		txs[i] = tx
+	}
+
+	res, err := suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+		Txs:         txs,
+		TraceConfig: &types.TraceConfig{Tracer: types.TracerStateDiff},
+		ChainId:     chainID.Int64(),
+	})
+	suite.Require().NoError(err)
+
+	var results []struct {
+		Result types.StateDiff `json:"result"`
+		Error  string          `json:"error"`
+	}
+	suite.Require().NoError(json.Unmarshal(res.Data, &results))
+	suite.Require().Len(results, 2)
+
+	fee := big.NewInt(int64(ethparams.TxGas))
+	for i, result := range results {
+		suite.Require().Empty(result.Error)
+		diff := result.Result
+
+		// each transaction starts from the state left by the previous one
+		suite.Require().Equal(nonce+uint64(i), diff.Pre[suite.address].Nonce)
+		suite.Require().Equal(nonce+uint64(i)+1, diff.Post[suite.address].Nonce)
+		suite.Require().Equal(balance.String(), diff.Pre[suite.address].Balance.ToInt().String())
+		balance = new(big.Int).Sub(balance, big.NewInt(1000))
+		balance.Sub(balance, fee)
+		suite.Require().Equal(balance.String(), diff.Post[suite.address].Balance.ToInt().String())
+
+		// the recipient is created by the first transaction
+		_, found := diff.Pre[recipient]
+		suite.Require().Equal(i > 0, found)
+		suite.Require().Equal(big.NewInt(int64(1000*(i+1))).String(), diff.Post[recipient].Balance.ToInt().String())
+
+		// the fees are sent to the fee collector
+		suite.Require().Equal(feeCollectorBalance.String(), diff.Pre[feeCollector].Balance.ToInt().String())
+		feeCollectorBalance = new(big.Int).Add(feeCollectorBalance, fee)
+		suite.Require().Equal(feeCollectorBalance.String(), diff.Post[feeCollector].Balance.ToInt().String())
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1368,6 +1444,480 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	var req *types.EthCallRequest
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+
+	// returns the value of the storage slot 0
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	// returns the block number
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	slotValue := common.BigToHash(big.NewInt(42))
+	value := (*hexutil.Big)(big.NewInt(1000))
+
+	callArgs := func(value *hexutil.Big) []byte {
+		args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract, Value: value})
+		suite.Require().NoError(err)
+		return args
+	}
+	marshal := func(v interface{}) []byte {
+		bz, err := json.Marshal(v)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expRet   []byte
+		expPass  bool
+	}{
+		{
+			"fail - invalid state overrides",
+			func() {
+				req = &types.EthCallRequest{Args: callArgs(nil), GasCap: config.DefaultGasCap, Overrides: []byte("invalid")}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - both state and stateDiff overrides",
+			func() {
+				state := map[common.Hash]common.Hash{{}: slotValue}
+				overrides := types.StateOverride{contract: {State: &state, StateDiff: &state}}
+				req = &types.EthCallRequest{Args: callArgs(nil), GasCap: config.DefaultGasCap, Overrides: marshal(overrides)}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - insufficient balance without balance override",
+			func() {
+				req = &types.EthCallRequest{Args: callArgs(value), GasCap: config.DefaultGasCap}
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - balance override",
+			func() {
+				balance := value
+				overrides := types.StateOverride{from: {Balance: &balance}}
+				req = &types.EthCallRequest{Args: callArgs(value), GasCap: config.DefaultGasCap, Overrides: marshal(overrides)}
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - code and storage overrides",
+			func() {
+				state := map[common.Hash]common.Hash{{}: slotValue}
+				overrides := types.StateOverride{contract: {Code: &sloadCode, StateDiff: &state}}
+				req = &types.EthCallRequest{Args: callArgs(nil), GasCap: config.DefaultGasCap, Overrides: marshal(overrides)}
+			},
+			slotValue.Bytes(),
+			true,
+		},
+		{
+			"pass - block number override",
+			func() {
+				overrides := types.StateOverride{contract: {Code: &numberCode}}
+				blockOverrides := types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}
+				req = &types.EthCallRequest{
+					Args:           callArgs(nil),
+					GasCap:         config.DefaultGasCap,
+					Overrides:      marshal(overrides),
+					BlockOverrides: marshal(blockOverrides),
+				}
+			},
+			common.BigToHash(big.NewInt(1000)).Bytes(),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				if err == nil {
+					suite.Require().True(res.Failed())
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().False(res.Failed(), res.VmError)
+			if tc.expRet != nil {
+				suite.Require().Equal(tc.expRet, res.Ret)
+			}
+
+			// the overrides must not be persisted
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(sloadCode)))
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, from).Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	code := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &contract})
+	suite.Require().NoError(err)
+	overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		traceConfig *types.TraceConfig
+		expPass     bool
+	}{
+		{
+			"fail - negative limit",
+			&types.TraceConfig{Limit: -1},
+			false,
+		},
+		{
+			"pass - default tracer",
+			nil,
+			true,
+		},
+		{
+			"pass - call tracer",
+			&types.TraceConfig{Tracer: types.TracerCall},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, &types.QueryTraceCallRequest{
+				Call:        &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides},
+				TraceConfig: tc.traceConfig,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			if tc.traceConfig == nil {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(res.Data, &result))
+				suite.Require().False(result.Failed)
+				// the overridden code is executed
+				suite.Require().NotEmpty(result.StructLogs)
+				return
+			}
+
+			var frame types.CallFrame
+			suite.Require().NoError(json.Unmarshal(res.Data, &frame))
+			suite.Require().Equal(strings.ToLower(contract.Hex()), frame.To)
+			suite.Require().Empty(frame.Error)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthSimulate() {
+	var req *types.EthSimulateRequest
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	withdrawer := utiltx.GenerateAddress()
+
+	// increments the storage slot 0, emits an empty log and returns the new value
+	counterCode := hexutil.Bytes(common.FromHex("0x6000546001018060005560006000a060005260206000f3"))
+
+	distributionPrecompile, err := distributionprecompile.NewPrecompile(suite.app.DistrKeeper, suite.app.StakingKeeper, suite.app.AuthzKeeper)
+	suite.Require().NoError(err)
+	distributionAddr := distributionPrecompile.Address()
+	distributionABI := distributionPrecompile.ABI
+
+	marshal := func(v interface{}) []byte {
+		bz, err := json.Marshal(v)
+		suite.Require().NoError(err)
+		return bz
+	}
+	call := func(to common.Address, input []byte) []byte {
+		data := hexutil.Bytes(input)
+		return marshal(&types.TransactionArgs{From: &from, To: &to, Data: &data})
+	}
+	pack := func(method string, args ...interface{}) []byte {
+		input, err := distributionABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		return input
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		check    func(res *types.EthSimulateResponse)
+		expPass  bool
+	}{
+		{
+			"fail - no blocks",
+			func() {
+				req = &types.EthSimulateRequest{GasCap: config.DefaultGasCap}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid call args",
+			func() {
+				req = &types.EthSimulateRequest{
+					Blocks: []types.EthSimulateBlock{{Calls: [][]byte{[]byte("invalid")}}},
+					GasCap: config.DefaultGasCap,
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - calls exceed the gas cap",
+			func() {
+				overrides := types.StateOverride{contract: {Code: &counterCode}}
+				req = &types.EthSimulateRequest{
+					Blocks: []types.EthSimulateBlock{
+						{Calls: [][]byte{call(contract, nil), call(contract, nil)}, Overrides: marshal(overrides)},
+					},
+					GasCap: 50000,
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - calls are executed on top of the previous ones",
+			func() {
+				overrides := types.StateOverride{contract: {Code: &counterCode}}
+				req = &types.EthSimulateRequest{
+					Blocks: []types.EthSimulateBlock{
+						{Calls: [][]byte{call(contract, nil), call(contract, nil)}, Overrides: marshal(overrides)},
+						{Calls: [][]byte{call(contract, nil)}},
+					},
+					GasCap: config.DefaultGasCap,
+				}
+			},
+			func(res *types.EthSimulateResponse) {
+				suite.Require().Len(res.Blocks, 2)
+				suite.Require().Len(res.Blocks[0].Calls, 2)
+				suite.Require().Len(res.Blocks[1].Calls, 1)
+
+				expCalls := []struct {
+					res      *types.MsgEthereumTxResponse
+					value    int64
+					logIndex uint64
+				}{
+					{res.Blocks[0].Calls[0], 1, 0},
+					{res.Blocks[0].Calls[1], 2, 1},
+					{res.Blocks[1].Calls[0], 3, 0},
+				}
+				for _, exp := range expCalls {
+					suite.Require().False(exp.res.Failed(), exp.res.VmError)
+					suite.Require().Equal(common.BigToHash(big.NewInt(exp.value)).Bytes(), exp.res.Ret)
+					suite.Require().NotZero(exp.res.GasUsed)
+					suite.Require().Len(exp.res.Logs, 1)
+					suite.Require().Equal(exp.logIndex, exp.res.Logs[0].Index)
+				}
+			},
+			true,
+		},
+		{
+			"pass - stateful precompile calls",
+			func() {
+				req = &types.EthSimulateRequest{
+					Blocks: []types.EthSimulateBlock{
+						{Calls: [][]byte{call(distributionAddr, pack(distributionprecompile.SetWithdrawAddressMethod, from, withdrawer.Hex()))}},
+						{Calls: [][]byte{call(distributionAddr, pack(distributionprecompile.DelegatorWithdrawAddressMethod, from))}},
+					},
+					GasCap: config.DefaultGasCap,
+				}
+			},
+			func(res *types.EthSimulateResponse) {
+				suite.Require().Len(res.Blocks, 2)
+				suite.Require().False(res.Blocks[0].Calls[0].Failed(), res.Blocks[0].Calls[0].VmError)
+				suite.Require().False(res.Blocks[1].Calls[0].Failed(), res.Blocks[1].Calls[0].VmError)
+
+				out, err := distributionABI.Unpack(distributionprecompile.DelegatorWithdrawAddressMethod, res.Blocks[1].Calls[0].Ret)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.AccAddress(withdrawer.Bytes()).String(), out[0])
+
+				// the withdraw address set by the precompile must not be persisted
+				suite.Require().Equal(
+					sdk.AccAddress(from.Bytes()),
+					suite.app.DistrKeeper.GetDelegatorWithdrawAddr(suite.ctx, from.Bytes()),
+				)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.EthSimulate(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.check(res)
+
+			// the simulated state changes must not be persisted
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, from).Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPendingTxs() {
+	var pendingTxs [][]byte
+
+	recipient := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	chainID := suite.app.EvmKeeper.ChainID()
+	gasPrice := big.NewInt(1)
+
+	buildTxWithGas := func(nonce uint64, amount int64, gasLimit uint64) []byte {
+		tx := types.NewTx(&types.EvmTxArgs{
+			ChainID:  chainID,
+			Nonce:    nonce,
+			To:       &recipient,
+			Amount:   big.NewInt(amount),
+			GasLimit: gasLimit,
+			GasPrice: gasPrice,
+		})
+		tx.From = suite.address.Hex()
+		err := tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
+		suite.Require().NoError(err)
+		bz, err := tx.AsTransaction().MarshalBinary()
+		suite.Require().NoError(err)
+		return bz
+	}
+	buildTx := func(nonce uint64, amount int64) []byte {
+		return buildTxWithGas(nonce, amount, ethparams.TxGas)
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func(nonce uint64)
+		expPass      bool
+		expBalance   string
+		expNonceDiff uint64
+	}{
+		{
+			"fail - invalid pending transaction",
+			func(uint64) {
+				pendingTxs = [][]byte{[]byte("invalid")}
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"pass - no pending transactions",
+			func(uint64) {
+				pendingTxs = nil
+			},
+			true,
+			"0",
+			0,
+		},
+		{
+			"pass - pending transactions are replayed in order",
+			func(nonce uint64) {
+				pendingTxs = [][]byte{buildTx(nonce, 1000), buildTx(nonce+1, 2000)}
+			},
+			true,
+			"3000",
+			2,
+		},
+		{
+			"pass - pending transactions with invalid nonce are skipped",
+			func(nonce uint64) {
+				pendingTxs = [][]byte{buildTx(nonce, 1000), buildTx(nonce+5, 2000)}
+			},
+			true,
+			"1000",
+			1,
+		},
+		{
+			"pass - pending transactions above the gas limit are dropped",
+			func(nonce uint64) {
+				pendingTxs = [][]byte{
+					buildTx(nonce, 1000),
+					buildTxWithGas(nonce+1, 2000, 25_000_000),
+					buildTx(nonce+2, 4000),
+				}
+			},
+			true,
+			"1000",
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			amt := sdk.Coins{sdk.NewInt64Coin(suite.EvmDenom(), 1e18)}
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt)
+			suite.Require().NoError(err)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			tc.malleate(nonce)
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.Account(ctx, &types.QueryAccountRequest{
+				Address:    recipient.String(),
+				PendingTxs: pendingTxs,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, res.Balance)
+
+			res, err = suite.queryClient.Account(ctx, &types.QueryAccountRequest{
+				Address:    suite.address.String(),
+				PendingTxs: pendingTxs,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(nonce+tc.expNonceDiff, res.Nonce)
+
+			// the recipient can spend the pending transfers in a call
+			value := (*hexutil.Big)(big.NewInt(1000))
+			args, err := json.Marshal(&types.TransactionArgs{From: &recipient, To: &other, Value: value})
+			suite.Require().NoError(err)
+			callRes, err := suite.queryClient.EthCall(ctx, &types.EthCallRequest{
+				Args:            args,
+				GasCap:          config.DefaultGasCap,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+				PendingTxs:      pendingTxs,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expNonceDiff == 0, callRes.Failed())
+
+			// the pending transactions are not persisted
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, recipient).Sign())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1435,6 +1985,18 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
+		{
+			"EthSimulate method",
+			func() (interface{}, error) {
+				return k.EthSimulate(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
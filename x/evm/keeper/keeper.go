@@ -52,7 +52,7 @@ type Keeper struct {
 	eip155ChainID *big.Int
 
 	// Tracer used to collect execution traces from the EVM transaction execution
-	tracer string
+	tracer types.TracerConfig
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
@@ -74,7 +74,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
-	tracer string,
+	tracer types.TracerConfig,
 	ss paramstypes.Subspace,
 ) *Keeper {
 	// ensure evm module account is set
@@ -259,9 +259,18 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *et
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
 
-// Tracer return a default vm.Tracer based on current keeper state
+// Tracer return a default vm.Tracer based on current keeper state. When the
+// traces are stored on a sink, only the DeliverTx executions are traced, so
+// the simulations (e.g. eth_estimateGas) and the queries don't reach the sink.
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
-	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
+	// the simulations and the queries run on a CheckTx context
+	if k.tracer.Sink != nil && ctx.IsCheckTx() {
+		return types.NewNoOpTracer()
+	}
+
+	tracerCfg := k.tracer
+	tracerCfg.Logger = k.Logger(ctx)
+	return types.NewTracer(tracerCfg, msg, ethCfg, ctx.BlockHeight())
 }
 
 // GetAccountWithoutBalance load nonce and codehash without balance,
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	evmostypes "github.com/evmos/evmos/v15/types"
 	"github.com/evmos/evmos/v15/x/evm/keeper"
@@ -12,6 +13,7 @@ import (
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 )
@@ -68,6 +70,42 @@ func (suite *KeeperTestSuite) TestWithChainID() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTracerSink() {
+	sink, err := evmtypes.NewFileTraceSink(suite.T().TempDir())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		sink      evmtypes.TraceSink
+		checkTx   bool
+		expTraced bool
+	}{
+		{"no sink - DeliverTx", nil, false, true},
+		{"no sink - CheckTx", nil, true, true},
+		{"sink - DeliverTx", sink, false, true},
+		{"sink - CheckTx, simulation or query", sink, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tracerCfg := evmtypes.TracerConfig{Tracer: evmtypes.TracerStruct, Sink: tc.sink}
+			k := keeper.NewKeeper(
+				suite.app.AppCodec(), suite.app.GetKey(evmtypes.StoreKey), suite.app.GetTKey(evmtypes.TransientKey),
+				authtypes.NewModuleAddress(govtypes.ModuleName), suite.app.AccountKeeper, suite.app.BankKeeper,
+				suite.app.StakingKeeper, suite.app.FeeMarketKeeper, tracerCfg, suite.app.GetSubspace(evmtypes.ModuleName),
+			)
+
+			to := common.Address{}
+			msg := ethtypes.NewMessage(suite.address, &to, 0, big.NewInt(0), 21000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx)
+
+			tracer := k.Tracer(ctx, msg, evmtypes.DefaultChainConfig().EthereumConfig(suite.app.EvmKeeper.ChainID()))
+			_, isNoOp := tracer.(*evmtypes.NoOpTracer)
+			suite.Require().Equal(!tc.expTraced, isNoOp)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestBaseFee() {
 	testCases := []struct {
 		name            string
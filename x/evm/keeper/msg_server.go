@@ -17,6 +17,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v15/x/evm/types"
 )
@@ -140,9 +141,45 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the whitelisted fee tokens of the fee market are swapped into the EVM denomination
+	if err := k.feeMarketKeeper.GetParams(ctx).ValidateFeeTokens(req.Params.EvmDenom); err != nil {
+		return nil, err
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetSponsorPolicy implements the gRPC MsgServer interface. It creates or updates the policy under
+// which the sponsor pays for the fees of the Ethereum transactions sent to a contract.
+func (k *Keeper) SetSponsorPolicy(goCtx context.Context, req *types.MsgSetSponsorPolicy) (*types.MsgSetSponsorPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.StoreSponsorPolicy(ctx, req.Policy()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSponsorPolicyResponse{}, nil
+}
+
+// RemoveSponsorPolicy implements the gRPC MsgServer interface. It removes the policy of the sponsor
+// for a contract.
+func (k *Keeper) RemoveSponsorPolicy(goCtx context.Context, req *types.MsgRemoveSponsorPolicy) (*types.MsgRemoveSponsorPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := common.HexToAddress(req.Contract)
+	if _, found := k.GetSponsorPolicy(ctx, contract, sponsor); !found {
+		return nil, errorsmod.Wrapf(types.ErrSponsorPolicyNotFound, "sponsor %s, contract %s", req.Sponsor, req.Contract)
+	}
+
+	k.DeleteSponsorPolicy(ctx, contract, sponsor)
+	return &types.MsgRemoveSponsorPolicyResponse{}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
 	"github.com/evmos/evmos/v15/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestEthereumTx() {
@@ -95,8 +97,24 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - EVM denom is a fee token",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.EvmDenom = "uusdc"
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.FeeTokens = []feemarkettypes.FeeToken{feemarkettypes.NewFeeToken("uusdc", sdk.NewDec(1))}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run("MsgUpdateParams", func() {
@@ -0,0 +1,191 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// prepareEthCall parses the eth_call request and returns the context, the EVM
+// configuration and the message to execute. The pending transactions and the
+// state and block overrides of the request are applied on a branch of the given
+// context, so they are discarded once the query finishes.
+func (k Keeper) prepareEthCall(ctx sdk.Context, req *types.EthCallRequest) (sdk.Context, *statedb.EVMConfig, core.Message, error) {
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return ctx, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Overrides) > 0 || len(req.PendingTxs) > 0 {
+		ctx, _ = ctx.CacheContext()
+	}
+
+	ctx, cfg, err := k.prepareCallEnv(ctx, GetProposerAddress(ctx, req.ProposerAddress), req.ChainId, req.PendingTxs, req.Overrides, req.BlockOverrides)
+	if err != nil {
+		return ctx, nil, nil, err
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return ctx, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return ctx, cfg, msg, nil
+}
+
+// prepareCallEnv returns the context and the EVM configuration to execute calls
+// with, after replaying the given pending transactions and applying the given
+// JSON encoded state and block overrides. The pending transactions and the state
+// overrides are written to the given context, so the caller is expected to
+// branch it beforehand.
+func (k Keeper) prepareCallEnv(
+	ctx sdk.Context,
+	proposerAddress sdk.ConsAddress,
+	chainID int64,
+	pendingTxs [][]byte,
+	overrides, blockOverrides []byte,
+) (sdk.Context, *statedb.EVMConfig, error) {
+	var blockOverride *types.BlockOverrides
+	if len(blockOverrides) > 0 {
+		if err := json.Unmarshal(blockOverrides, &blockOverride); err != nil {
+			return ctx, nil, status.Errorf(codes.InvalidArgument, "invalid block overrides: %s", err.Error())
+		}
+		if err := blockOverride.Validate(); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var stateOverrides types.StateOverride
+	if len(overrides) > 0 {
+		if err := json.Unmarshal(overrides, &stateOverrides); err != nil {
+			return ctx, nil, status.Errorf(codes.InvalidArgument, "invalid state overrides: %s", err.Error())
+		}
+		if err := stateOverrides.Validate(); err != nil {
+			return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ethChainID, err := getChainID(ctx, chainID)
+	if err != nil {
+		return ctx, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, proposerAddress, ethChainID)
+	if err != nil {
+		return ctx, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the pending transactions are replayed with the actual block context,
+	// before the block overrides are applied
+	if len(pendingTxs) > 0 {
+		if err := k.applyPendingTxs(ctx, cfg, pendingTxs); err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	if blockOverride != nil {
+		ctx = applyBlockOverrides(ctx, cfg, blockOverride)
+	}
+
+	if len(stateOverrides) > 0 {
+		if err := k.applyStateOverrides(ctx, stateOverrides); err != nil {
+			return ctx, nil, status.Errorf(codes.Internal, "failed to apply state overrides: %s", err.Error())
+		}
+	}
+
+	return ctx, cfg, nil
+}
+
+// applyStateOverrides writes the account overrides to the state of the given context.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance := (*account.Balance).ToInt()
+			delta := new(big.Int).Sub(balance, stateDB.GetBalance(addr))
+			switch delta.Sign() {
+			case 1:
+				stateDB.AddBalance(addr, delta)
+			case -1:
+				stateDB.SubBalance(addr, new(big.Int).Neg(delta))
+			}
+		}
+		if account.State != nil {
+			// the state override replaces the whole account storage
+			var keys []common.Hash
+			if err := stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			}); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.Commit()
+}
+
+// applyBlockOverrides returns the context with the header fields of the block
+// overrides, and sets the coinbase and base fee overrides on the EVM configuration.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC())
+	}
+	if overrides.GasLimit != nil {
+		params := tmproto.ConsensusParams{}
+		if ctx.ConsensusParams() != nil {
+			params = *ctx.ConsensusParams()
+		}
+		blockParams := tmproto.BlockParams{}
+		if params.Block != nil {
+			blockParams = *params.Block
+		}
+		blockParams.MaxGas = int64(*overrides.GasLimit)
+		params.Block = &blockParams
+		ctx = ctx.WithConsensusParams(&params)
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+	return ctx
+}
@@ -0,0 +1,124 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+const (
+	// maxPendingTxs is the maximum number of pending transactions replayed by a
+	// single query.
+	maxPendingTxs = 1000
+	// maxPendingTxsGas is the maximum total gas limit of the pending
+	// transactions replayed by a single query.
+	maxPendingTxsGas = 25_000_000
+)
+
+// applyPendingTxs replays the given binary encoded ethereum transactions on the
+// state of the given context, so that queries observe the effects of the
+// transactions that are still in the mempool. The changes are written to the
+// given context, so the caller is expected to branch it beforehand.
+//
+// Each transaction goes through the same steps as in the ante handler and the
+// message server: the fees are deducted, the nonce is incremented, the message
+// is applied and the leftover gas is refunded. Transactions that can't be
+// applied on top of the current state (e.g. because of an invalid nonce or
+// insufficient funds) are skipped. The queries that accept pending transactions
+// are reachable without going through the JSON-RPC gas cap, so the replay stops
+// once maxPendingTxs transactions or maxPendingTxsGas gas have been replayed and
+// the remaining transactions are dropped.
+func (k *Keeper) applyPendingTxs(ctx sdk.Context, cfg *statedb.EVMConfig, txs [][]byte) error {
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	blockHash := common.BytesToHash(ctx.HeaderHash())
+
+	if len(txs) > maxPendingTxs {
+		k.Logger(ctx).Debug("dropping pending transactions above the limit", "count", len(txs)-maxPendingTxs)
+		txs = txs[:maxPendingTxs]
+	}
+
+	var totalGas uint64
+	for i, bz := range txs {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(bz); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid pending transaction %d: %s", i, err.Error())
+		}
+
+		if totalGas+tx.Gas() > maxPendingTxsGas {
+			k.Logger(ctx).Debug("dropping pending transactions above the gas limit", "count", len(txs)-i)
+			break
+		}
+		totalGas += tx.Gas()
+
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			k.Logger(ctx).Debug("skipping pending transaction", "hash", tx.Hash().Hex(), "error", err.Error())
+			continue
+		}
+
+		txConfig := statedb.NewTxConfig(blockHash, tx.Hash(), 0, 0)
+
+		// apply each transaction on its own branch, so a failure doesn't leave
+		// partial changes behind
+		txCtx, write := ctx.CacheContext()
+		if err := k.applyPendingTx(txCtx, cfg, msg, txConfig); err != nil {
+			k.Logger(ctx).Debug("skipping pending transaction", "hash", tx.Hash().Hex(), "error", err.Error())
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// applyPendingTx applies a single pending message on the given context.
+func (k *Keeper) applyPendingTx(ctx sdk.Context, cfg *statedb.EVMConfig, msg core.Message, txConfig statedb.TxConfig) error {
+	from := msg.From()
+	denom := cfg.Params.EvmDenom
+
+	acc := k.accountKeeper.GetAccount(ctx, from.Bytes())
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s is nil", from)
+	}
+	if nonce := acc.GetSequence(); msg.Nonce() != nonce {
+		return errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce; got %d, expected %d", msg.Nonce(), nonce)
+	}
+
+	// the transaction can't be included while its fee cap is below the base fee
+	if cfg.BaseFee != nil && msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas fee cap %s is lower than the base fee %s", msg.GasFeeCap(), cfg.BaseFee)
+	}
+
+	// the gas price of the message is the effective gas price at the current base fee
+	fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+	if fee.Sign() > 0 {
+		fees := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(fee))}
+		if err := k.DeductTxCostsFromUserBalance(ctx, fees, from); err != nil {
+			return err
+		}
+	}
+
+	if err := acc.SetSequence(msg.Nonce() + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return err
+	}
+
+	return k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, denom)
+}
@@ -15,16 +15,28 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	connectionkeeper "github.com/cosmos/ibc-go/v7/modules/core/03-connection/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	authzprecompile "github.com/evmos/evmos/v15/precompiles/authz"
+	bankprecompile "github.com/evmos/evmos/v15/precompiles/bank"
 	distprecompile "github.com/evmos/evmos/v15/precompiles/distribution"
+	govprecompile "github.com/evmos/evmos/v15/precompiles/gov"
+	ibcprecompile "github.com/evmos/evmos/v15/precompiles/ibc"
 	ics20precompile "github.com/evmos/evmos/v15/precompiles/ics20"
+	multicallprecompile "github.com/evmos/evmos/v15/precompiles/multicall"
+	osmosisoutpost "github.com/evmos/evmos/v15/precompiles/outposts/osmosis"
 	strideoutpost "github.com/evmos/evmos/v15/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v15/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v15/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v15/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v15/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
@@ -37,11 +49,17 @@ import (
 func AvailablePrecompiles(
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
 	erc20Keeper erc20Keeper.Keeper,
 	vestingKeeper vestingkeeper.Keeper,
+	govKeeper govkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	connectionKeeper connectionkeeper.Keeper,
+	clientKeeper clientkeeper.Keeper,
+	cdc codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -74,18 +92,60 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load vesting precompile: %w", err))
 	}
 
-	strideOutpost, err := strideoutpost.NewPrecompile(transfertypes.PortID, "channel-25", transferKeeper, erc20Keeper, authzKeeper, stakingKeeper)
+	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load bank precompile: %w", err))
+	}
+
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to load gov precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to load authz precompile: %w", err))
+	}
+
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load slashing precompile: %w", err))
+	}
+
+	ibcPrecompile, err := ibcprecompile.NewPrecompile(channelKeeper, connectionKeeper, clientKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load IBC precompile: %w", err))
+	}
+
+	multicallPrecompile, err := multicallprecompile.NewPrecompile(authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load multicall precompile: %w", err))
+	}
+
+	strideOutpost, err := strideoutpost.NewPrecompile(transferKeeper, erc20Keeper, authzKeeper, stakingKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to load stride outpost: %w", err))
 	}
 
+	osmosisOutpost, err := osmosisoutpost.NewPrecompile(bankKeeper, transferKeeper, stakingKeeper, erc20Keeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load osmosis outpost: %w", err))
+	}
+
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
+	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[multicallPrecompile.Address()] = multicallPrecompile
+	precompiles[ibcPrecompile.Address()] = ibcPrecompile
 	precompiles[strideOutpost.Address()] = strideOutpost
+	precompiles[osmosisOutpost.Address()] = osmosisOutpost
 	return precompiles
 }
 
@@ -0,0 +1,193 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// GetSponsorPolicy returns the policy of the sponsor for the given contract.
+func (k Keeper) GetSponsorPolicy(ctx sdk.Context, contract common.Address, sponsor sdk.AccAddress) (types.SponsorPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SponsorPolicyKey(contract, sponsor))
+	if len(bz) == 0 {
+		return types.SponsorPolicy{}, false
+	}
+
+	var policy types.SponsorPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetSponsorPolicies returns all the sponsor policies registered for the given contract.
+func (k Keeper) GetSponsorPolicies(ctx sdk.Context, contract common.Address) []types.SponsorPolicy {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixSponsorPolicy, contract.Bytes()...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var policies []types.SponsorPolicy
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.SponsorPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// StoreSponsorPolicy validates and stores the given sponsor policy. The fees already paid by the
+// sponsor for the users of the contract are kept when the policy is updated.
+func (k Keeper) StoreSponsorPolicy(ctx sdk.Context, policy types.SponsorPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(types.SponsorPolicyKey(policy.ContractAddress(), policy.SponsorAddress()), bz)
+	return nil
+}
+
+// DeleteSponsorPolicy removes the policy of the sponsor for the given contract together with the
+// fees paid by the sponsor for the users of the contract.
+func (k Keeper) DeleteSponsorPolicy(ctx sdk.Context, contract common.Address, sponsor sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SponsorPolicyKey(contract, sponsor))
+
+	spentStore := prefix.NewStore(store, types.SponsorSpentPrefix(contract, sponsor))
+	iterator := spentStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		spentStore.Delete(key)
+	}
+}
+
+// GetSponsorSpent returns the amount of fees, in the EVM denomination, paid by the sponsor for the
+// user of the given contract.
+func (k Keeper) GetSponsorSpent(ctx sdk.Context, contract common.Address, sponsor, user sdk.AccAddress) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SponsorSpentKey(contract, sponsor, user))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	var spent sdkmath.Int
+	if err := spent.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return spent
+}
+
+// setSponsorSpent stores the amount of fees paid by the sponsor for the user of the given contract.
+func (k Keeper) setSponsorSpent(ctx sdk.Context, contract common.Address, sponsor, user sdk.AccAddress, spent sdkmath.Int) {
+	bz, err := spent.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SponsorSpentKey(contract, sponsor, user), bz)
+}
+
+// GetFeeSponsor returns the policy of the first sponsor that pays for the fees of a transaction
+// sent by the user to the given contract. A sponsor pays for the fees if its policy allows the
+// called method, if the remaining budget for the user covers the fees and if its balance is
+// sufficient.
+func (k *Keeper) GetFeeSponsor(ctx sdk.Context, user sdk.AccAddress, to *common.Address, input []byte, fees sdkmath.Int) (types.SponsorPolicy, bool) {
+	// contract creations cannot be sponsored
+	if to == nil || !fees.IsPositive() {
+		return types.SponsorPolicy{}, false
+	}
+
+	for _, policy := range k.GetSponsorPolicies(ctx, *to) {
+		if !policy.IsMethodAllowed(input) {
+			continue
+		}
+
+		sponsor := policy.SponsorAddress()
+		spent := k.GetSponsorSpent(ctx, *to, sponsor, user)
+		if spent.Add(fees).GT(policy.BudgetPerUser) {
+			continue
+		}
+
+		if k.GetBalance(ctx, common.BytesToAddress(sponsor)).Cmp(fees.BigInt()) < 0 {
+			continue
+		}
+
+		return policy, true
+	}
+
+	return types.SponsorPolicy{}, false
+}
+
+// UseSponsorBudget charges the fees paid by the sponsor against the budget of the user. It returns
+// an error if the remaining budget doesn't cover the fees.
+func (k Keeper) UseSponsorBudget(ctx sdk.Context, policy types.SponsorPolicy, user sdk.AccAddress, fees sdkmath.Int) error {
+	contract := policy.ContractAddress()
+	sponsor := policy.SponsorAddress()
+
+	spent := k.GetSponsorSpent(ctx, contract, sponsor, user).Add(fees)
+	if spent.GT(policy.BudgetPerUser) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidSponsorPolicy,
+			"fees exceed the remaining sponsor budget for user %s (%s > %s)", user, spent, policy.BudgetPerUser,
+		)
+	}
+
+	k.setSponsorSpent(ctx, contract, sponsor, user, spent)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorFee,
+			sdk.NewAttribute(types.AttributeKeySponsor, policy.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, policy.Contract),
+			sdk.NewAttribute(types.AttributeKeyUser, user.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+		),
+	)
+	return nil
+}
+
+// RefundSponsorBudget credits the fees refunded to the sponsor for the leftover gas of a transaction
+// back to the budget of the user, so that the budget is only charged with the fees of the gas used.
+func (k Keeper) RefundSponsorBudget(ctx sdk.Context, contract common.Address, sponsor, user sdk.AccAddress, refund sdkmath.Int) {
+	// the fees paid for the user are removed together with the policy
+	if _, found := k.GetSponsorPolicy(ctx, contract, sponsor); !found {
+		return
+	}
+
+	spent := sdkmath.MaxInt(k.GetSponsorSpent(ctx, contract, sponsor, user).Sub(refund), sdkmath.ZeroInt())
+	k.setSponsorSpent(ctx, contract, sponsor, user, spent)
+}
+
+// SetFeePayerTransient sets the account paying for the fees of the Ethereum transaction
+// identified by the sender and nonce when it differs from the sender. This value is reset on
+// every block.
+func (k Keeper) SetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64, feePayer sdk.AccAddress) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientFeePayerKey(sender, nonce), feePayer.Bytes())
+}
+
+// GetFeePayerTransient returns the account paying for the fees of the Ethereum transaction
+// identified by the sender and nonce. It defaults to the sender.
+func (k Keeper) GetFeePayerTransient(ctx sdk.Context, sender common.Address, nonce uint64) sdk.AccAddress {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TransientFeePayerKey(sender, nonce))
+	if len(bz) == 0 {
+		return sender.Bytes()
+	}
+
+	return bz
+}
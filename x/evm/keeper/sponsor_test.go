@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v15/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+var transferSelector = []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+func (suite *KeeperTestSuite) TestSetAndRemoveSponsorPolicy() {
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name      string
+		malleate  func() error
+		expPolicy bool
+		expErr    bool
+	}{
+		{
+			"fail - invalid method selector",
+			func() error {
+				msg := types.NewMsgSetSponsorPolicy(sponsor, contract, []string{"0x01"}, sdkmath.NewInt(100))
+				_, err := suite.app.EvmKeeper.SetSponsorPolicy(suite.ctx, msg)
+				return err
+			},
+			false,
+			true,
+		},
+		{
+			"fail - zero budget per user",
+			func() error {
+				msg := types.NewMsgSetSponsorPolicy(sponsor, contract, nil, sdkmath.ZeroInt())
+				_, err := suite.app.EvmKeeper.SetSponsorPolicy(suite.ctx, msg)
+				return err
+			},
+			false,
+			true,
+		},
+		{
+			"fail - remove a policy not found",
+			func() error {
+				msg := types.NewMsgRemoveSponsorPolicy(sponsor, contract)
+				_, err := suite.app.EvmKeeper.RemoveSponsorPolicy(suite.ctx, msg)
+				return err
+			},
+			false,
+			true,
+		},
+		{
+			"pass - set policy",
+			func() error {
+				msg := types.NewMsgSetSponsorPolicy(sponsor, contract, []string{"0xa9059cbb"}, sdkmath.NewInt(100))
+				_, err := suite.app.EvmKeeper.SetSponsorPolicy(suite.ctx, msg)
+				return err
+			},
+			true,
+			false,
+		},
+		{
+			"pass - remove policy",
+			func() error {
+				msg := types.NewMsgSetSponsorPolicy(sponsor, contract, nil, sdkmath.NewInt(100))
+				if _, err := suite.app.EvmKeeper.SetSponsorPolicy(suite.ctx, msg); err != nil {
+					return err
+				}
+				_, err := suite.app.EvmKeeper.RemoveSponsorPolicy(suite.ctx, types.NewMsgRemoveSponsorPolicy(sponsor, contract))
+				return err
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := tc.malleate()
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			_, found := suite.app.EvmKeeper.GetSponsorPolicy(suite.ctx, contract, sponsor)
+			suite.Require().Equal(tc.expPolicy, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetFeeSponsor() {
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	user := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	fees := sdkmath.NewInt(60)
+
+	testCases := []struct {
+		name     string
+		to       *common.Address
+		input    []byte
+		malleate func()
+		expFound bool
+	}{
+		{
+			"not sponsored - contract creation",
+			nil,
+			transferSelector,
+			nil,
+			false,
+		},
+		{
+			"not sponsored - method not allowed",
+			&contract,
+			[]byte{0x01, 0x02, 0x03, 0x04},
+			nil,
+			false,
+		},
+		{
+			"not sponsored - budget exceeded",
+			&contract,
+			transferSelector,
+			func() {
+				policy, _ := suite.app.EvmKeeper.GetSponsorPolicy(suite.ctx, contract, sponsor)
+				suite.Require().NoError(suite.app.EvmKeeper.UseSponsorBudget(suite.ctx, policy, user, fees))
+			},
+			false,
+		},
+		{
+			"not sponsored - insufficient sponsor balance",
+			&contract,
+			transferSelector,
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sponsor, suite.denom)
+				err := suite.app.BankKeeper.SendCoins(suite.ctx, sponsor, user, sdk.NewCoins(balance))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"sponsored",
+			&contract,
+			append(transferSelector, make([]byte, 64)...),
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, sdk.NewCoins(sdk.NewCoin(suite.denom, sdkmath.NewInt(1000))))
+			suite.Require().NoError(err)
+
+			policy := types.NewSponsorPolicy(sponsor, contract, []string{"0xa9059cbb"}, sdkmath.NewInt(100))
+			suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(suite.ctx, policy))
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			res, found := suite.app.EvmKeeper.GetFeeSponsor(suite.ctx, user, tc.to, tc.input, fees)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(policy, res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUseSponsorBudget() {
+	suite.SetupTest()
+
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	user := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	policy := types.NewSponsorPolicy(sponsor, contract, nil, sdkmath.NewInt(100))
+	suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(suite.ctx, policy))
+
+	suite.Require().NoError(suite.app.EvmKeeper.UseSponsorBudget(suite.ctx, policy, user, sdkmath.NewInt(70)))
+	suite.Require().Equal(sdkmath.NewInt(70), suite.app.EvmKeeper.GetSponsorSpent(suite.ctx, contract, sponsor, user))
+
+	err := suite.app.EvmKeeper.UseSponsorBudget(suite.ctx, policy, user, sdkmath.NewInt(31))
+	suite.Require().ErrorIs(err, types.ErrInvalidSponsorPolicy)
+
+	// updating the policy keeps the spent budget
+	policy.BudgetPerUser = sdkmath.NewInt(200)
+	suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(suite.ctx, policy))
+	suite.Require().Equal(sdkmath.NewInt(70), suite.app.EvmKeeper.GetSponsorSpent(suite.ctx, contract, sponsor, user))
+
+	// removing the policy resets the spent budget
+	suite.app.EvmKeeper.DeleteSponsorPolicy(suite.ctx, contract, sponsor)
+	suite.Require().True(suite.app.EvmKeeper.GetSponsorSpent(suite.ctx, contract, sponsor, user).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRefundGasSponsorBudget() {
+	suite.SetupTest()
+
+	sender := utiltx.GenerateAddress()
+	user := sdk.AccAddress(sender.Bytes())
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	gasPrice := big.NewInt(10)
+
+	policy := types.NewSponsorPolicy(sponsor, contract, nil, sdkmath.NewInt(1000000))
+	suite.Require().NoError(suite.app.EvmKeeper.StoreSponsorPolicy(suite.ctx, policy))
+
+	// the ante handler charges the fees of the gas limit against the budget of the user
+	fees := sdkmath.NewInt(50000 * 10)
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, fees)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.EvmKeeper.UseSponsorBudget(suite.ctx, policy, user, fees))
+	suite.app.EvmKeeper.SetFeePayerTransient(suite.ctx, sender, 1, sponsor)
+
+	msg := ethtypes.NewMessage(sender, &contract, 1, big.NewInt(0), 50000, gasPrice, gasPrice, gasPrice, nil, nil, false)
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, msg, 30000, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded to the sponsor and credited back to the budget of the user
+	refund := sdkmath.NewInt(30000 * 10)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sponsor, types.DefaultEVMDenom)
+	suite.Require().Equal(refund, balance.Amount)
+	suite.Require().Equal(fees.Sub(refund), suite.app.EvmKeeper.GetSponsorSpent(suite.ctx, contract, sponsor, user))
+
+	// the refund is not credited once the policy is removed
+	suite.app.EvmKeeper.DeleteSponsorPolicy(suite.ctx, contract, sponsor)
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, msg, 10000, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.EvmKeeper.GetSponsorSpent(suite.ctx, contract, sponsor, user).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestFeePayerTransient() {
+	suite.SetupTest()
+
+	sender := utiltx.GenerateAddress()
+	sponsor := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	suite.Require().Equal(sdk.AccAddress(sender.Bytes()), suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, sender, 1))
+
+	suite.app.EvmKeeper.SetFeePayerTransient(suite.ctx, sender, 1, sponsor)
+	suite.Require().Equal(sponsor, suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, sender, 1))
+	suite.Require().Equal(sdk.AccAddress(sender.Bytes()), suite.app.EvmKeeper.GetFeePayerTransient(suite.ctx, sender, 2))
+}
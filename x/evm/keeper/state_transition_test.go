@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -457,6 +458,59 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundGasFeeTokenSwap() {
+	testCases := []struct {
+		name string
+		// swapped is the part of the fees swapped from fee tokens, in units of gas
+		swapped uint64
+		// expRefund is the expected refunded gas
+		expRefund uint64
+	}{
+		{"no swap, the leftover gas is refunded", 0, 10000},
+		{"swap of the used gas, the leftover gas is refunded", params.TxGas - 10000, 10000},
+		{"swap of part of the leftover gas, the rest is refunded", params.TxGas - 4000, 4000},
+		{"swap of the whole fees, nothing is refunded", params.TxGas, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+			ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+			signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+			m, err := newNativeMessage(
+				suite.StateDB().GetNonce(suite.address),
+				suite.ctx.BlockHeight(),
+				suite.address,
+				ethCfg,
+				suite.signer,
+				signer,
+				ethtypes.AccessListTxType,
+				nil,
+				nil,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(params.TxGas, m.Gas())
+
+			gasPrice := sdkmath.NewIntFromBigInt(m.GasPrice())
+			swapped := gasPrice.MulRaw(int64(tc.swapped))
+			suite.app.EvmKeeper.SetFeeTokenSwapTransient(suite.ctx, m.From(), m.Nonce(), swapped)
+			suite.Require().Equal(swapped, suite.app.EvmKeeper.GetFeeTokenSwapTransient(suite.ctx, m.From(), m.Nonce()))
+
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom)
+			err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, 10000, types.DefaultEVMDenom)
+			suite.Require().NoError(err)
+
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom)
+			suite.Require().Equal(gasPrice.MulRaw(int64(tc.expRefund)), balanceAfter.Amount.Sub(balanceBefore.Amount))
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -743,3 +797,70 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestApplyMessageCancunOpcodes() {
+	// tstore(1, 42); mstore(0, tload(1)); mcopy(0x20, 0, 0x20); sstore(0, mload(0x20))
+	code := common.FromHex("602a60015d" + "60015c600052" + "602060006020" + "5e" + "60205160005500")
+	contractAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		cancunBlock int64
+		expPass     bool
+	}{
+		{
+			"fail - before the Cancun block",
+			suite.ctx.BlockHeight() + 1,
+			false,
+		},
+		{
+			"pass - after the Cancun block",
+			suite.ctx.BlockHeight(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			cancunBlock := sdk.NewInt(tc.cancunBlock)
+			params.ChainConfig.CancunBlock = &cancunBlock
+			err := suite.app.EvmKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contractAddr, code)
+			suite.Require().NoError(vmdb.Commit())
+
+			msg := ethtypes.NewMessage(
+				suite.address,
+				&contractAddr,
+				vmdb.GetNonce(suite.address),
+				big.NewInt(0),
+				100000,
+				big.NewInt(0),
+				big.NewInt(0),
+				big.NewInt(0),
+				nil,
+				nil,
+				true,
+			)
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+
+			value := suite.app.EvmKeeper.GetState(suite.ctx, contractAddr, common.Hash{})
+			if tc.expPass {
+				suite.Require().False(res.Failed(), res.VmError)
+				suite.Require().Equal(common.BigToHash(big.NewInt(42)), value)
+				// the transient storage is discarded at the end of the transaction
+				suite.Require().Equal(common.Hash{}, suite.StateDB().GetTransientState(contractAddr, common.BigToHash(big.NewInt(1))))
+			} else {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, "invalid opcode")
+				suite.Require().Equal(common.Hash{}, value)
+			}
+		})
+	}
+}
@@ -118,6 +118,10 @@ type (
 		account       *common.Address
 		key, prevalue common.Hash
 	}
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 	codeChange struct {
 		account            *common.Address
 		prevcode, prevhash []byte
@@ -199,6 +203,14 @@ func (ch storageChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch refundChange) Revert(s *StateDB) {
 	s.refund = ch.prev
 }
@@ -3,6 +3,7 @@
 package statedb
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sort"
@@ -10,9 +11,12 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v15/x/evm/types"
 )
 
 // revision is the identifier of a version of state.
@@ -52,6 +56,10 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Transient storage (EIP-1153), which is discarded at the end of the transaction
+	// together with the StateDB
+	transientStorage transientStorage
 }
 
 // New creates a new state from a given trie.
@@ -63,6 +71,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -77,6 +87,12 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// TxHash returns the hash of the current transaction, which is empty for calls
+// that aren't transactions (e.g. `eth_call`).
+func (s *StateDB) TxHash() common.Hash {
+	return s.txConfig.TxHash
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -416,6 +432,35 @@ func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addre
 	return s.accessList.Contains(addr, slot)
 }
 
+// GetTransientState gets transient storage for a given account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
 // Snapshot returns an identifier for the current revision of the state.
 func (s *StateDB) Snapshot() int {
 	id := s.nextRevisionID
@@ -468,3 +513,122 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// GetCommittedBalance returns the balance of the account stored in the keeper,
+// ignoring the uncommitted changes of the StateDB. It includes the changes made
+// through the Cosmos SDK, e.g. by the stateful precompiles.
+func (s *StateDB) GetCommittedBalance(addr common.Address) *big.Int {
+	account := s.keeper.GetAccount(s.ctx, addr)
+	if account == nil {
+		return common.Big0
+	}
+	return account.Balance
+}
+
+// GetCommittedNonce returns the nonce of the account stored in the keeper,
+// ignoring the uncommitted changes of the StateDB.
+func (s *StateDB) GetCommittedNonce(addr common.Address) uint64 {
+	account := s.keeper.GetAccount(s.ctx, addr)
+	if account == nil {
+		return 0
+	}
+	return account.Nonce
+}
+
+// SyncBalance sets the balance of the account to the one stored in the keeper,
+// after it was changed through the Cosmos SDK, e.g. by a stateful precompile.
+// The change is recorded in the journal, so that committing the StateDB doesn't
+// overwrite the keeper balance with the stale one.
+func (s *StateDB) SyncBalance(addr common.Address) {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	balance := s.GetCommittedBalance(addr)
+	if stateObject.Balance().Cmp(balance) != 0 {
+		stateObject.SetBalance(new(big.Int).Set(balance))
+	}
+}
+
+// SyncBalances syncs the balance of all the accounts cached by the StateDB with
+// the ones stored in the keeper. It's used after executing arbitrary Cosmos
+// messages, whose balance changes can't be known in advance.
+func (s *StateDB) SyncBalances() {
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		s.SyncBalance(addr)
+	}
+}
+
+// CacheContext branches the transaction Context of the StateDB, so that the changes
+// made through the Cosmos SDK in the meantime, e.g. by a batch of stateful precompile
+// calls, are written or discarded together. Both returned functions restore the
+// original Context, write first writes the branch to it.
+func (s *StateDB) CacheContext() (write func(), discard func()) {
+	ctx := s.ctx
+	cacheCtx, writeCache := ctx.CacheContext()
+	s.ctx = cacheCtx
+
+	write = func() {
+		writeCache()
+		s.ctx = ctx
+	}
+	discard = func() {
+		s.ctx = ctx
+	}
+	return write, discard
+}
+
+// JournalPrestate returns the state that the accounts modified in the journal had
+// before their first recorded change. The balance, nonce and code that weren't
+// modified hold their current values, and the storage only holds the modified
+// slots. The accounts created by the recorded changes have a nil balance.
+func (s *StateDB) JournalPrestate() map[common.Address]*types.StateDiffAccount {
+	prestate := make(map[common.Address]*types.StateDiffAccount)
+	account := func(addr common.Address) *types.StateDiffAccount {
+		if acc, ok := prestate[addr]; ok {
+			return acc
+		}
+		acc := &types.StateDiffAccount{
+			Balance: (*hexutil.Big)(new(big.Int).Set(s.GetBalance(addr))),
+			Nonce:   s.GetNonce(addr),
+			Code:    s.GetCode(addr),
+			Storage: make(map[common.Hash]common.Hash),
+		}
+		prestate[addr] = acc
+		return acc
+	}
+
+	// walk the journal backwards, so that the earliest change of each field wins
+	for i := len(s.journal.entries) - 1; i >= 0; i-- {
+		switch ch := s.journal.entries[i].(type) {
+		case createObjectChange:
+			acc := account(*ch.account)
+			acc.Balance, acc.Nonce, acc.Code = nil, 0, nil
+		case resetObjectChange:
+			acc := account(ch.prev.address)
+			acc.Balance = (*hexutil.Big)(new(big.Int).Set(ch.prev.Balance()))
+			acc.Nonce = ch.prev.Nonce()
+			acc.Code = ch.prev.Code()
+		case suicideChange:
+			account(*ch.account).Balance = (*hexutil.Big)(new(big.Int).Set(ch.prevbalance))
+		case balanceChange:
+			account(*ch.account).Balance = (*hexutil.Big)(new(big.Int).Set(ch.prev))
+		case nonceChange:
+			account(*ch.account).Nonce = ch.prev
+		case codeChange:
+			account(*ch.account).Code = ch.prevcode
+		case storageChange:
+			account(*ch.account).Storage[ch.key] = ch.prevalue
+		}
+	}
+
+	return prestate
+}
@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -385,6 +387,38 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is never committed and is discarded with the StateDB
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
@@ -504,6 +538,118 @@ func (suite *StateDBTestSuite) TestLog() {
 	suite.Require().Equal(expecedLog, db.Logs()[1])
 }
 
+func (suite *StateDBTestSuite) TestJournalPrestate() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+	code := []byte("hello world")
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(100))
+	db.SetNonce(address, 1)
+	db.SetCode(address, code)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(50))
+	db.SubBalance(address, big.NewInt(20))
+	db.SetNonce(address, 2)
+	db.SetState(address, key1, value2)
+	db.SetState(address, key2, value1)
+	db.AddBalance(address2, big.NewInt(10))
+	// reads don't modify the journal
+	_ = db.GetBalance(address3)
+
+	prestate := db.JournalPrestate()
+	suite.Require().Len(prestate, 2)
+
+	pre := prestate[address]
+	suite.Require().Equal(big.NewInt(100), pre.Balance.ToInt())
+	suite.Require().Equal(uint64(1), pre.Nonce)
+	suite.Require().Equal(code, []byte(pre.Code))
+	suite.Require().Equal(map[common.Hash]common.Hash{key1: value1, key2: {}}, pre.Storage)
+
+	// created accounts have no balance
+	suite.Require().Nil(prestate[address2].Balance)
+
+	// the prestate doesn't modify the current state
+	suite.Require().Equal(big.NewInt(130), db.GetBalance(address))
+	suite.Require().Equal(value2, db.GetState(address, key1))
+}
+
+func (suite *StateDBTestSuite) TestSyncBalance() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(100))
+	suite.Require().NoError(db.Commit())
+
+	// change the balance outside of the StateDB, e.g. through a precompile
+	suite.Require().NoError(keeper.SetAccount(sdk.Context{}, address, statedb.Account{
+		Balance:  big.NewInt(40),
+		CodeHash: emptyCodeHash,
+	}))
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+
+	snapshot := db.Snapshot()
+	db.SyncBalance(address)
+	suite.Require().Equal(big.NewInt(40), db.GetBalance(address))
+
+	// uncached accounts are a no-op
+	db.SyncBalance(address2)
+	suite.Require().False(db.Exist(address2))
+
+	// the sync is journaled
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+
+	db.SyncBalance(address)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(big.NewInt(40), keeper.accounts[address].account.Balance)
+}
+
+func (suite *StateDBTestSuite) TestSyncBalances() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(100))
+	db.AddBalance(address2, big.NewInt(50))
+	suite.Require().NoError(db.Commit())
+
+	// change the balances outside of the StateDB, e.g. through a precompile
+	for addr, balance := range map[common.Address]int64{address: 40, address2: 60} {
+		suite.Require().NoError(keeper.SetAccount(sdk.Context{}, addr, statedb.Account{
+			Balance:  big.NewInt(balance),
+			CodeHash: emptyCodeHash,
+		}))
+	}
+
+	db.SyncBalances()
+	suite.Require().Equal(big.NewInt(40), db.GetBalance(address))
+	suite.Require().Equal(big.NewInt(60), db.GetBalance(address2))
+}
+
+func (suite *StateDBTestSuite) TestCacheContext() {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	db := statedb.New(ctx, NewMockKeeper(), emptyTxConfig)
+
+	// discarded changes don't reach the original context
+	_, discard := db.CacheContext()
+	db.GetContext().KVStore(key).Set([]byte("key"), []byte("discarded"))
+	discard()
+	suite.Require().False(ctx.KVStore(key).Has([]byte("key")))
+
+	// written changes reach the original context
+	write, _ := db.CacheContext()
+	db.GetContext().KVStore(key).Set([]byte("key"), []byte("written"))
+	suite.Require().False(ctx.KVStore(key).Has([]byte("key")))
+	write()
+	suite.Require().Equal([]byte("written"), ctx.KVStore(key).Get([]byte("key")))
+	suite.Require().Equal([]byte("written"), db.GetContext().KVStore(key).Get([]byte("key")))
+}
+
 func (suite *StateDBTestSuite) TestRefund() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,310 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// TracerCall is the name of the native call tracer. It produces the same output
+	// as the go-ethereum callTracer and, when enabled through the tracer configuration,
+	// adds a synthetic frame for every stateful precompile invocation.
+	TracerCall = "callTracer"
+
+	// CallTypePrecompile is the call frame type used for the synthetic frames that
+	// describe the Cosmos execution of a stateful precompile.
+	CallTypePrecompile = "PRECOMPILE"
+)
+
+var _ tracers.Tracer = &CallTracer{}
+
+// CallFrame is a single call frame of the call tracer output.
+type CallFrame struct {
+	Type    string      `json:"type"`
+	From    string      `json:"from"`
+	To      string      `json:"to,omitempty"`
+	Value   string      `json:"value,omitempty"`
+	Gas     string      `json:"gas"`
+	GasUsed string      `json:"gasUsed"`
+	Input   string      `json:"input"`
+	Output  string      `json:"output,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Calls   []CallFrame `json:"calls,omitempty"`
+
+	// Method, Args and Events are only populated for precompile frames
+	Method string                 `json:"method,omitempty"`
+	Args   map[string]interface{} `json:"args,omitempty"`
+	Events sdk.StringEvents       `json:"events,omitempty"`
+
+	// eventsStart is the number of Cosmos events emitted before entering the frame
+	eventsStart int
+	// precompileMethod is the ABI method of the precompile called in this frame
+	precompileMethod *abi.Method
+}
+
+// CallTracerConfig defines the configuration options of the call tracer.
+type CallTracerConfig struct {
+	// OnlyTopCall skips the collection of sub-calls if true
+	OnlyTopCall bool `json:"onlyTopCall"`
+	// WithPrecompiles adds a synthetic frame with the decoded method, arguments and
+	// emitted Cosmos events for each call to a stateful precompile if true
+	WithPrecompiles bool `json:"withPrecompiles"`
+}
+
+// precompileABI defines the ABI method lookup implemented by the stateful precompiles.
+type precompileABI interface {
+	MethodById(sigdata []byte) (*abi.Method, error)
+}
+
+// contextStateDB defines the StateDB interface that exposes the Cosmos SDK context
+// used by the stateful precompiles.
+type contextStateDB interface {
+	GetContext() sdk.Context
+}
+
+// CallTracer is a native tracer that tracks the call frames of a transaction.
+type CallTracer struct {
+	env       *vm.EVM
+	callstack []CallFrame
+	config    CallTracerConfig
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// NewCallTracer returns a native call tracer that implements the tracers.Tracer interface.
+func NewCallTracer(_ *tracers.Context, cfg json.RawMessage) (*CallTracer, error) {
+	var config CallTracerConfig
+	if len(cfg) > 0 {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	// First callframe contains tx context info
+	// and is populated on start and end.
+	return &CallTracer{callstack: make([]CallFrame, 1), config: config}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *CallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.callstack[0] = CallFrame{
+		Type:  vm.CALL.String(),
+		From:  addrToHex(from),
+		To:    addrToHex(to),
+		Input: bytesToHex(input),
+		Gas:   uintToHex(gas),
+		Value: bigToHex(value),
+	}
+	if create {
+		t.callstack[0].Type = vm.CREATE.String()
+		return
+	}
+
+	t.enterPrecompile(&t.callstack[0], to, input)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.callstack[0].GasUsed = uintToHex(gasUsed)
+	if err != nil {
+		t.callstack[0].Error = err.Error()
+		if errors.Is(err, vm.ErrExecutionReverted) && len(output) > 0 {
+			t.callstack[0].Output = bytesToHex(output)
+		}
+	} else {
+		t.callstack[0].Output = bytesToHex(output)
+	}
+
+	t.exitPrecompile(&t.callstack[0], err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *CallTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *CallTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *CallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	call := CallFrame{
+		Type:  typ.String(),
+		From:  addrToHex(from),
+		To:    addrToHex(to),
+		Input: bytesToHex(input),
+		Gas:   uintToHex(gas),
+		Value: bigToHex(value),
+	}
+
+	if typ != vm.CREATE && typ != vm.CREATE2 {
+		t.enterPrecompile(&call, to, input)
+	}
+
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	// pop call
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	size--
+
+	call.GasUsed = uintToHex(gasUsed)
+	if err == nil {
+		call.Output = bytesToHex(output)
+	} else {
+		call.Error = err.Error()
+		if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+			call.To = ""
+		}
+	}
+
+	t.exitPrecompile(&call, err)
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
+}
+
+// CaptureTxStart implements the EVMLogger interface.
+func (*CallTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface.
+func (*CallTracer) CaptureTxEnd(_ uint64) {}
+
+// GetResult returns the json-encoded nested list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *CallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *CallTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// enterPrecompile records the precompile method and the number of Cosmos events
+// emitted before the execution of the call frame, if the callee is a stateful
+// precompile and precompile frames are enabled.
+func (t *CallTracer) enterPrecompile(call *CallFrame, to common.Address, input []byte) {
+	if !t.config.WithPrecompiles || t.env == nil || len(input) < 4 {
+		return
+	}
+
+	precompile, ok := t.env.Precompile(to)
+	if !ok {
+		return
+	}
+
+	// only the stateful precompiles expose their ABI
+	contract, ok := precompile.(precompileABI)
+	if !ok {
+		return
+	}
+
+	method, err := contract.MethodById(input[:4])
+	if err != nil {
+		return
+	}
+
+	call.precompileMethod = method
+	if stateDB, ok := t.env.StateDB.(contextStateDB); ok {
+		call.eventsStart = len(stateDB.GetContext().EventManager().Events())
+	}
+}
+
+// exitPrecompile appends the synthetic precompile frame to the given call frame
+// with the decoded method, arguments and the Cosmos events emitted during its
+// execution.
+func (t *CallTracer) exitPrecompile(call *CallFrame, err error) {
+	method := call.precompileMethod
+	if method == nil {
+		return
+	}
+
+	frame := CallFrame{
+		Type:    CallTypePrecompile,
+		From:    call.To,
+		To:      call.To,
+		Gas:     call.Gas,
+		GasUsed: call.GasUsed,
+		Input:   call.Input,
+		Output:  call.Output,
+		Error:   call.Error,
+		Method:  method.Name,
+		Args:    make(map[string]interface{}, len(method.Inputs)),
+	}
+
+	input := common.FromHex(call.Input)
+	if unpackErr := method.Inputs.UnpackIntoMap(frame.Args, input[4:]); unpackErr != nil {
+		frame.Args = nil
+	}
+
+	// events are only included if the precompile call was successful
+	stateDB, ok := t.env.StateDB.(contextStateDB)
+	if err == nil && ok {
+		events := stateDB.GetContext().EventManager().Events()
+		if len(events) > call.eventsStart {
+			frame.Events = sdk.StringifyEvents(events[call.eventsStart:].ToABCIEvents())
+		}
+	}
+
+	call.Calls = append(call.Calls, frame)
+}
+
+func bytesToHex(s []byte) string {
+	return "0x" + common.Bytes2Hex(s)
+}
+
+func bigToHex(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return "0x" + n.Text(16)
+}
+
+func uintToHex(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func addrToHex(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
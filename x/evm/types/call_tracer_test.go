@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/precompiles/bech32"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func TestCallTracer(t *testing.T) {
+	precompile, err := bech32.NewPrecompile(6000)
+	require.NoError(t, err)
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	input, err := precompile.Pack(bech32.HexToBech32Method, from, "evmos")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		config        string
+		err           error
+		expPrecompile bool
+		expEvents     int
+	}{
+		{
+			"pass - precompile frames disabled",
+			"",
+			nil,
+			false,
+			0,
+		},
+		{
+			"pass - precompile frame with events",
+			`{"withPrecompiles":true}`,
+			nil,
+			true,
+			1,
+		},
+		{
+			"pass - reverted precompile frame without events",
+			`{"withPrecompiles":true}`,
+			vm.ErrExecutionReverted,
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			stateDB := statedb.New(ctx, nil, statedb.NewEmptyTxConfig(common.Hash{}))
+
+			evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{})
+			evm.WithPrecompiles(
+				map[common.Address]vm.PrecompiledContract{precompile.Address(): precompile},
+				[]common.Address{precompile.Address()},
+			)
+
+			tracer, err := types.NewCallTracer(nil, json.RawMessage(tc.config))
+			require.NoError(t, err)
+
+			tracer.CaptureStart(evm, from, contract, false, nil, 100000, big.NewInt(0))
+			tracer.CaptureEnter(vm.STATICCALL, contract, precompile.Address(), input, 50000, nil)
+			ctx.EventManager().EmitEvent(sdk.NewEvent("test", sdk.NewAttribute("key", "value")))
+			tracer.CaptureExit(nil, 6000, tc.err)
+			tracer.CaptureEnd(nil, 30000, 0, nil)
+
+			res, err := tracer.GetResult()
+			require.NoError(t, err)
+
+			var frame types.CallFrame
+			require.NoError(t, json.Unmarshal(res, &frame))
+			require.Len(t, frame.Calls, 1)
+
+			call := frame.Calls[0]
+			require.Equal(t, vm.STATICCALL.String(), call.Type)
+			if !tc.expPrecompile {
+				require.Empty(t, call.Calls)
+				return
+			}
+
+			require.Len(t, call.Calls, 1)
+			precompileFrame := call.Calls[0]
+			require.Equal(t, types.CallTypePrecompile, precompileFrame.Type)
+			require.Equal(t, bech32.HexToBech32Method, precompileFrame.Method)
+			require.Equal(t, "evmos", precompileFrame.Args["prefix"])
+			require.Len(t, precompileFrame.Events, tc.expEvents)
+		})
+	}
+}
@@ -25,7 +25,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName        = "ethermint/MsgUpdateParams"
+	setSponsorPolicyName    = "ethermint/MsgSetSponsorPolicy"
+	removeSponsorPolicyName = "ethermint/MsgRemoveSponsorPolicy"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgSetSponsorPolicy{},
+		&MsgRemoveSponsorPolicy{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -91,4 +95,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetSponsorPolicy{}, setSponsorPolicyName, nil)
+	cdc.RegisterConcrete(&MsgRemoveSponsorPolicy{}, removeSponsorPolicyName, nil)
 }
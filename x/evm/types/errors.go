@@ -30,6 +30,8 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrInvalidSponsorPolicy
+	codeErrSponsorPolicyNotFound
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -82,6 +84,12 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrInvalidSponsorPolicy returns an error if a sponsor policy is invalid
+	ErrInvalidSponsorPolicy = errorsmod.Register(ModuleName, codeErrInvalidSponsorPolicy, "invalid sponsor policy")
+
+	// ErrSponsorPolicyNotFound returns an error if a sponsor policy cannot be found
+	ErrSponsorPolicyNotFound = errorsmod.Register(ModuleName, codeErrSponsorPolicyNotFound, "sponsor policy not found")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -685,6 +686,75 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// SponsorPolicy defines the conditions under which a sponsor account pays for
+// the fees of the Ethereum transactions sent to a contract.
+type SponsorPolicy struct {
+	// sponsor is the bech32 address of the account paying for the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the sponsored contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// allowed_methods are the hex encoded 4-byte selectors of the sponsored contract
+	// methods. If empty, all the calls to the contract are sponsored.
+	AllowedMethods []string `protobuf:"bytes,3,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	// budget_per_user is the maximum amount of fees, in the EVM denomination, that
+	// the sponsor pays for each user.
+	BudgetPerUser cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=budget_per_user,json=budgetPerUser,proto3,customtype=cosmossdk.io/math.Int" json:"budget_per_user"`
+}
+
+func (m *SponsorPolicy) Reset()         { *m = SponsorPolicy{} }
+func (m *SponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*SponsorPolicy) ProtoMessage()    {}
+func (*SponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+}
+func (m *SponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorPolicy.Merge(m, src)
+}
+func (m *SponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorPolicy proto.InternalMessageInfo
+
+func (m *SponsorPolicy) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsorPolicy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *SponsorPolicy) GetAllowedMethods() []string {
+	if m != nil {
+		return m.AllowedMethods
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -694,115 +764,122 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*SponsorPolicy)(nil), "ethermint.evm.v1.SponsorPolicy")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x4e, 0x23, 0xc9,
-	0x15, 0x06, 0xdc, 0x40, 0xbb, 0x6c, 0xec, 0xa6, 0xf0, 0xb0, 0xde, 0x19, 0x85, 0x26, 0x7d, 0x11,
-	0x11, 0x69, 0x07, 0x16, 0x56, 0x28, 0xa3, 0x5d, 0x25, 0x0a, 0x9e, 0x61, 0x77, 0x21, 0x93, 0x0d,
-	0x2a, 0x58, 0x45, 0x8a, 0x14, 0xb5, 0xca, 0xdd, 0xb5, 0xed, 0x5e, 0xba, 0xbb, 0xac, 0xaa, 0x6a,
-	0x8f, 0x9d, 0xe4, 0x01, 0x22, 0xe5, 0x26, 0x4f, 0x10, 0xed, 0x63, 0xe4, 0x11, 0x56, 0xb9, 0xda,
-	0xcb, 0x28, 0x17, 0xad, 0x88, 0xb9, 0xe3, 0xd2, 0x4f, 0x10, 0xd5, 0x8f, 0xdb, 0x3f, 0xa0, 0x24,
-	0xf8, 0x06, 0xea, 0x3b, 0xe7, 0xd4, 0xf7, 0x55, 0x9d, 0x3a, 0x45, 0x9d, 0x06, 0x3c, 0x27, 0xa2,
-	0x47, 0x58, 0x1a, 0x67, 0xe2, 0x88, 0x0c, 0xd2, 0xa3, 0xc1, 0xb1, 0xfc, 0x75, 0xd8, 0x67, 0x54,
-	0x50, 0xe8, 0x94, 0xbe, 0x43, 0x69, 0x1c, 0x1c, 0x3f, 0x6f, 0x45, 0x34, 0xa2, 0xca, 0x79, 0x24,
-	0x47, 0x3a, 0xce, 0xfb, 0x7b, 0x05, 0x6c, 0x5c, 0x61, 0x86, 0x53, 0x0e, 0x8f, 0x41, 0x95, 0x0c,
-	0x52, 0x3f, 0x24, 0x19, 0x4d, 0xdb, 0xab, 0xfb, 0xab, 0x07, 0xd5, 0x4e, 0x6b, 0x5c, 0xb8, 0xce,
-	0x08, 0xa7, 0xc9, 0xa7, 0x5e, 0xe9, 0xf2, 0x90, 0x4d, 0x06, 0xe9, 0x1b, 0x39, 0x84, 0x3f, 0x07,
-	0x5b, 0x24, 0xc3, 0xdd, 0x84, 0xf8, 0x01, 0x23, 0x58, 0x90, 0xf6, 0xda, 0xfe, 0xea, 0x81, 0xdd,
-	0x69, 0x8f, 0x0b, 0xb7, 0x65, 0xa6, 0xcd, 0xba, 0x3d, 0x54, 0xd7, 0xf8, 0xb5, 0x82, 0xf0, 0x67,
-	0xa0, 0x36, 0xf1, 0xe3, 0x24, 0x69, 0x57, 0xd4, 0xe4, 0xdd, 0x71, 0xe1, 0xc2, 0xf9, 0xc9, 0x38,
-	0x49, 0x3c, 0x04, 0xcc, 0x54, 0x9c, 0x24, 0xf0, 0x0c, 0x00, 0x32, 0x14, 0x0c, 0xfb, 0x24, 0xee,
-	0xf3, 0xb6, 0xb5, 0x5f, 0x39, 0xa8, 0x74, 0xbc, 0xbb, 0xc2, 0xad, 0x9e, 0x4b, 0xeb, 0xf9, 0xc5,
-	0x15, 0x1f, 0x17, 0xee, 0xb6, 0x21, 0x29, 0x03, 0x3d, 0x54, 0x55, 0xe0, 0x3c, 0xee, 0x73, 0xf8,
-	0x7b, 0x50, 0x0f, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66, 0xdf, 0xc4, 0x51, 0x7b, 0x7d, 0x7f, 0xf5,
-	0xa0, 0x76, 0xf2, 0xa3, 0xc3, 0xc5, 0xbc, 0x1d, 0xbe, 0x96, 0x51, 0xaf, 0x55, 0x50, 0xe7, 0xc5,
-	0xf7, 0x85, 0xbb, 0x32, 0x2e, 0xdc, 0x1d, 0x4d, 0x3d, 0x4b, 0xe0, 0xa1, 0x5a, 0x30, 0x8d, 0x84,
-	0x27, 0xe0, 0x19, 0x4e, 0x12, 0xfa, 0xce, 0xcf, 0x33, 0x99, 0x68, 0x12, 0x08, 0x12, 0xfa, 0x62,
-	0xc8, 0xdb, 0x1b, 0x72, 0x93, 0x68, 0x47, 0x39, 0xbf, 0x9e, 0xfa, 0x6e, 0x86, 0x1c, 0xbe, 0x04,
-	0x10, 0x07, 0x22, 0x1e, 0x10, 0xbf, 0xcf, 0x48, 0x40, 0xd3, 0x7e, 0x9c, 0x10, 0xde, 0xde, 0xdc,
-	0xaf, 0x1c, 0x54, 0xd1, 0xb6, 0xf6, 0x5c, 0x4d, 0x1d, 0xde, 0xdf, 0xb6, 0x41, 0x6d, 0x66, 0x71,
-	0x30, 0x05, 0xcd, 0x1e, 0x4d, 0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0xc1, 0xad, 0x39, 0xc5,
-	0x37, 0xff, 0x2a, 0xdc, 0x9f, 0x44, 0xb1, 0xe8, 0xe5, 0xdd, 0xc3, 0x80, 0xa6, 0x47, 0x01, 0xe5,
-	0x29, 0xe5, 0xe6, 0xd7, 0x4b, 0x1e, 0xde, 0x1e, 0x89, 0x51, 0x9f, 0xf0, 0xc3, 0x8b, 0x4c, 0x8c,
-	0x0b, 0x77, 0x57, 0xef, 0x6d, 0x81, 0xca, 0x43, 0x8d, 0xd2, 0xd2, 0x91, 0x06, 0x38, 0x02, 0x8d,
-	0x10, 0x53, 0xff, 0x1b, 0xca, 0x6e, 0x8d, 0xda, 0x9a, 0x52, 0xbb, 0xfe, 0xff, 0xd5, 0xee, 0x0a,
-	0xb7, 0xfe, 0xe6, 0xec, 0x37, 0x9f, 0x53, 0x76, 0xab, 0x38, 0xc7, 0x85, 0xfb, 0x4c, 0xab, 0xcf,
-	0x33, 0x7b, 0xa8, 0x1e, 0x62, 0x5a, 0x86, 0xc1, 0xdf, 0x02, 0xa7, 0x0c, 0xe0, 0x79, 0xbf, 0x4f,
-	0x99, 0x30, 0xc5, 0xf3, 0xf2, 0xae, 0x70, 0x1b, 0x86, 0xf2, 0x5a, 0x7b, 0xc6, 0x85, 0xfb, 0xc1,
-	0x02, 0xa9, 0x99, 0xe3, 0xa1, 0x86, 0xa1, 0x35, 0xa1, 0x90, 0x83, 0x3a, 0x89, 0xfb, 0xc7, 0xa7,
-	0x1f, 0x9b, 0x1d, 0x59, 0x6a, 0x47, 0x57, 0x4f, 0xda, 0x51, 0xed, 0xfc, 0xe2, 0xea, 0xf8, 0xf4,
-	0xe3, 0xc9, 0x86, 0x4c, 0xa9, 0xcc, 0xd2, 0x7a, 0xa8, 0xa6, 0xa1, 0xde, 0xcd, 0x05, 0x30, 0xd0,
-	0xef, 0x61, 0xde, 0x53, 0x85, 0x58, 0xed, 0x1c, 0xdc, 0x15, 0x2e, 0xd0, 0x4c, 0x5f, 0x62, 0xde,
-	0x9b, 0x9e, 0x4b, 0x77, 0xf4, 0x07, 0x9c, 0x89, 0x38, 0x4f, 0x27, 0x5c, 0x40, 0x4f, 0x96, 0x51,
-	0xe5, 0xfa, 0x4f, 0xcd, 0xfa, 0x37, 0x96, 0x5e, 0xff, 0xe9, 0x63, 0xeb, 0x3f, 0x9d, 0x5f, 0xbf,
-	0x8e, 0x29, 0x45, 0x5f, 0x19, 0xd1, 0xcd, 0xa5, 0x45, 0x5f, 0x3d, 0x26, 0xfa, 0x6a, 0x5e, 0x54,
-	0xc7, 0xc8, 0x62, 0x5f, 0xc8, 0x44, 0xdb, 0x5e, 0xbe, 0xd8, 0x1f, 0x24, 0xb5, 0x51, 0x5a, 0xb4,
-	0xdc, 0x9f, 0x40, 0x2b, 0xa0, 0x19, 0x17, 0xd2, 0x96, 0xd1, 0x7e, 0x42, 0x8c, 0x66, 0x55, 0x69,
-	0x5e, 0x3c, 0x49, 0xf3, 0x85, 0xf9, 0xe3, 0xf1, 0x08, 0x9f, 0x87, 0x76, 0xe6, 0xcd, 0x5a, 0xbd,
-	0x0f, 0x9c, 0x3e, 0x11, 0x84, 0xf1, 0x6e, 0xce, 0x22, 0xa3, 0x0c, 0x94, 0xf2, 0xf9, 0x93, 0x94,
-	0xcd, 0x3d, 0x58, 0xe4, 0xf2, 0x50, 0x73, 0x6a, 0xd2, 0x8a, 0xdf, 0x82, 0x46, 0x2c, 0x97, 0xd1,
-	0xcd, 0x13, 0xa3, 0x57, 0x53, 0x7a, 0xaf, 0x9f, 0xa4, 0x67, 0x2e, 0xf3, 0x3c, 0x93, 0x87, 0xb6,
-	0x26, 0x06, 0xad, 0x95, 0x03, 0x98, 0xe6, 0x31, 0xf3, 0xa3, 0x04, 0x07, 0x31, 0x61, 0x46, 0xaf,
-	0xae, 0xf4, 0xbe, 0x78, 0x92, 0xde, 0x87, 0x5a, 0xef, 0x21, 0x9b, 0x87, 0x1c, 0x69, 0xfc, 0x42,
-	0xdb, 0xb4, 0x6c, 0x08, 0xea, 0x5d, 0xc2, 0x92, 0x38, 0x33, 0x82, 0x5b, 0x4a, 0xf0, 0xec, 0x49,
-	0x82, 0xa6, 0x4e, 0x67, 0x79, 0x3c, 0x54, 0xd3, 0xb0, 0x54, 0x49, 0x68, 0x16, 0xd2, 0x89, 0xca,
-	0xf6, 0xf2, 0x2a, 0xb3, 0x3c, 0x1e, 0xaa, 0x69, 0xa8, 0x55, 0x86, 0x60, 0x07, 0x33, 0x46, 0xdf,
-	0x2d, 0xe4, 0x10, 0x2a, 0xb1, 0x2f, 0x9f, 0x24, 0xf6, 0x5c, 0x8b, 0x3d, 0x42, 0xe7, 0xa1, 0x6d,
-	0x65, 0x9d, 0xcb, 0x62, 0x0e, 0x60, 0xc4, 0xf0, 0x68, 0x41, 0xb8, 0xb5, 0xfc, 0xe1, 0x3d, 0x64,
-	0xf3, 0x90, 0x23, 0x8d, 0x73, 0xb2, 0x7f, 0x04, 0xad, 0x94, 0xb0, 0x88, 0xf8, 0x19, 0x11, 0xbc,
-	0x9f, 0xc4, 0xc2, 0x08, 0x3f, 0x5b, 0xfe, 0x3e, 0x3e, 0xc6, 0xe7, 0x21, 0xa8, 0xcc, 0x5f, 0x19,
-	0x6b, 0x79, 0x39, 0x78, 0x0f, 0x67, 0x51, 0x0f, 0xc7, 0x46, 0x76, 0x77, 0xf9, 0xcb, 0x31, 0xcf,
-	0xe4, 0xa1, 0xad, 0x89, 0xa1, 0xac, 0x9f, 0x00, 0x67, 0x41, 0x3e, 0xa9, 0x9f, 0x0f, 0x96, 0xaf,
-	0x9f, 0x59, 0x1e, 0xd9, 0xad, 0x28, 0xa8, 0x54, 0x2e, 0x2d, 0xbb, 0xe1, 0x34, 0x2f, 0x2d, 0xbb,
-	0xe9, 0x38, 0x97, 0x96, 0xed, 0x38, 0xdb, 0x97, 0x96, 0xbd, 0xe3, 0xb4, 0xd0, 0xd6, 0x88, 0x26,
-	0xd4, 0x1f, 0x7c, 0xa2, 0x27, 0xa1, 0x1a, 0x79, 0x87, 0xb9, 0xf9, 0x1b, 0x89, 0x1a, 0x01, 0x16,
-	0x38, 0x19, 0x71, 0x93, 0x2a, 0xe4, 0xe8, 0x04, 0xce, 0xbc, 0xda, 0x47, 0x60, 0xfd, 0x5a, 0xc8,
-	0x3e, 0xcf, 0x01, 0x95, 0x5b, 0x32, 0xd2, 0xdd, 0x08, 0x92, 0x43, 0xd8, 0x02, 0xeb, 0x03, 0x9c,
-	0xe4, 0xba, 0x61, 0xac, 0x22, 0x0d, 0xbc, 0x2b, 0xd0, 0xbc, 0x61, 0x38, 0xe3, 0xb2, 0xd7, 0xa1,
-	0xd9, 0x5b, 0x1a, 0x71, 0x08, 0x81, 0xa5, 0x5e, 0x45, 0x3d, 0x57, 0x8d, 0xe1, 0x4f, 0x81, 0x95,
-	0xd0, 0x88, 0xb7, 0xd7, 0xf6, 0x2b, 0x07, 0xb5, 0x93, 0x67, 0x0f, 0x5b, 0xb6, 0xb7, 0x34, 0x42,
-	0x2a, 0xc4, 0xfb, 0xc7, 0x1a, 0xa8, 0xbc, 0xa5, 0x11, 0x6c, 0x83, 0x4d, 0x1c, 0x86, 0x8c, 0x70,
-	0x6e, 0x98, 0x26, 0x10, 0xee, 0x82, 0x0d, 0x41, 0xfb, 0x71, 0xa0, 0xe9, 0xaa, 0xc8, 0x20, 0x29,
-	0x1c, 0x62, 0x81, 0x55, 0x5f, 0x51, 0x47, 0x6a, 0x0c, 0x4f, 0x40, 0x5d, 0xed, 0xcc, 0xcf, 0xf2,
-	0xb4, 0x4b, 0x98, 0x6a, 0x0f, 0xac, 0x4e, 0xf3, 0xbe, 0x70, 0x6b, 0xca, 0xfe, 0x95, 0x32, 0xa3,
-	0x59, 0x00, 0x3f, 0x02, 0x9b, 0x62, 0x38, 0xfb, 0xb2, 0xef, 0xdc, 0x17, 0x6e, 0x53, 0x4c, 0xb7,
-	0x29, 0x1f, 0x6e, 0xb4, 0x21, 0x86, 0xea, 0x01, 0x3f, 0x02, 0xb6, 0x18, 0xfa, 0x71, 0x16, 0x92,
-	0xa1, 0x7a, 0xbc, 0xad, 0x4e, 0xeb, 0xbe, 0x70, 0x9d, 0x99, 0xf0, 0x0b, 0xe9, 0x43, 0x9b, 0x62,
-	0xa8, 0x06, 0xf0, 0x23, 0x00, 0xf4, 0x92, 0x94, 0x82, 0x7e, 0x7a, 0xb7, 0xee, 0x0b, 0xb7, 0xaa,
-	0xac, 0x8a, 0x7b, 0x3a, 0x84, 0x1e, 0x58, 0xd7, 0xdc, 0xb6, 0xe2, 0xae, 0xdf, 0x17, 0xae, 0x9d,
-	0xd0, 0x48, 0x73, 0x6a, 0x97, 0x4c, 0x15, 0x23, 0x29, 0x1d, 0x90, 0x50, 0xbd, 0x6e, 0x36, 0x9a,
-	0x40, 0xef, 0x2f, 0x6b, 0xc0, 0xbe, 0x19, 0x22, 0xc2, 0xf3, 0x44, 0xc0, 0xcf, 0x81, 0x13, 0xd0,
-	0x4c, 0x30, 0x1c, 0x08, 0x7f, 0x2e, 0xb5, 0x9d, 0x17, 0xd3, 0x97, 0x66, 0x31, 0xc2, 0x43, 0xcd,
-	0x89, 0xe9, 0xcc, 0xe4, 0xbf, 0x05, 0xd6, 0xbb, 0x09, 0xa5, 0xa9, 0xaa, 0x84, 0x3a, 0xd2, 0x00,
-	0x22, 0x95, 0x35, 0x75, 0xca, 0x15, 0xd5, 0x98, 0xff, 0xf8, 0xe1, 0x29, 0x2f, 0x94, 0x4a, 0x67,
-	0xd7, 0x34, 0xe7, 0x0d, 0xad, 0x6d, 0xe6, 0x7b, 0x32, 0xb7, 0xaa, 0x94, 0x1c, 0x50, 0x61, 0x44,
-	0xa8, 0x43, 0xab, 0x23, 0x39, 0x84, 0xcf, 0x81, 0xcd, 0xc8, 0x80, 0x30, 0x41, 0x42, 0x75, 0x38,
-	0x36, 0x2a, 0x31, 0xfc, 0x10, 0xd8, 0x11, 0xe6, 0x7e, 0xce, 0x49, 0xa8, 0x4f, 0x02, 0x6d, 0x46,
-	0x98, 0x7f, 0xcd, 0x49, 0xf8, 0xa9, 0xf5, 0xe7, 0xef, 0xdc, 0x15, 0x0f, 0x83, 0xda, 0x59, 0x10,
-	0x10, 0xce, 0x6f, 0xf2, 0x7e, 0x42, 0xfe, 0x4b, 0x85, 0x9d, 0x80, 0x3a, 0x17, 0x94, 0xe1, 0x88,
-	0xf8, 0xb7, 0x64, 0x64, 0xea, 0x4c, 0x57, 0x8d, 0xb1, 0xff, 0x8a, 0x8c, 0x38, 0x9a, 0x05, 0x46,
-	0xe2, 0x3b, 0x0b, 0xd4, 0x6e, 0x18, 0x0e, 0x88, 0xe9, 0xf0, 0x65, 0xad, 0x4a, 0xc8, 0x8c, 0x84,
-	0x41, 0x52, 0x5b, 0xc4, 0x29, 0xa1, 0xb9, 0x30, 0xf7, 0x69, 0x02, 0xe5, 0x0c, 0x46, 0xc8, 0x90,
-	0x04, 0x2a, 0x8d, 0x16, 0x32, 0x08, 0x9e, 0x82, 0xad, 0x30, 0xe6, 0xea, 0xeb, 0x8a, 0x0b, 0x1c,
-	0xdc, 0xea, 0xed, 0x77, 0x9c, 0xfb, 0xc2, 0xad, 0x1b, 0xc7, 0xb5, 0xb4, 0xa3, 0x39, 0x04, 0x3f,
-	0x03, 0xcd, 0xe9, 0x34, 0xb5, 0x5a, 0xfd, 0x3d, 0xd3, 0x81, 0xf7, 0x85, 0xdb, 0x28, 0x43, 0x95,
-	0x07, 0x2d, 0x60, 0x79, 0xd2, 0x21, 0xe9, 0xe6, 0x91, 0x2a, 0x3e, 0x1b, 0x69, 0x20, 0xad, 0x49,
-	0x9c, 0xc6, 0x42, 0x15, 0xdb, 0x3a, 0xd2, 0x00, 0x7e, 0x06, 0xaa, 0x74, 0x40, 0x18, 0x8b, 0x43,
-	0xc2, 0x55, 0xab, 0xf3, 0xbf, 0x3e, 0xcd, 0xd0, 0x34, 0x5e, 0x6e, 0xce, 0x7c, 0x39, 0xa6, 0x24,
-	0xa5, 0x6c, 0xa4, 0x7a, 0x17, 0xb3, 0x39, 0xed, 0xf8, 0xb5, 0xb2, 0xa3, 0x39, 0x04, 0x3b, 0x00,
-	0x9a, 0x69, 0x8c, 0x88, 0x9c, 0x65, 0xbe, 0xba, 0xff, 0x75, 0x35, 0x57, 0xdd, 0x42, 0xed, 0x45,
-	0xca, 0xf9, 0x06, 0x0b, 0x8c, 0x1e, 0x58, 0xe0, 0x2f, 0x00, 0xd4, 0x67, 0xe2, 0x7f, 0xcb, 0x69,
-	0xf9, 0x6d, 0xa9, 0x5b, 0x0b, 0xa5, 0xaf, 0xbd, 0x66, 0xcd, 0x8e, 0x46, 0x97, 0x9c, 0x9a, 0x5d,
-	0x5c, 0x5a, 0xb6, 0xe5, 0xac, 0x5f, 0x5a, 0xf6, 0xa6, 0x63, 0x97, 0xf9, 0x33, 0xbb, 0x40, 0x3b,
-	0x13, 0x3c, 0xb3, 0xbc, 0xce, 0x2f, 0xbf, 0xbf, 0xdb, 0x5b, 0xfd, 0xe1, 0x6e, 0x6f, 0xf5, 0xdf,
-	0x77, 0x7b, 0xab, 0x7f, 0x7d, 0xbf, 0xb7, 0xf2, 0xc3, 0xfb, 0xbd, 0x95, 0x7f, 0xbe, 0xdf, 0x5b,
-	0xf9, 0xdd, 0xec, 0xfb, 0x40, 0x06, 0xf2, 0x79, 0xd0, 0x3f, 0x07, 0xc7, 0xa7, 0x47, 0x43, 0xf5,
-	0x0f, 0x03, 0xf5, 0x46, 0x74, 0x37, 0xd4, 0x3f, 0x02, 0x3e, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x36, 0x0e, 0xa8, 0xa0, 0x4e, 0x10, 0x00, 0x00,
+	// 1733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0x23, 0xb7,
+	0x15, 0xb6, 0x2d, 0xd9, 0x1e, 0x51, 0x7f, 0x63, 0x5a, 0x76, 0x94, 0x5d, 0xc4, 0xe3, 0xce, 0x45,
+	0xeb, 0x02, 0x59, 0x2b, 0x76, 0x60, 0x74, 0x91, 0xa0, 0x45, 0xad, 0xb5, 0x93, 0xd8, 0xdd, 0xa4,
+	0x06, 0xed, 0x45, 0x81, 0x02, 0xc5, 0x80, 0x9a, 0x61, 0x46, 0x13, 0xcf, 0x0c, 0x05, 0x92, 0xa3,
+	0x95, 0xda, 0x3e, 0x40, 0x81, 0xde, 0xf4, 0x09, 0x8a, 0xbc, 0x44, 0x81, 0x3e, 0x42, 0xd0, 0xab,
+	0x5c, 0x16, 0xb9, 0x18, 0x14, 0xde, 0x3b, 0x5f, 0xfa, 0x09, 0x0a, 0xfe, 0x68, 0xf4, 0x63, 0x23,
+	0x88, 0x7d, 0x63, 0xf1, 0x3b, 0xe7, 0xf0, 0xfb, 0xc8, 0xc3, 0xc3, 0x21, 0x69, 0xf0, 0x8c, 0x88,
+	0x3e, 0x61, 0x49, 0x94, 0x8a, 0x0e, 0x19, 0x26, 0x9d, 0xe1, 0x81, 0xfc, 0xd9, 0x1f, 0x30, 0x2a,
+	0x28, 0xb4, 0x0b, 0xdf, 0xbe, 0x34, 0x0e, 0x0f, 0x9e, 0xb5, 0x42, 0x1a, 0x52, 0xe5, 0xec, 0xc8,
+	0x96, 0x8e, 0x73, 0xff, 0x5d, 0x02, 0x6b, 0x17, 0x98, 0xe1, 0x84, 0xc3, 0x03, 0x50, 0x21, 0xc3,
+	0xc4, 0x0b, 0x48, 0x4a, 0x93, 0xf6, 0xf2, 0xee, 0xf2, 0x5e, 0xa5, 0xdb, 0xba, 0xcb, 0x1d, 0x7b,
+	0x8c, 0x93, 0xf8, 0x13, 0xb7, 0x70, 0xb9, 0xc8, 0x22, 0xc3, 0xe4, 0x44, 0x36, 0xe1, 0xaf, 0x41,
+	0x9d, 0xa4, 0xb8, 0x17, 0x13, 0xcf, 0x67, 0x04, 0x0b, 0xd2, 0x5e, 0xd9, 0x5d, 0xde, 0xb3, 0xba,
+	0xed, 0xbb, 0xdc, 0x69, 0x99, 0x6e, 0xb3, 0x6e, 0x17, 0xd5, 0x34, 0x7e, 0xa5, 0x20, 0xfc, 0x15,
+	0xa8, 0x4e, 0xfc, 0x38, 0x8e, 0xdb, 0x25, 0xd5, 0x79, 0xfb, 0x2e, 0x77, 0xe0, 0x7c, 0x67, 0x1c,
+	0xc7, 0x2e, 0x02, 0xa6, 0x2b, 0x8e, 0x63, 0x78, 0x0c, 0x00, 0x19, 0x09, 0x86, 0x3d, 0x12, 0x0d,
+	0x78, 0xbb, 0xbc, 0x5b, 0xda, 0x2b, 0x75, 0xdd, 0x9b, 0xdc, 0xa9, 0x9c, 0x4a, 0xeb, 0xe9, 0xd9,
+	0x05, 0xbf, 0xcb, 0x9d, 0x0d, 0x43, 0x52, 0x04, 0xba, 0xa8, 0xa2, 0xc0, 0x69, 0x34, 0xe0, 0xf0,
+	0x4f, 0xa0, 0xe6, 0xf7, 0x71, 0x94, 0x7a, 0x3e, 0x4d, 0xbf, 0x8e, 0xc2, 0xf6, 0xea, 0xee, 0xf2,
+	0x5e, 0xf5, 0xf0, 0x83, 0xfd, 0xc5, 0xbc, 0xed, 0xbf, 0x92, 0x51, 0xaf, 0x54, 0x50, 0xf7, 0xf9,
+	0x77, 0xb9, 0xb3, 0x74, 0x97, 0x3b, 0x9b, 0x9a, 0x7a, 0x96, 0xc0, 0x45, 0x55, 0x7f, 0x1a, 0x09,
+	0x0f, 0xc1, 0x16, 0x8e, 0x63, 0xfa, 0xd6, 0xcb, 0x52, 0x99, 0x68, 0xe2, 0x0b, 0x12, 0x78, 0x62,
+	0xc4, 0xdb, 0x6b, 0x72, 0x92, 0x68, 0x53, 0x39, 0xdf, 0x4c, 0x7d, 0x57, 0x23, 0x0e, 0x5f, 0x00,
+	0x88, 0x7d, 0x11, 0x0d, 0x89, 0x37, 0x60, 0xc4, 0xa7, 0xc9, 0x20, 0x8a, 0x09, 0x6f, 0xaf, 0xef,
+	0x96, 0xf6, 0x2a, 0x68, 0x43, 0x7b, 0x2e, 0xa6, 0x0e, 0xf7, 0x9f, 0x1b, 0xa0, 0x3a, 0x33, 0x38,
+	0x98, 0x80, 0x66, 0x9f, 0x26, 0x84, 0x0b, 0x82, 0x03, 0xaf, 0x17, 0x53, 0xff, 0xda, 0xac, 0xe2,
+	0xc9, 0x0f, 0xb9, 0xf3, 0xf3, 0x30, 0x12, 0xfd, 0xac, 0xb7, 0xef, 0xd3, 0xa4, 0xe3, 0x53, 0x9e,
+	0x50, 0x6e, 0x7e, 0x5e, 0xf0, 0xe0, 0xba, 0x23, 0xc6, 0x03, 0xc2, 0xf7, 0xcf, 0x52, 0x71, 0x97,
+	0x3b, 0xdb, 0x7a, 0x6e, 0x0b, 0x54, 0x2e, 0x6a, 0x14, 0x96, 0xae, 0x34, 0xc0, 0x31, 0x68, 0x04,
+	0x98, 0x7a, 0x5f, 0x53, 0x76, 0x6d, 0xd4, 0x56, 0x94, 0xda, 0xe5, 0x4f, 0x57, 0xbb, 0xc9, 0x9d,
+	0xda, 0xc9, 0xf1, 0xef, 0x3f, 0xa3, 0xec, 0x5a, 0x71, 0xde, 0xe5, 0xce, 0x96, 0x56, 0x9f, 0x67,
+	0x76, 0x51, 0x2d, 0xc0, 0xb4, 0x08, 0x83, 0x7f, 0x00, 0x76, 0x11, 0xc0, 0xb3, 0xc1, 0x80, 0x32,
+	0x61, 0x8a, 0xe7, 0xc5, 0x4d, 0xee, 0x34, 0x0c, 0xe5, 0xa5, 0xf6, 0xdc, 0xe5, 0xce, 0x7b, 0x0b,
+	0xa4, 0xa6, 0x8f, 0x8b, 0x1a, 0x86, 0xd6, 0x84, 0x42, 0x0e, 0x6a, 0x24, 0x1a, 0x1c, 0x1c, 0x7d,
+	0x64, 0x66, 0x54, 0x56, 0x33, 0xba, 0x78, 0xd4, 0x8c, 0xaa, 0xa7, 0x67, 0x17, 0x07, 0x47, 0x1f,
+	0x4d, 0x26, 0x64, 0x4a, 0x65, 0x96, 0xd6, 0x45, 0x55, 0x0d, 0xf5, 0x6c, 0xce, 0x80, 0x81, 0x5e,
+	0x1f, 0xf3, 0xbe, 0x2a, 0xc4, 0x4a, 0x77, 0xef, 0x26, 0x77, 0x80, 0x66, 0xfa, 0x02, 0xf3, 0xfe,
+	0x74, 0x5d, 0x7a, 0xe3, 0x3f, 0xe3, 0x54, 0x44, 0x59, 0x32, 0xe1, 0x02, 0xba, 0xb3, 0x8c, 0x2a,
+	0xc6, 0x7f, 0x64, 0xc6, 0xbf, 0xf6, 0xe4, 0xf1, 0x1f, 0x3d, 0x34, 0xfe, 0xa3, 0xf9, 0xf1, 0xeb,
+	0x98, 0x42, 0xf4, 0xa5, 0x11, 0x5d, 0x7f, 0xb2, 0xe8, 0xcb, 0x87, 0x44, 0x5f, 0xce, 0x8b, 0xea,
+	0x18, 0x59, 0xec, 0x0b, 0x99, 0x68, 0x5b, 0x4f, 0x2f, 0xf6, 0x7b, 0x49, 0x6d, 0x14, 0x16, 0x2d,
+	0xf7, 0x57, 0xd0, 0xf2, 0x69, 0xca, 0x85, 0xb4, 0xa5, 0x74, 0x10, 0x13, 0xa3, 0x59, 0x51, 0x9a,
+	0x67, 0x8f, 0xd2, 0x7c, 0x6e, 0x3e, 0x1e, 0x0f, 0xf0, 0xb9, 0x68, 0x73, 0xde, 0xac, 0xd5, 0x07,
+	0xc0, 0x1e, 0x10, 0x41, 0x18, 0xef, 0x65, 0x2c, 0x34, 0xca, 0x40, 0x29, 0x9f, 0x3e, 0x4a, 0xd9,
+	0xec, 0x83, 0x45, 0x2e, 0x17, 0x35, 0xa7, 0x26, 0xad, 0xf8, 0x0d, 0x68, 0x44, 0x72, 0x18, 0xbd,
+	0x2c, 0x36, 0x7a, 0x55, 0xa5, 0xf7, 0xea, 0x51, 0x7a, 0x66, 0x33, 0xcf, 0x33, 0xb9, 0xa8, 0x3e,
+	0x31, 0x68, 0xad, 0x0c, 0xc0, 0x24, 0x8b, 0x98, 0x17, 0xc6, 0xd8, 0x8f, 0x08, 0x33, 0x7a, 0x35,
+	0xa5, 0xf7, 0xf9, 0xa3, 0xf4, 0xde, 0xd7, 0x7a, 0xf7, 0xd9, 0x5c, 0x64, 0x4b, 0xe3, 0xe7, 0xda,
+	0xa6, 0x65, 0x03, 0x50, 0xeb, 0x11, 0x16, 0x47, 0xa9, 0x11, 0xac, 0x2b, 0xc1, 0xe3, 0x47, 0x09,
+	0x9a, 0x3a, 0x9d, 0xe5, 0x71, 0x51, 0x55, 0xc3, 0x42, 0x25, 0xa6, 0x69, 0x40, 0x27, 0x2a, 0x1b,
+	0x4f, 0x57, 0x99, 0xe5, 0x71, 0x51, 0x55, 0x43, 0xad, 0x32, 0x02, 0x9b, 0x98, 0x31, 0xfa, 0x76,
+	0x21, 0x87, 0x50, 0x89, 0x7d, 0xf1, 0x28, 0xb1, 0x67, 0x5a, 0xec, 0x01, 0x3a, 0x17, 0x6d, 0x28,
+	0xeb, 0x5c, 0x16, 0x33, 0x00, 0x43, 0x86, 0xc7, 0x0b, 0xc2, 0xad, 0xa7, 0x2f, 0xde, 0x7d, 0x36,
+	0x17, 0xd9, 0xd2, 0x38, 0x27, 0xfb, 0x17, 0xd0, 0x4a, 0x08, 0x0b, 0x89, 0x97, 0x12, 0xc1, 0x07,
+	0x71, 0x24, 0x8c, 0xf0, 0xd6, 0xd3, 0xf7, 0xe3, 0x43, 0x7c, 0x2e, 0x82, 0xca, 0xfc, 0x95, 0xb1,
+	0x16, 0x9b, 0x83, 0xf7, 0x71, 0x1a, 0xf6, 0x71, 0x64, 0x64, 0xb7, 0x9f, 0xbe, 0x39, 0xe6, 0x99,
+	0x5c, 0x54, 0x9f, 0x18, 0x8a, 0xfa, 0xf1, 0x71, 0xea, 0x67, 0x93, 0xfa, 0x79, 0xef, 0xe9, 0xf5,
+	0x33, 0xcb, 0x23, 0x6f, 0x2b, 0x0a, 0x2a, 0x95, 0xf3, 0xb2, 0xd5, 0xb0, 0x9b, 0xe7, 0x65, 0xab,
+	0x69, 0xdb, 0xe7, 0x65, 0xcb, 0xb6, 0x37, 0xce, 0xcb, 0xd6, 0xa6, 0xdd, 0x42, 0xf5, 0x31, 0x8d,
+	0xa9, 0x37, 0xfc, 0x58, 0x77, 0x42, 0x55, 0xf2, 0x16, 0x73, 0xf3, 0x8d, 0x44, 0x0d, 0x1f, 0x0b,
+	0x1c, 0x8f, 0xb9, 0x49, 0x15, 0xb2, 0x75, 0x02, 0x67, 0x4e, 0xed, 0x0e, 0x58, 0xbd, 0x14, 0xf2,
+	0x9e, 0x67, 0x83, 0xd2, 0x35, 0x19, 0xeb, 0xdb, 0x08, 0x92, 0x4d, 0xd8, 0x02, 0xab, 0x43, 0x1c,
+	0x67, 0xfa, 0xc2, 0x58, 0x41, 0x1a, 0xb8, 0x17, 0xa0, 0x79, 0xc5, 0x70, 0xca, 0xe5, 0x5d, 0x87,
+	0xa6, 0xaf, 0x69, 0xc8, 0x21, 0x04, 0x65, 0x75, 0x2a, 0xea, 0xbe, 0xaa, 0x0d, 0x7f, 0x09, 0xca,
+	0x31, 0x0d, 0x79, 0x7b, 0x65, 0xb7, 0xb4, 0x57, 0x3d, 0xdc, 0xba, 0x7f, 0x65, 0x7b, 0x4d, 0x43,
+	0xa4, 0x42, 0xdc, 0xff, 0xac, 0x80, 0xd2, 0x6b, 0x1a, 0xc2, 0x36, 0x58, 0xc7, 0x41, 0xc0, 0x08,
+	0xe7, 0x86, 0x69, 0x02, 0xe1, 0x36, 0x58, 0x13, 0x74, 0x10, 0xf9, 0x9a, 0xae, 0x82, 0x0c, 0x92,
+	0xc2, 0x01, 0x16, 0x58, 0xdd, 0x2b, 0x6a, 0x48, 0xb5, 0xe1, 0x21, 0xa8, 0xa9, 0x99, 0x79, 0x69,
+	0x96, 0xf4, 0x08, 0x53, 0xd7, 0x83, 0x72, 0xb7, 0x79, 0x9b, 0x3b, 0x55, 0x65, 0xff, 0x4a, 0x99,
+	0xd1, 0x2c, 0x80, 0x1f, 0x82, 0x75, 0x31, 0x9a, 0x3d, 0xd9, 0x37, 0x6f, 0x73, 0xa7, 0x29, 0xa6,
+	0xd3, 0x94, 0x07, 0x37, 0x5a, 0x13, 0x23, 0xf9, 0x0b, 0x3b, 0xc0, 0x12, 0x23, 0x2f, 0x4a, 0x03,
+	0x32, 0x52, 0x87, 0x77, 0xb9, 0xdb, 0xba, 0xcd, 0x1d, 0x7b, 0x26, 0xfc, 0x4c, 0xfa, 0xd0, 0xba,
+	0x18, 0xa9, 0x06, 0xfc, 0x10, 0x00, 0x3d, 0x24, 0xa5, 0xa0, 0x8f, 0xde, 0xfa, 0x6d, 0xee, 0x54,
+	0x94, 0x55, 0x71, 0x4f, 0x9b, 0xd0, 0x05, 0xab, 0x9a, 0xdb, 0x52, 0xdc, 0xb5, 0xdb, 0xdc, 0xb1,
+	0x62, 0x1a, 0x6a, 0x4e, 0xed, 0x92, 0xa9, 0x62, 0x24, 0xa1, 0x43, 0x12, 0xa8, 0xd3, 0xcd, 0x42,
+	0x13, 0xe8, 0xfe, 0x7d, 0x05, 0x58, 0x57, 0x23, 0x44, 0x78, 0x16, 0x0b, 0xf8, 0x19, 0xb0, 0x7d,
+	0x9a, 0x0a, 0x86, 0x7d, 0xe1, 0xcd, 0xa5, 0xb6, 0xfb, 0x7c, 0x7a, 0xd2, 0x2c, 0x46, 0xb8, 0xa8,
+	0x39, 0x31, 0x1d, 0x9b, 0xfc, 0xb7, 0xc0, 0x6a, 0x2f, 0xa6, 0x34, 0x51, 0x95, 0x50, 0x43, 0x1a,
+	0x40, 0xa4, 0xb2, 0xa6, 0x56, 0xb9, 0xa4, 0x2e, 0xe6, 0x3f, 0xbb, 0xbf, 0xca, 0x0b, 0xa5, 0xd2,
+	0xdd, 0x36, 0x97, 0xf3, 0x86, 0xd6, 0x36, 0xfd, 0x5d, 0x99, 0x5b, 0x55, 0x4a, 0x36, 0x28, 0x31,
+	0x22, 0xd4, 0xa2, 0xd5, 0x90, 0x6c, 0xc2, 0x67, 0xc0, 0x62, 0x64, 0x48, 0x98, 0x20, 0x81, 0x5a,
+	0x1c, 0x0b, 0x15, 0x18, 0xbe, 0x0f, 0xac, 0x10, 0x73, 0x2f, 0xe3, 0x24, 0xd0, 0x2b, 0x81, 0xd6,
+	0x43, 0xcc, 0xdf, 0x70, 0x12, 0x7c, 0x52, 0xfe, 0xdb, 0xb7, 0xce, 0x92, 0x8b, 0x41, 0xf5, 0xd8,
+	0xf7, 0x09, 0xe7, 0x57, 0xd9, 0x20, 0x26, 0x3f, 0x52, 0x61, 0x87, 0xa0, 0xc6, 0x05, 0x65, 0x38,
+	0x24, 0xde, 0x35, 0x19, 0x9b, 0x3a, 0xd3, 0x55, 0x63, 0xec, 0xbf, 0x23, 0x63, 0x8e, 0x66, 0x81,
+	0x91, 0xf8, 0xb6, 0x0c, 0xaa, 0x57, 0x0c, 0xfb, 0xc4, 0xdc, 0xf0, 0x65, 0xad, 0x4a, 0xc8, 0x8c,
+	0x84, 0x41, 0x52, 0x5b, 0x44, 0x09, 0xa1, 0x99, 0x30, 0xfb, 0x69, 0x02, 0x65, 0x0f, 0x46, 0xc8,
+	0x88, 0xf8, 0x2a, 0x8d, 0x65, 0x64, 0x10, 0x3c, 0x02, 0xf5, 0x20, 0xe2, 0xea, 0x75, 0xc5, 0x05,
+	0xf6, 0xaf, 0xf5, 0xf4, 0xbb, 0xf6, 0x6d, 0xee, 0xd4, 0x8c, 0xe3, 0x52, 0xda, 0xd1, 0x1c, 0x82,
+	0x9f, 0x82, 0xe6, 0xb4, 0x9b, 0x1a, 0xad, 0x7e, 0xcf, 0x74, 0xe1, 0x6d, 0xee, 0x34, 0x8a, 0x50,
+	0xe5, 0x41, 0x0b, 0x58, 0xae, 0x74, 0x40, 0x7a, 0x59, 0xa8, 0x8a, 0xcf, 0x42, 0x1a, 0x48, 0x6b,
+	0x1c, 0x25, 0x91, 0x50, 0xc5, 0xb6, 0x8a, 0x34, 0x80, 0x9f, 0x82, 0x0a, 0x1d, 0x12, 0xc6, 0xa2,
+	0x80, 0xf0, 0x36, 0xf8, 0x09, 0x4f, 0x33, 0x34, 0x8d, 0x97, 0x93, 0x33, 0x2f, 0xc7, 0x84, 0x24,
+	0x94, 0x8d, 0xdb, 0xd5, 0xe9, 0xe4, 0xb4, 0xe3, 0x4b, 0x65, 0x47, 0x73, 0x08, 0x76, 0x01, 0x34,
+	0xdd, 0x18, 0x11, 0x19, 0x4b, 0x3d, 0xb5, 0xff, 0x6b, 0xaa, 0xaf, 0xda, 0x85, 0xda, 0x8b, 0x94,
+	0xf3, 0x04, 0x0b, 0x8c, 0xee, 0x59, 0xe0, 0x6f, 0x00, 0xd4, 0x6b, 0xe2, 0x7d, 0xc3, 0x69, 0xf1,
+	0xb6, 0xd4, 0x57, 0x0b, 0xa5, 0xaf, 0xbd, 0x66, 0xcc, 0xb6, 0x46, 0xe7, 0x9c, 0x9a, 0x59, 0x9c,
+	0x97, 0xad, 0xb2, 0xbd, 0x7a, 0x5e, 0xb6, 0xd6, 0x6d, 0xab, 0xc8, 0x9f, 0x99, 0x05, 0xda, 0x9c,
+	0xe0, 0x99, 0xe1, 0xb9, 0xff, 0x5a, 0x06, 0xf5, 0xcb, 0x01, 0x4d, 0x39, 0x65, 0x17, 0x34, 0x8e,
+	0xfc, 0xb1, 0x2c, 0x06, 0xae, 0x0d, 0x93, 0x42, 0x34, 0x50, 0x96, 0xfb, 0x64, 0xf7, 0x99, 0x3a,
+	0x29, 0x30, 0xfc, 0x05, 0x68, 0xaa, 0x27, 0x29, 0x09, 0xbc, 0x84, 0x88, 0x3e, 0x0d, 0xe4, 0xc6,
+	0x93, 0xdf, 0xc3, 0x86, 0x31, 0x7f, 0xa9, 0xad, 0xf0, 0x14, 0x34, 0x7b, 0x59, 0x10, 0x12, 0xe1,
+	0x0d, 0x08, 0x93, 0xdb, 0x83, 0x99, 0x57, 0xd2, 0x07, 0x72, 0xfb, 0xfd, 0x90, 0x3b, 0x5b, 0xfa,
+	0x14, 0xe2, 0xc1, 0xf5, 0x7e, 0x44, 0x3b, 0x09, 0x16, 0x7d, 0x79, 0x0c, 0xa1, 0xba, 0xee, 0x75,
+	0x41, 0xd8, 0x1b, 0x4e, 0x58, 0xf7, 0xb7, 0xdf, 0xdd, 0xec, 0x2c, 0x7f, 0x7f, 0xb3, 0xb3, 0xfc,
+	0xbf, 0x9b, 0x9d, 0xe5, 0x7f, 0xbc, 0xdb, 0x59, 0xfa, 0xfe, 0xdd, 0xce, 0xd2, 0x7f, 0xdf, 0xed,
+	0x2c, 0xfd, 0x71, 0xf6, 0x5c, 0x23, 0x43, 0x79, 0xac, 0xe9, 0xbf, 0xc3, 0x83, 0xa3, 0xce, 0x48,
+	0xb6, 0xf5, 0xd9, 0xd6, 0x5b, 0x53, 0xff, 0xc0, 0xf8, 0xf8, 0xff, 0x03, 0x00, 0x33, 0xc3, 0xd4,
+	0x0b, 0x06, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1549,6 +1626,62 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BudgetPerUser.Size()
+		i -= size
+		if _, err := m.BudgetPerUser.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedMethods) > 0 {
+		for iNdEx := len(m.AllowedMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMethods[iNdEx])
+			copy(dAtA[i:], m.AllowedMethods[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1854,6 +1987,31 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *SponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.AllowedMethods) > 0 {
+		for _, s := range m.AllowedMethods {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.BudgetPerUser.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4032,6 +4190,186 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SponsorPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMethods = append(m.AllowedMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetPerUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BudgetPerUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
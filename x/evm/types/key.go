@@ -3,6 +3,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -28,6 +30,8 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixSponsorPolicy
+	prefixSponsorSpent
 )
 
 // prefix bytes for the EVM transient store
@@ -36,6 +40,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeePayer
+	prefixTransientFeeTokenSwap
 )
 
 // KVStore key prefixes
@@ -43,14 +49,20 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixSponsorPolicy = []byte{prefixSponsorPolicy}
+	KeyPrefixSponsorSpent  = []byte{prefixSponsorSpent}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeePayer = []byte{prefixTransientFeePayer}
+
+	KeyPrefixTransientFeeTokenSwap = []byte{prefixTransientFeeTokenSwap}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -62,3 +74,32 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// SponsorPolicyKey defines the key under which the policy of a sponsor for a contract is stored.
+// The policies are prefixed by the contract address to iterate over all the sponsors of a contract.
+func SponsorPolicyKey(contract common.Address, sponsor sdk.AccAddress) []byte {
+	return append(append(KeyPrefixSponsorPolicy, contract.Bytes()...), sponsor.Bytes()...)
+}
+
+// SponsorSpentKey defines the key under which the fees paid by a sponsor for a user of a contract
+// are stored.
+func SponsorSpentKey(contract common.Address, sponsor, user sdk.AccAddress) []byte {
+	return append(SponsorSpentPrefix(contract, sponsor), user.Bytes()...)
+}
+
+// SponsorSpentPrefix returns a prefix to iterate over the fees paid by a sponsor for the users of a contract.
+func SponsorSpentPrefix(contract common.Address, sponsor sdk.AccAddress) []byte {
+	return append(append(KeyPrefixSponsorSpent, contract.Bytes()...), address.MustLengthPrefix(sponsor)...)
+}
+
+// TransientFeePayerKey defines the key under which the fee payer of an Ethereum transaction is
+// stored, identified by the sender address and nonce.
+func TransientFeePayerKey(sender common.Address, nonce uint64) []byte {
+	return append(append(KeyPrefixTransientFeePayer, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// TransientFeeTokenSwapKey defines the key under which the fees swapped from whitelisted fee tokens
+// for an Ethereum transaction are stored, identified by the sender address and nonce.
+func TransientFeeTokenSwapKey(sender common.Address, nonce uint64) []byte {
+	return append(append(KeyPrefixTransientFeeTokenSwap, sender.Bytes()...), sdk.Uint64ToBigEndian(nonce)...)
+}
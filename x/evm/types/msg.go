@@ -31,6 +31,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgSetSponsorPolicy{}
+	_ sdk.Msg    = &MsgRemoveSponsorPolicy{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -388,3 +390,71 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgSetSponsorPolicy creates a new MsgSetSponsorPolicy instance.
+func NewMsgSetSponsorPolicy(sponsor sdk.AccAddress, contract common.Address, allowedMethods []string, budgetPerUser sdkmath.Int) *MsgSetSponsorPolicy {
+	return &MsgSetSponsorPolicy{
+		Sponsor:        sponsor.String(),
+		Contract:       contract.Hex(),
+		AllowedMethods: allowedMethods,
+		BudgetPerUser:  budgetPerUser,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgSetSponsorPolicy message.
+func (m MsgSetSponsorPolicy) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetSponsorPolicy) ValidateBasic() error {
+	return m.Policy().Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetSponsorPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// Policy returns the sponsor policy defined by the message.
+func (m MsgSetSponsorPolicy) Policy() SponsorPolicy {
+	return SponsorPolicy{
+		Sponsor:        m.Sponsor,
+		Contract:       m.Contract,
+		AllowedMethods: m.AllowedMethods,
+		BudgetPerUser:  m.BudgetPerUser,
+	}
+}
+
+// NewMsgRemoveSponsorPolicy creates a new MsgRemoveSponsorPolicy instance.
+func NewMsgRemoveSponsorPolicy(sponsor sdk.AccAddress, contract common.Address) *MsgRemoveSponsorPolicy {
+	return &MsgRemoveSponsorPolicy{
+		Sponsor:  sponsor.String(),
+		Contract: contract.Hex(),
+	}
+}
+
+// GetSigners returns the expected signers for a MsgRemoveSponsorPolicy message.
+func (m MsgRemoveSponsorPolicy) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sponsor)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveSponsorPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+	if err := types.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveSponsorPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
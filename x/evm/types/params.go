@@ -34,11 +34,23 @@ var (
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
+		"0x0000000000000000000000000000000000000804", // Bank precompile
+		"0x0000000000000000000000000000000000000805", // Gov precompile
+		"0x0000000000000000000000000000000000000806", // Authz precompile
+		"0x0000000000000000000000000000000000000807", // Slashing precompile
+		"0x0000000000000000000000000000000000000808", // Multicall precompile
+		"0x0000000000000000000000000000000000000809", // IBC precompile
 		"0x0000000000000000000000000000000000000900", // Stride outpost
+		"0x0000000000000000000000000000000000000901", // Osmosis outpost
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
 	DefaultExtraEIPs = []int64{3855}
+	// ShanghaiEIPs defines the EIPs enabled from the Shanghai block: PUSH0 (EIP-3855)
+	ShanghaiEIPs = []int{3855}
+	// CancunEIPs defines the EIPs enabled from the Cancun block that don't depend on the
+	// EIP-4844 blob transactions: TLOAD and TSTORE (EIP-1153) and MCOPY (EIP-5656).
+	CancunEIPs = []int{1153, 5656}
 )
 
 // NewParams creates a new Params instance
@@ -104,6 +116,16 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	// the EVM skips the extra EIPs that it can't activate, so the hard forks can only
+	// be scheduled if the EVM implements their EIPs
+	if err := validateForkEIPs("Shanghai", p.ChainConfig.ShanghaiBlock != nil, ShanghaiEIPs); err != nil {
+		return err
+	}
+
+	if err := validateForkEIPs("Cancun", p.ChainConfig.CancunBlock != nil, CancunEIPs); err != nil {
+		return err
+	}
+
 	return ValidatePrecompiles(p.ActivePrecompiles)
 }
 
@@ -116,6 +138,28 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// ActiveEIPs returns the extra EIPs enabled on the parameters together with the EIPs of the
+// Shanghai and Cancun hard forks activated at the given height, without duplicates.
+func (p Params) ActiveEIPs(ethCfg *params.ChainConfig, height int64) []int {
+	eips := p.EIPs()
+
+	blockNumber := big.NewInt(height)
+	if ethCfg.IsShanghai(blockNumber) {
+		eips = append(eips, ShanghaiEIPs...)
+	}
+	if ethCfg.IsCancun(blockNumber) {
+		eips = append(eips, CancunEIPs...)
+	}
+
+	activeEIPs := make([]int, 0, len(eips))
+	for _, eip := range eips {
+		if !slices.Contains(activeEIPs, eip) {
+			activeEIPs = append(activeEIPs, eip)
+		}
+	}
+	return activeEIPs
+}
+
 // HasCustomPrecompiles returns true if the ActivePrecompiles slice is not empty.
 func (p Params) HasCustomPrecompiles() bool {
 	return len(p.ActivePrecompiles) > 0
@@ -158,6 +202,22 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+// validateForkEIPs checks that the EVM can activate the EIPs of the given hard fork
+// if it's scheduled on the chain config.
+func validateForkEIPs(fork string, scheduled bool, eips []int) error {
+	if !scheduled {
+		return nil
+	}
+
+	for _, eip := range eips {
+		if !vm.ValidEip(eip) {
+			return fmt.Errorf("EIP %d of the %s hard fork is not activateable, valid EIPs are: %s", eip, fork, vm.ActivateableEips())
+		}
+	}
+
+	return nil
+}
+
 func validateEIPs(i interface{}) error {
 	eips, ok := i.([]int64)
 	if !ok {
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -93,6 +94,33 @@ func TestParamsEIPs(t *testing.T) {
 	require.Equal(t, []int{2929, 1884, 1344}, actual)
 }
 
+func TestParamsActiveEIPs(t *testing.T) {
+	extraEips := []int64{2929, 3855}
+	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
+
+	chainConfig := DefaultChainConfig()
+	shanghaiBlock := sdk.NewInt(10)
+	chainConfig.ShanghaiBlock = &shanghaiBlock
+	chainConfig.CancunBlock = &shanghaiBlock
+	ethCfg := chainConfig.EthereumConfig(nil)
+
+	require.Equal(t, []int{2929, 3855}, params.ActiveEIPs(ethCfg, 9))
+	// PUSH0 is not duplicated after the Shanghai block
+	require.Equal(t, []int{2929, 3855, 1153, 5656}, params.ActiveEIPs(ethCfg, 10))
+
+	params.ExtraEIPs = nil
+	require.Empty(t, params.ActiveEIPs(ethCfg, 9))
+	require.Equal(t, []int{3855, 1153, 5656}, params.ActiveEIPs(ethCfg, 10))
+}
+
+func TestValidateForkEIPs(t *testing.T) {
+	require.NoError(t, validateForkEIPs("Shanghai", true, ShanghaiEIPs))
+	require.NoError(t, validateForkEIPs("Cancun", true, CancunEIPs))
+	// the EIPs of an unscheduled hard fork are not checked
+	require.NoError(t, validateForkEIPs("Cancun", false, []int{9999}))
+	require.ErrorContains(t, validateForkEIPs("Cancun", true, []int{1153, 9999}), "EIP 9999 of the Cancun hard fork is not activateable")
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEVMDenom(false))
 	require.NoError(t, validateEVMDenom("inj"))
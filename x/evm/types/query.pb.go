@@ -39,6 +39,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryAccountRequest struct {
 	// address is the ethereum hex address to query the account for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pending_txs are the binary encoded ethereum transactions of the mempool that
+	// are replayed on top of the state before querying the account.
+	PendingTxs [][]byte `protobuf:"bytes,2,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
 }
 
 func (m *QueryAccountRequest) Reset()         { *m = QueryAccountRequest{} }
@@ -796,6 +799,15 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the set of account overrides applied to the state before
+	// executing the call. It uses the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the set of block header fields overridden while executing
+	// the call. It uses the same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+	// pending_txs are the binary encoded ethereum transactions of the mempool that
+	// are replayed on top of the state before executing the call.
+	PendingTxs [][]byte `protobuf:"bytes,7,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -859,6 +871,27 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetPendingTxs() [][]byte {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1225,6 +1258,342 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// call holds the call arguments and the state and block overrides
+	// to execute the call with.
+	Call *EthCallRequest `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,2,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetCall() *EthCallRequest {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{23}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// EthSimulateRequest defines EthSimulate request
+type EthSimulateRequest struct {
+	// blocks are the blocks of calls to simulate. They are executed in order on
+	// top of the state left by the previous ones.
+	Blocks []EthSimulateBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+	// gas_cap defines the total amount of gas the simulated calls can consume
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EthSimulateRequest) Reset()         { *m = EthSimulateRequest{} }
+func (m *EthSimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*EthSimulateRequest) ProtoMessage()    {}
+func (*EthSimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *EthSimulateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthSimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthSimulateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthSimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthSimulateRequest.Merge(m, src)
+}
+func (m *EthSimulateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthSimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthSimulateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthSimulateRequest proto.InternalMessageInfo
+
+func (m *EthSimulateRequest) GetBlocks() []EthSimulateBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *EthSimulateRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *EthSimulateRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *EthSimulateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// EthSimulateBlock defines a block of calls to simulate
+type EthSimulateBlock struct {
+	// calls are the arguments of the calls to execute, in order. They use the same
+	// json format as the json rpc api.
+	Calls [][]byte `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	// overrides is the set of account overrides applied to the state before
+	// executing the calls of the block. It uses the same json format as the json
+	// rpc api.
+	Overrides []byte `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the set of block header fields overridden while executing
+	// the calls of the block. It uses the same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,3,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *EthSimulateBlock) Reset()         { *m = EthSimulateBlock{} }
+func (m *EthSimulateBlock) String() string { return proto.CompactTextString(m) }
+func (*EthSimulateBlock) ProtoMessage()    {}
+func (*EthSimulateBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *EthSimulateBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthSimulateBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthSimulateBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthSimulateBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthSimulateBlock.Merge(m, src)
+}
+func (m *EthSimulateBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthSimulateBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthSimulateBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthSimulateBlock proto.InternalMessageInfo
+
+func (m *EthSimulateBlock) GetCalls() [][]byte {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *EthSimulateBlock) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthSimulateBlock) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// EthSimulateResponse defines EthSimulate response
+type EthSimulateResponse struct {
+	// blocks are the results of the simulated blocks
+	Blocks []EthSimulateBlockResult `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *EthSimulateResponse) Reset()         { *m = EthSimulateResponse{} }
+func (m *EthSimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*EthSimulateResponse) ProtoMessage()    {}
+func (*EthSimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *EthSimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthSimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthSimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthSimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthSimulateResponse.Merge(m, src)
+}
+func (m *EthSimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthSimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthSimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthSimulateResponse proto.InternalMessageInfo
+
+func (m *EthSimulateResponse) GetBlocks() []EthSimulateBlockResult {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// EthSimulateBlockResult defines the results of a simulated block
+type EthSimulateBlockResult struct {
+	// calls are the results of the calls of the block, in execution order
+	Calls []*MsgEthereumTxResponse `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (m *EthSimulateBlockResult) Reset()         { *m = EthSimulateBlockResult{} }
+func (m *EthSimulateBlockResult) String() string { return proto.CompactTextString(m) }
+func (*EthSimulateBlockResult) ProtoMessage()    {}
+func (*EthSimulateBlockResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{27}
+}
+func (m *EthSimulateBlockResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthSimulateBlockResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthSimulateBlockResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthSimulateBlockResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthSimulateBlockResult.Merge(m, src)
+}
+func (m *EthSimulateBlockResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthSimulateBlockResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthSimulateBlockResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthSimulateBlockResult proto.InternalMessageInfo
+
+func (m *EthSimulateBlockResult) GetCalls() []*MsgEthereumTxResponse {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1234,7 +1603,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1642,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{29}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,6 +1694,12 @@ func init() {
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*EthSimulateRequest)(nil), "ethermint.evm.v1.EthSimulateRequest")
+	proto.RegisterType((*EthSimulateBlock)(nil), "ethermint.evm.v1.EthSimulateBlock")
+	proto.RegisterType((*EthSimulateResponse)(nil), "ethermint.evm.v1.EthSimulateResponse")
+	proto.RegisterType((*EthSimulateBlockResult)(nil), "ethermint.evm.v1.EthSimulateBlockResult")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 }
@@ -1332,99 +1707,114 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xce, 0xc6, 0x4e, 0xec, 0x3c, 0x27, 0x90, 0x0e, 0x01, 0x9c, 0x25, 0x89, 0xc3, 0xb6, 0x89,
-	0x0d, 0x85, 0x5d, 0x92, 0xaa, 0x48, 0xed, 0xa5, 0x60, 0x2b, 0x50, 0x0a, 0x54, 0xd4, 0x8d, 0x7a,
-	0xa8, 0x84, 0xac, 0xf1, 0x7a, 0x58, 0x5b, 0xb1, 0x77, 0xcc, 0xce, 0xd8, 0x72, 0x40, 0x1c, 0x8a,
-	0x50, 0x7f, 0xa8, 0x17, 0xa4, 0xde, 0x7a, 0xe2, 0xde, 0x5b, 0x2f, 0x3d, 0xf6, 0xca, 0x11, 0xa9,
-	0x97, 0xaa, 0x07, 0x5a, 0x41, 0x0f, 0xfd, 0x1b, 0x7a, 0xaa, 0x66, 0x76, 0xd6, 0xde, 0xb5, 0xbd,
-	0x71, 0xa8, 0xe8, 0xad, 0xa7, 0xdd, 0x99, 0x79, 0xf3, 0xbe, 0x6f, 0xde, 0x7b, 0x33, 0xef, 0x83,
-	0x15, 0xc2, 0xeb, 0xc4, 0x6b, 0x35, 0x5c, 0x6e, 0x91, 0x6e, 0xcb, 0xea, 0x6e, 0x59, 0x77, 0x3b,
-	0xc4, 0xdb, 0x37, 0xdb, 0x1e, 0xe5, 0x14, 0x2d, 0xf6, 0x57, 0x4d, 0xd2, 0x6d, 0x99, 0xdd, 0x2d,
-	0xfd, 0xac, 0x4d, 0x59, 0x8b, 0x32, 0xab, 0x8a, 0x19, 0xf1, 0x4d, 0xad, 0xee, 0x56, 0x95, 0x70,
-	0xbc, 0x65, 0xb5, 0xb1, 0xd3, 0x70, 0x31, 0x6f, 0x50, 0xd7, 0xdf, 0xad, 0xeb, 0x23, 0xbe, 0x85,
-	0x13, 0x7f, 0x6d, 0x79, 0x64, 0x8d, 0xf7, 0xd4, 0xd2, 0x92, 0x43, 0x1d, 0x2a, 0x7f, 0x2d, 0xf1,
-	0xa7, 0x66, 0x57, 0x1c, 0x4a, 0x9d, 0x26, 0xb1, 0x70, 0xbb, 0x61, 0x61, 0xd7, 0xa5, 0x5c, 0x22,
-	0x31, 0xb5, 0x9a, 0x53, 0xab, 0x72, 0x54, 0xed, 0xdc, 0xb1, 0x78, 0xa3, 0x45, 0x18, 0xc7, 0xad,
-	0xb6, 0x6f, 0x60, 0xbc, 0x07, 0xc7, 0x3e, 0x11, 0x6c, 0x2f, 0xdb, 0x36, 0xed, 0xb8, 0xbc, 0x4c,
-	0xee, 0x76, 0x08, 0xe3, 0x28, 0x0b, 0x29, 0x5c, 0xab, 0x79, 0x84, 0xb1, 0xac, 0xb6, 0xae, 0x15,
-	0xe6, 0xca, 0xc1, 0xf0, 0xfd, 0xf4, 0xd7, 0x4f, 0x72, 0x53, 0x7f, 0x3d, 0xc9, 0x4d, 0x19, 0x36,
-	0x2c, 0x45, 0xb7, 0xb2, 0x36, 0x75, 0x19, 0x11, 0x7b, 0xab, 0xb8, 0x89, 0x5d, 0x9b, 0x04, 0x7b,
-	0xd5, 0x10, 0x9d, 0x82, 0x39, 0x9b, 0xd6, 0x48, 0xa5, 0x8e, 0x59, 0x3d, 0x3b, 0x2d, 0xd7, 0xd2,
-	0x62, 0xe2, 0x43, 0xcc, 0xea, 0x68, 0x09, 0x66, 0x5c, 0x2a, 0x36, 0x25, 0xd6, 0xb5, 0x42, 0xb2,
-	0xec, 0x0f, 0x8c, 0x0f, 0x60, 0x59, 0x82, 0x94, 0x64, 0x78, 0xff, 0x05, 0xcb, 0x2f, 0x35, 0xd0,
-	0xc7, 0x79, 0x50, 0x64, 0x37, 0xe0, 0x88, 0x9f, 0xb9, 0x4a, 0xd4, 0xd3, 0x82, 0x3f, 0x7b, 0xd9,
-	0x9f, 0x44, 0x3a, 0xa4, 0x99, 0x00, 0x15, 0xfc, 0xa6, 0x25, 0xbf, 0xfe, 0x58, 0xb8, 0xc0, 0xbe,
-	0xd7, 0x8a, 0xdb, 0x69, 0x55, 0x89, 0xa7, 0x4e, 0xb0, 0xa0, 0x66, 0x3f, 0x96, 0x93, 0xc6, 0x75,
-	0x58, 0x91, 0x3c, 0x3e, 0xc3, 0xcd, 0x46, 0x0d, 0x73, 0xea, 0x0d, 0x1d, 0xe6, 0x34, 0xcc, 0xdb,
-	0xd4, 0x1d, 0xe6, 0x91, 0x11, 0x73, 0x97, 0x47, 0x4e, 0xf5, 0xad, 0x06, 0xab, 0x31, 0xde, 0xd4,
-	0xc1, 0xf2, 0x70, 0x34, 0x60, 0x15, 0xf5, 0x18, 0x90, 0x7d, 0x8d, 0x47, 0x0b, 0x8a, 0xa8, 0xe8,
-	0xe7, 0xf9, 0x55, 0xd2, 0x73, 0x41, 0x15, 0x51, 0x7f, 0xeb, 0xa4, 0x22, 0x32, 0xae, 0x2b, 0xb0,
-	0x4f, 0x39, 0xf5, 0xb0, 0x33, 0x19, 0x0c, 0x2d, 0x42, 0x62, 0x8f, 0xec, 0xab, 0x7a, 0x13, 0xbf,
-	0x21, 0xf8, 0x73, 0x0a, 0xbe, 0xef, 0x4c, 0xc1, 0x2f, 0xc1, 0x4c, 0x17, 0x37, 0x3b, 0x01, 0xb8,
-	0x3f, 0x30, 0x2e, 0xc2, 0xa2, 0x2a, 0xa5, 0xda, 0x2b, 0x1d, 0x32, 0x0f, 0x6f, 0x84, 0xf6, 0x29,
-	0x08, 0x04, 0x49, 0x51, 0xfb, 0x72, 0xd7, 0x7c, 0x59, 0xfe, 0x1b, 0xf7, 0x00, 0x49, 0xc3, 0xdd,
-	0xde, 0x0d, 0xea, 0xb0, 0x00, 0x02, 0x41, 0x52, 0xde, 0x18, 0xdf, 0xbf, 0xfc, 0x47, 0x57, 0x00,
-	0x06, 0xef, 0x8a, 0x3c, 0x5b, 0x66, 0x7b, 0xd3, 0xf4, 0x8b, 0xd6, 0x14, 0x8f, 0x90, 0xe9, 0xbf,
-	0x57, 0xea, 0x11, 0x32, 0x6f, 0x0d, 0x42, 0x55, 0x0e, 0xed, 0x0c, 0x91, 0xfc, 0x46, 0x53, 0x81,
-	0x0d, 0xc0, 0x15, 0xcf, 0x33, 0x90, 0x6c, 0x52, 0x47, 0x9c, 0x2e, 0x51, 0xc8, 0x6c, 0x1f, 0x37,
-	0x87, 0x9f, 0x3e, 0xf3, 0x06, 0x75, 0xca, 0xd2, 0x04, 0x5d, 0x1d, 0x43, 0x2a, 0x3f, 0x91, 0x94,
-	0x8f, 0x13, 0x66, 0x65, 0x2c, 0xa9, 0x38, 0xdc, 0xc2, 0x1e, 0x6e, 0x05, 0x71, 0x30, 0x6e, 0x2a,
-	0x82, 0xc1, 0xac, 0x22, 0x78, 0x11, 0x66, 0xdb, 0x72, 0x46, 0x06, 0x28, 0xb3, 0x9d, 0x1d, 0xa5,
-	0xe8, 0xef, 0x28, 0x26, 0x9f, 0x3e, 0xcf, 0x4d, 0x95, 0x95, 0xb5, 0xf1, 0x93, 0x06, 0x47, 0x76,
-	0x78, 0xbd, 0x84, 0x9b, 0xcd, 0x50, 0xa4, 0xb1, 0xe7, 0xb0, 0x20, 0x27, 0xe2, 0x1f, 0x9d, 0x84,
-	0x94, 0x83, 0x59, 0xc5, 0xc6, 0x6d, 0x75, 0x3d, 0x66, 0x1d, 0xcc, 0x4a, 0xb8, 0x8d, 0x6e, 0xc3,
-	0x62, 0xdb, 0xa3, 0x6d, 0xca, 0x88, 0xd7, 0xbf, 0x62, 0xe2, 0x7a, 0xcc, 0x17, 0xb7, 0xff, 0x7e,
-	0x9e, 0x33, 0x9d, 0x06, 0xaf, 0x77, 0xaa, 0xa6, 0x4d, 0x5b, 0x96, 0xea, 0x0d, 0xfe, 0xe7, 0x3c,
-	0xab, 0xed, 0x59, 0x7c, 0xbf, 0x4d, 0x98, 0x59, 0x1a, 0xdc, 0xed, 0xf2, 0xd1, 0xc0, 0x57, 0x70,
-	0x2f, 0x97, 0x21, 0x6d, 0xd7, 0x71, 0xc3, 0xad, 0x34, 0x6a, 0xd9, 0xe4, 0xba, 0x56, 0x48, 0x94,
-	0x53, 0x72, 0x7c, 0xad, 0x66, 0xe4, 0xe1, 0xd8, 0x0e, 0xe3, 0x8d, 0x16, 0xe6, 0xe4, 0x2a, 0x1e,
-	0x04, 0x62, 0x11, 0x12, 0x0e, 0xf6, 0xc9, 0x27, 0xcb, 0xe2, 0xd7, 0x78, 0x94, 0x0c, 0x72, 0xea,
-	0x61, 0x9b, 0xec, 0xf6, 0x82, 0x73, 0x6e, 0x41, 0xa2, 0xc5, 0x1c, 0x15, 0xaf, 0xdc, 0x68, 0xbc,
-	0x6e, 0x32, 0x67, 0x47, 0xcc, 0x91, 0x4e, 0x6b, 0xb7, 0x57, 0x16, 0xb6, 0xe8, 0x12, 0xcc, 0x73,
-	0xe1, 0xa4, 0x62, 0x53, 0xf7, 0x4e, 0xc3, 0x91, 0x27, 0xcd, 0x6c, 0xaf, 0x8e, 0xee, 0x95, 0x50,
-	0x25, 0x69, 0x54, 0xce, 0xf0, 0xc1, 0x00, 0x95, 0x60, 0xbe, 0xed, 0x91, 0x1a, 0xb1, 0x09, 0x63,
-	0xd4, 0x63, 0xd9, 0xa4, 0x2c, 0xa8, 0x89, 0xe8, 0x91, 0x4d, 0xe2, 0x95, 0xac, 0x36, 0xa9, 0xbd,
-	0x17, 0xbc, 0x47, 0x33, 0x32, 0x32, 0x19, 0x39, 0xe7, 0xbf, 0x46, 0x68, 0x15, 0xc0, 0x37, 0x91,
-	0x97, 0x66, 0x56, 0x5e, 0x9a, 0x39, 0x39, 0x23, 0xfb, 0x4c, 0x29, 0x58, 0x16, 0xad, 0x30, 0x9b,
-	0x92, 0xc7, 0xd0, 0x4d, 0xbf, 0x4f, 0x9a, 0x41, 0x9f, 0x34, 0x77, 0x83, 0x3e, 0x59, 0x4c, 0x8b,
-	0xa2, 0x79, 0xfc, 0x7b, 0x4e, 0x53, 0x4e, 0xc4, 0xca, 0xd8, 0xdc, 0xa7, 0xff, 0x9b, 0xdc, 0xcf,
-	0x45, 0x72, 0x8f, 0x0c, 0x58, 0xf0, 0xe9, 0xb7, 0x70, 0xaf, 0x22, 0xd2, 0x0d, 0xa1, 0x08, 0xdc,
-	0xc4, 0xbd, 0xab, 0x98, 0x7d, 0x94, 0x4c, 0x4f, 0x2f, 0x26, 0xca, 0x69, 0xde, 0xab, 0x34, 0xdc,
-	0x1a, 0xe9, 0x19, 0x67, 0xd5, 0x2b, 0xd7, 0xaf, 0x82, 0xc1, 0x13, 0x54, 0xc3, 0x1c, 0x07, 0xe5,
-	0x2e, 0xfe, 0x8d, 0x1f, 0x13, 0x70, 0x62, 0x60, 0x5c, 0x14, 0x5e, 0x43, 0x55, 0xc3, 0x7b, 0xc1,
-	0x43, 0x30, 0xb9, 0x6a, 0x78, 0x8f, 0xbd, 0x86, 0xaa, 0xf9, 0x3f, 0xe1, 0x93, 0x13, 0x6e, 0x9c,
-	0x87, 0x93, 0x23, 0x39, 0x3b, 0x20, 0xc7, 0xc7, 0xfb, 0xfd, 0x9a, 0x91, 0x2b, 0x24, 0xe8, 0x0b,
-	0xc6, 0xed, 0x7e, 0x2f, 0x56, 0xd3, 0xca, 0xc5, 0x0e, 0xa4, 0xc5, 0xe3, 0x5d, 0xb9, 0x43, 0x54,
-	0x3f, 0x2c, 0x9e, 0xfd, 0xed, 0x79, 0x6e, 0xf3, 0x10, 0x67, 0xbe, 0xe6, 0x72, 0xd1, 0xb8, 0xa5,
-	0xbb, 0xed, 0x9f, 0xe7, 0x61, 0x46, 0xfa, 0x47, 0x5f, 0x68, 0x90, 0x52, 0x7a, 0x05, 0x6d, 0x8c,
-	0xd6, 0xc2, 0x18, 0x41, 0xaa, 0x6f, 0x4e, 0x32, 0xf3, 0xb9, 0x1a, 0xf9, 0x87, 0xbf, 0xfc, 0xf9,
-	0xdd, 0xf4, 0x69, 0x94, 0x13, 0xf2, 0x99, 0xb2, 0x40, 0x44, 0x2b, 0xbd, 0x62, 0xdd, 0x57, 0xb9,
-	0x7b, 0x80, 0xbe, 0xd7, 0x60, 0x21, 0x22, 0x09, 0xd1, 0xdb, 0x31, 0x10, 0xe3, 0xa4, 0xa7, 0x7e,
-	0xee, 0x70, 0xc6, 0x8a, 0x95, 0x29, 0x59, 0x15, 0xd0, 0x66, 0x94, 0x55, 0xa0, 0x3c, 0x47, 0xc8,
-	0xfd, 0xa0, 0xc1, 0xe2, 0xb0, 0xb2, 0x43, 0x66, 0x0c, 0x64, 0x8c, 0xa0, 0xd4, 0xad, 0x43, 0xdb,
-	0x2b, 0x96, 0x17, 0x25, 0xcb, 0x0b, 0xc8, 0x8c, 0xb2, 0xec, 0x06, 0xf6, 0x03, 0xa2, 0x61, 0xa1,
-	0xfa, 0x00, 0x3d, 0xd4, 0x20, 0xa5, 0xf4, 0x5b, 0x6c, 0x3a, 0xa3, 0xd2, 0x30, 0x36, 0x9d, 0x43,
-	0x32, 0xd0, 0x28, 0x48, 0x4a, 0x06, 0x5a, 0x8f, 0x52, 0x52, 0x5a, 0x90, 0x85, 0x42, 0xf6, 0x95,
-	0x06, 0x29, 0xa5, 0xe2, 0x62, 0x49, 0x44, 0x25, 0x63, 0x2c, 0x89, 0x21, 0x31, 0x68, 0x9c, 0x97,
-	0x24, 0xf2, 0x68, 0x23, 0x4a, 0x82, 0xf9, 0x66, 0x03, 0x0e, 0xd6, 0xfd, 0x3d, 0xb2, 0xff, 0x00,
-	0x75, 0x21, 0x29, 0x84, 0x1e, 0x32, 0x62, 0x4b, 0xa4, 0xaf, 0x1e, 0xf5, 0x37, 0x0f, 0xb4, 0x51,
-	0xf8, 0x1b, 0x12, 0x3f, 0x87, 0x56, 0x87, 0xab, 0xa7, 0x16, 0x89, 0x00, 0x83, 0x59, 0x5f, 0xe7,
-	0xa0, 0xb7, 0x62, 0xbc, 0x46, 0xe4, 0x94, 0xbe, 0x31, 0xc1, 0x4a, 0xa1, 0xaf, 0x48, 0xf4, 0x13,
-	0x68, 0x29, 0x8a, 0xee, 0x8b, 0x28, 0xc4, 0x21, 0xa5, 0x34, 0x14, 0x5a, 0x1f, 0xf5, 0x17, 0x95,
-	0x57, 0x7a, 0x7e, 0x52, 0xcf, 0x08, 0x30, 0xd7, 0x24, 0x66, 0x16, 0x9d, 0x88, 0x62, 0x12, 0x5e,
-	0xaf, 0xd8, 0x02, 0xea, 0x1e, 0x64, 0x42, 0x02, 0xe8, 0x10, 0xc8, 0x63, 0xce, 0x3a, 0x46, 0x41,
-	0x19, 0x86, 0xc4, 0x5d, 0x41, 0xfa, 0x10, 0xae, 0x32, 0x15, 0xcf, 0x2f, 0xea, 0x41, 0x4a, 0xf5,
-	0xd1, 0xd8, 0x3a, 0x8b, 0xaa, 0xad, 0xd8, 0x3a, 0x1b, 0x6a, 0xc7, 0x71, 0xa7, 0xf6, 0x1b, 0x28,
-	0xef, 0xa1, 0x47, 0x1a, 0xc0, 0xe0, 0x85, 0x47, 0x85, 0x83, 0xdc, 0x86, 0x1b, 0xb7, 0x7e, 0xe6,
-	0x10, 0x96, 0x8a, 0xc3, 0x69, 0xc9, 0xe1, 0x14, 0x5a, 0x1e, 0xc7, 0x41, 0xb6, 0x1c, 0x11, 0x00,
-	0xd5, 0x21, 0x0e, 0xb8, 0xed, 0xe1, 0xc6, 0x72, 0xc0, 0x6d, 0x8f, 0x34, 0x9a, 0xb8, 0x00, 0x04,
-	0xcd, 0xa7, 0x78, 0xe9, 0xe9, 0x8b, 0x35, 0xed, 0xd9, 0x8b, 0x35, 0xed, 0x8f, 0x17, 0x6b, 0xda,
-	0xe3, 0x97, 0x6b, 0x53, 0xcf, 0x5e, 0xae, 0x4d, 0xfd, 0xfa, 0x72, 0x6d, 0xea, 0xf3, 0x70, 0x33,
-	0xea, 0xef, 0xa5, 0xcc, 0xea, 0x6e, 0xbd, 0x6b, 0xf5, 0xa4, 0x1f, 0xd9, 0x90, 0xaa, 0xb3, 0xb2,
-	0xdf, 0xbf, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xb4, 0x4f, 0x77, 0xdf, 0x11, 0x00,
-	0x00,
+	// 1708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xdb, 0x4e, 0xec, 0x3c, 0x27, 0x59, 0x53, 0xf1, 0x64, 0x9d, 0xde, 0x24, 0xf6, 0x34,
+	0xc4, 0xf1, 0x0e, 0x33, 0xdd, 0x9b, 0x00, 0x23, 0x81, 0x84, 0x98, 0x49, 0x94, 0x19, 0x96, 0xdd,
+	0x81, 0xa5, 0x37, 0x02, 0x69, 0xa5, 0x91, 0x55, 0xb6, 0x6b, 0xda, 0x56, 0xec, 0x6e, 0x6f, 0x57,
+	0xd9, 0xea, 0xec, 0x6a, 0x10, 0xac, 0x56, 0x7c, 0x88, 0x03, 0x2b, 0x71, 0xe3, 0xc2, 0xdc, 0xb9,
+	0xf1, 0x27, 0x70, 0x9a, 0xe3, 0x48, 0x5c, 0x10, 0x87, 0x01, 0xcd, 0x70, 0x80, 0x7f, 0x81, 0x13,
+	0xaa, 0x8f, 0xb6, 0xbb, 0xfd, 0xd5, 0x1e, 0x69, 0x90, 0x38, 0x70, 0xea, 0xae, 0xaa, 0x57, 0xef,
+	0xfd, 0xde, 0x47, 0xbd, 0x0f, 0xd8, 0x23, 0xac, 0x4d, 0xfc, 0x5e, 0xc7, 0x65, 0x16, 0x19, 0xf6,
+	0xac, 0xe1, 0xb1, 0xf5, 0xf1, 0x80, 0xf8, 0x57, 0x66, 0xdf, 0xf7, 0x98, 0x87, 0x0a, 0xa3, 0x53,
+	0x93, 0x0c, 0x7b, 0xe6, 0xf0, 0x58, 0xbf, 0xd1, 0xf4, 0x68, 0xcf, 0xa3, 0x56, 0x03, 0x53, 0x22,
+	0x49, 0xad, 0xe1, 0x71, 0x83, 0x30, 0x7c, 0x6c, 0xf5, 0xb1, 0xd3, 0x71, 0x31, 0xeb, 0x78, 0xae,
+	0xbc, 0xad, 0xeb, 0x53, 0xbc, 0x39, 0x13, 0x79, 0xb6, 0x3b, 0x75, 0xc6, 0x02, 0x75, 0x54, 0x74,
+	0x3c, 0xc7, 0x13, 0xbf, 0x16, 0xff, 0x53, 0xbb, 0x7b, 0x8e, 0xe7, 0x39, 0x5d, 0x62, 0xe1, 0x7e,
+	0xc7, 0xc2, 0xae, 0xeb, 0x31, 0x21, 0x89, 0xaa, 0xd3, 0xb2, 0x3a, 0x15, 0xab, 0xc6, 0xe0, 0x91,
+	0xc5, 0x3a, 0x3d, 0x42, 0x19, 0xee, 0xf5, 0x25, 0x81, 0xf1, 0x11, 0x6c, 0xff, 0x90, 0xa3, 0xbd,
+	0xdb, 0x6c, 0x7a, 0x03, 0x97, 0xd9, 0xe4, 0xe3, 0x01, 0xa1, 0x0c, 0x95, 0x20, 0x8b, 0x5b, 0x2d,
+	0x9f, 0x50, 0x5a, 0xd2, 0x2a, 0x5a, 0x6d, 0xdd, 0x0e, 0x97, 0xa8, 0x0c, 0xf9, 0x3e, 0x71, 0x5b,
+	0x1d, 0xd7, 0xa9, 0xb3, 0x80, 0x96, 0x52, 0x95, 0x74, 0x6d, 0xc3, 0x06, 0xb5, 0x75, 0x11, 0xd0,
+	0x6f, 0xe5, 0x7e, 0xf9, 0xa4, 0xbc, 0xf2, 0xcf, 0x27, 0xe5, 0x15, 0xa3, 0x09, 0xc5, 0x38, 0x6f,
+	0xda, 0xf7, 0x5c, 0x4a, 0x38, 0xf3, 0x06, 0xee, 0x62, 0xb7, 0x49, 0x42, 0xe6, 0x6a, 0x89, 0xde,
+	0x82, 0xf5, 0xa6, 0xd7, 0x22, 0xf5, 0x36, 0xa6, 0xed, 0x52, 0x4a, 0x9c, 0xe5, 0xf8, 0xc6, 0x77,
+	0x31, 0x6d, 0xa3, 0x22, 0xac, 0xba, 0x1e, 0xbf, 0x94, 0xae, 0x68, 0xb5, 0x8c, 0x2d, 0x17, 0xc6,
+	0x77, 0x60, 0x57, 0x08, 0x39, 0x13, 0xf6, 0x5f, 0x56, 0x8d, 0x08, 0xca, 0x9f, 0x6b, 0xa0, 0xcf,
+	0xe2, 0xa0, 0xc0, 0x1e, 0xc2, 0x96, 0x74, 0x6d, 0x3d, 0xce, 0x69, 0x53, 0xee, 0xde, 0x55, 0x66,
+	0xd1, 0x21, 0x47, 0xb9, 0x50, 0x8e, 0x2f, 0x25, 0xf0, 0x8d, 0xd6, 0x9c, 0x05, 0x96, 0x5c, 0xeb,
+	0xee, 0xa0, 0xd7, 0x20, 0xbe, 0xd2, 0x60, 0x53, 0xed, 0x7e, 0x5f, 0x6c, 0x1a, 0xef, 0xc1, 0x9e,
+	0xc0, 0xf1, 0x23, 0xdc, 0xed, 0xb4, 0x30, 0xf3, 0xfc, 0x09, 0x65, 0xae, 0xc3, 0x46, 0xd3, 0x73,
+	0x27, 0x71, 0xe4, 0xf9, 0xde, 0xdd, 0x29, 0xad, 0x7e, 0xad, 0xc1, 0xfe, 0x1c, 0x6e, 0x4a, 0xb1,
+	0x23, 0x78, 0x23, 0x44, 0x15, 0xe7, 0x18, 0x82, 0x7d, 0x8d, 0xaa, 0x7d, 0x53, 0x45, 0xd9, 0xa9,
+	0xf4, 0xf3, 0xab, 0xb8, 0xe7, 0x1d, 0x28, 0xc6, 0xaf, 0x26, 0x05, 0x91, 0xf1, 0x9e, 0x12, 0xf6,
+	0x21, 0xf3, 0x7c, 0xec, 0x24, 0x0b, 0x43, 0x05, 0x48, 0x5f, 0x92, 0x2b, 0x15, 0x6f, 0xfc, 0x37,
+	0x22, 0xfe, 0x26, 0x14, 0xe3, 0xcc, 0x94, 0xf8, 0x22, 0xac, 0x0e, 0x71, 0x77, 0x10, 0x0a, 0x97,
+	0x0b, 0xe3, 0x36, 0x14, 0x54, 0x28, 0xb5, 0x5e, 0x49, 0xc9, 0x23, 0xf8, 0x52, 0xe4, 0x9e, 0x12,
+	0x81, 0x20, 0xc3, 0x63, 0x5f, 0xdc, 0xda, 0xb0, 0xc5, 0xbf, 0xf1, 0x09, 0x20, 0x41, 0x78, 0x11,
+	0xbc, 0xef, 0x39, 0x34, 0x14, 0x81, 0x20, 0x23, 0x5e, 0x8c, 0xe4, 0x2f, 0xfe, 0xd1, 0x3d, 0x80,
+	0x71, 0xe2, 0x11, 0xba, 0xe5, 0x4f, 0xaa, 0xa6, 0x0c, 0x5a, 0x93, 0x67, 0x29, 0x53, 0x26, 0x34,
+	0x95, 0xa5, 0xcc, 0x0f, 0xc6, 0xa6, 0xb2, 0x23, 0x37, 0x23, 0x20, 0x7f, 0xa5, 0xc1, 0x76, 0x4c,
+	0xb8, 0xc2, 0xf9, 0x36, 0x64, 0xba, 0x9e, 0xc3, 0xb5, 0x4b, 0xd7, 0xf2, 0x27, 0xd7, 0xcc, 0xc9,
+	0xdc, 0x68, 0xbe, 0xef, 0x39, 0xb6, 0x20, 0x41, 0xf7, 0x67, 0x80, 0x3a, 0x4a, 0x04, 0x25, 0xe5,
+	0x44, 0x51, 0x19, 0x45, 0x65, 0x87, 0x0f, 0xb0, 0x8f, 0x7b, 0xa1, 0x1d, 0x8c, 0x07, 0xb0, 0x1d,
+	0xdb, 0x55, 0x00, 0x6f, 0xc3, 0x5a, 0x5f, 0xec, 0x08, 0x03, 0xe5, 0x4f, 0x4a, 0xd3, 0x10, 0xe5,
+	0x8d, 0xd3, 0xcc, 0xd3, 0xe7, 0xe5, 0x15, 0x5b, 0x51, 0x1b, 0xbf, 0x4f, 0xc1, 0xd6, 0x39, 0x6b,
+	0x9f, 0xe1, 0x6e, 0x37, 0x62, 0x69, 0xec, 0x3b, 0x34, 0xf4, 0x09, 0xff, 0x47, 0x6f, 0x42, 0xd6,
+	0xc1, 0xb4, 0xde, 0xc4, 0x7d, 0xf5, 0x3c, 0xd6, 0x1c, 0x4c, 0xcf, 0x70, 0x1f, 0x3d, 0x84, 0x42,
+	0xdf, 0xf7, 0xfa, 0x1e, 0x25, 0xfe, 0xe8, 0x89, 0xf1, 0xe7, 0xb1, 0x71, 0x7a, 0xf2, 0xef, 0xe7,
+	0x65, 0xd3, 0xe9, 0xb0, 0xf6, 0xa0, 0x61, 0x36, 0xbd, 0x9e, 0xa5, 0x8a, 0x87, 0xfc, 0xdc, 0xa2,
+	0xad, 0x4b, 0x8b, 0x5d, 0xf5, 0x09, 0x35, 0xcf, 0xc6, 0x6f, 0xdb, 0x7e, 0x23, 0xe4, 0x15, 0xbe,
+	0xcb, 0x5d, 0xc8, 0x35, 0xdb, 0xb8, 0xe3, 0xd6, 0x3b, 0xad, 0x52, 0xa6, 0xa2, 0xd5, 0xd2, 0x76,
+	0x56, 0xac, 0xdf, 0x6d, 0xa1, 0x3d, 0x58, 0xf7, 0x86, 0xc4, 0xf7, 0x3b, 0x2d, 0x42, 0x4b, 0xab,
+	0x02, 0xeb, 0x78, 0x83, 0xbf, 0xfc, 0x46, 0xd7, 0x6b, 0x5e, 0xd6, 0xc7, 0x34, 0x6b, 0x82, 0x66,
+	0x4b, 0x6c, 0xff, 0x60, 0x44, 0x38, 0x91, 0xeb, 0xb3, 0x93, 0xb9, 0xde, 0x38, 0x82, 0xed, 0x73,
+	0xca, 0x3a, 0x3d, 0xcc, 0xc8, 0x7d, 0x3c, 0x36, 0x78, 0x01, 0xd2, 0x0e, 0x96, 0x46, 0xca, 0xd8,
+	0xfc, 0xd7, 0xf8, 0x3c, 0x13, 0xc6, 0x8e, 0x8f, 0x9b, 0xe4, 0x22, 0x08, 0xed, 0x79, 0x0c, 0xe9,
+	0x1e, 0x75, 0x94, 0x5f, 0xca, 0xd3, 0x7e, 0x79, 0x40, 0x9d, 0x73, 0xbe, 0x47, 0x06, 0xbd, 0x8b,
+	0xc0, 0xe6, 0xb4, 0xe8, 0x0e, 0x6c, 0x30, 0xce, 0xa4, 0xde, 0xf4, 0xdc, 0x47, 0x1d, 0x47, 0x58,
+	0x34, 0x7f, 0xb2, 0x3f, 0x7d, 0x57, 0x88, 0x3a, 0x13, 0x44, 0x76, 0x9e, 0x8d, 0x17, 0xe8, 0x0c,
+	0x36, 0xfa, 0x3e, 0x69, 0x91, 0x26, 0xa1, 0xd4, 0xf3, 0x69, 0x29, 0x53, 0x49, 0x2f, 0x23, 0x3d,
+	0x76, 0x89, 0x67, 0x63, 0x69, 0x44, 0x95, 0xf7, 0x56, 0x85, 0x07, 0xf2, 0x62, 0x4f, 0x66, 0x3d,
+	0xb4, 0x0f, 0x20, 0x49, 0xc4, 0xe3, 0x5c, 0x13, 0x8f, 0x73, 0x5d, 0xec, 0x88, 0x7a, 0x76, 0x16,
+	0x1e, 0xf3, 0x9a, 0x5c, 0xca, 0x0a, 0x35, 0x74, 0x53, 0x16, 0x6c, 0x33, 0x2c, 0xd8, 0xe6, 0x45,
+	0x58, 0xb0, 0x4f, 0x73, 0x3c, 0x38, 0xbf, 0xf8, 0x5b, 0x59, 0x53, 0x4c, 0xf8, 0xc9, 0xcc, 0x18,
+	0xcb, 0xfd, 0x77, 0x62, 0x6c, 0x3d, 0x1e, 0x63, 0x06, 0x6c, 0x4a, 0xf8, 0x3d, 0x1c, 0xd4, 0xb9,
+	0xbb, 0x21, 0x62, 0x81, 0x07, 0x38, 0xb8, 0x8f, 0xe9, 0xf7, 0x32, 0xb9, 0x54, 0x21, 0x6d, 0xe7,
+	0x58, 0x50, 0xef, 0xb8, 0x2d, 0x12, 0x18, 0x37, 0x54, 0x36, 0x1d, 0x45, 0xc1, 0x38, 0xd5, 0xb5,
+	0x30, 0xc3, 0xe1, 0xb3, 0xe2, 0xff, 0xc6, 0x1f, 0xd3, 0xb0, 0x33, 0x26, 0x3e, 0xe5, 0x5c, 0x23,
+	0x51, 0xc3, 0x82, 0x30, 0xe1, 0x24, 0x47, 0x0d, 0x0b, 0xe8, 0x6b, 0x88, 0x9a, 0xff, 0x3b, 0x3c,
+	0xd9, 0xe1, 0xc6, 0x2d, 0x78, 0x73, 0xca, 0x67, 0x0b, 0x7c, 0xfc, 0x1b, 0x0d, 0xae, 0x8d, 0xe9,
+	0xa3, 0x89, 0xf6, 0xeb, 0x90, 0x69, 0xe2, 0x6e, 0x57, 0x65, 0x86, 0xca, 0xb4, 0x9f, 0xe2, 0x89,
+	0xd9, 0x16, 0xd4, 0x53, 0x5e, 0x4e, 0xbd, 0xaa, 0x97, 0x8d, 0x9b, 0xd1, 0xa0, 0x93, 0x02, 0x16,
+	0xe0, 0xff, 0x97, 0x06, 0xe8, 0x9c, 0xb5, 0x3f, 0xec, 0xf4, 0x06, 0x5d, 0xcc, 0x46, 0x25, 0xff,
+	0x0e, 0xac, 0x09, 0xa3, 0x84, 0x21, 0x6a, 0xcc, 0x84, 0x1f, 0xde, 0x12, 0x66, 0x0a, 0x4b, 0x8f,
+	0xbc, 0xf7, 0x3f, 0x58, 0x53, 0x0c, 0x0f, 0x0a, 0x93, 0xa0, 0x79, 0x17, 0xc4, 0xed, 0x2e, 0xf5,
+	0xdc, 0xb0, 0xe5, 0x22, 0x5e, 0x7d, 0x52, 0x4b, 0x54, 0x9f, 0xf4, 0xac, 0xea, 0x63, 0x3c, 0x84,
+	0xed, 0x98, 0x6d, 0x95, 0x1f, 0xee, 0x4d, 0x18, 0xb7, 0x96, 0x6c, 0x5c, 0x9b, 0xd0, 0x41, 0x97,
+	0xc5, 0x4d, 0x6c, 0xfc, 0x18, 0x76, 0x66, 0xd3, 0xa1, 0x6f, 0x47, 0xb5, 0xe2, 0x0d, 0x4a, 0x42,
+	0x82, 0x09, 0x1b, 0x14, 0x79, 0xcb, 0xb8, 0x36, 0x6a, 0x76, 0x29, 0xb9, 0x47, 0xc2, 0xa0, 0x30,
+	0x1e, 0x42, 0x31, 0xbe, 0xad, 0xf4, 0x39, 0x87, 0x1c, 0xef, 0x7c, 0xea, 0x8f, 0x88, 0x6a, 0x26,
+	0x4f, 0x6f, 0xfc, 0xf5, 0x79, 0xb9, 0xba, 0x84, 0x27, 0xdf, 0x75, 0x19, 0xef, 0x7a, 0x05, 0xbb,
+	0x93, 0x3f, 0x6d, 0xc1, 0xaa, 0xe0, 0x8f, 0x7e, 0xa6, 0x41, 0x56, 0x35, 0xfb, 0xe8, 0x70, 0x1a,
+	0xfb, 0x8c, 0x71, 0x4f, 0xaf, 0x26, 0x91, 0x49, 0xac, 0xc6, 0xd1, 0x67, 0x7f, 0xfe, 0xc7, 0x6f,
+	0x53, 0xd7, 0x51, 0x99, 0x0f, 0xa7, 0x1e, 0x0d, 0x47, 0x54, 0xd5, 0xec, 0x5b, 0x9f, 0xaa, 0x88,
+	0x7c, 0x8c, 0x7e, 0xa7, 0xc1, 0x66, 0x6c, 0x9e, 0x42, 0x5f, 0x9d, 0x23, 0x62, 0xd6, 0xdc, 0xa6,
+	0xdf, 0x5c, 0x8e, 0x58, 0xa1, 0x32, 0x05, 0xaa, 0x1a, 0xaa, 0xc6, 0x51, 0x85, 0x63, 0xdb, 0x14,
+	0xb8, 0x3f, 0x68, 0x50, 0x98, 0x1c, 0x8b, 0x90, 0x39, 0x47, 0xe4, 0x9c, 0x69, 0x4c, 0xb7, 0x96,
+	0xa6, 0x57, 0x28, 0x6f, 0x0b, 0x94, 0xef, 0x20, 0x33, 0x8e, 0x72, 0x18, 0xd2, 0x8f, 0x81, 0x46,
+	0xa7, 0xbc, 0xc7, 0xe8, 0x33, 0x0d, 0xb2, 0x6a, 0xf8, 0x99, 0xeb, 0xce, 0xf8, 0x5c, 0xa5, 0x57,
+	0x93, 0xc8, 0x14, 0xa4, 0x9a, 0x80, 0x64, 0xa0, 0x4a, 0x1c, 0x92, 0x1a, 0xa4, 0x68, 0xc4, 0x64,
+	0xbf, 0xd0, 0x20, 0xab, 0x46, 0xa0, 0xb9, 0x20, 0xe2, 0xf3, 0x96, 0x5e, 0x4d, 0x22, 0x53, 0x20,
+	0x6e, 0x09, 0x10, 0x47, 0xe8, 0x30, 0x0e, 0x82, 0x4a, 0xb2, 0x31, 0x06, 0xeb, 0xd3, 0x4b, 0x72,
+	0xf5, 0x18, 0x0d, 0x21, 0xc3, 0xa7, 0x24, 0x64, 0xcc, 0x0d, 0x91, 0xd1, 0xe8, 0xa5, 0x7f, 0x79,
+	0x21, 0x8d, 0x92, 0x7f, 0x28, 0xe4, 0x97, 0xd1, 0xfe, 0x64, 0xf4, 0xb4, 0x62, 0x16, 0xa0, 0xb0,
+	0x26, 0x87, 0x04, 0xf4, 0x95, 0x39, 0x5c, 0x63, 0xb3, 0x88, 0x7e, 0x98, 0x40, 0xa5, 0xa4, 0xef,
+	0x09, 0xe9, 0x3b, 0xa8, 0x18, 0x97, 0x2e, 0x27, 0x10, 0xc4, 0x20, 0xab, 0xea, 0x1c, 0x4a, 0x2c,
+	0x81, 0xfa, 0xb2, 0x79, 0xca, 0x38, 0x10, 0x32, 0x4b, 0x68, 0x27, 0x2e, 0x93, 0xb0, 0x76, 0x5d,
+	0x54, 0xd1, 0x4f, 0x20, 0x1f, 0xe9, 0xea, 0x97, 0x90, 0x3c, 0x43, 0xd7, 0x19, 0x63, 0x81, 0x61,
+	0x08, 0xb9, 0x7b, 0x48, 0x9f, 0x90, 0xab, 0x48, 0x79, 0x4f, 0x81, 0x02, 0xc8, 0xaa, 0xe6, 0x70,
+	0x6e, 0x9c, 0xc5, 0x47, 0x08, 0xbd, 0x9a, 0x44, 0xb6, 0x58, 0x6b, 0xd9, 0x2f, 0xb0, 0x00, 0x7d,
+	0xae, 0x01, 0x8c, 0xdb, 0x16, 0x54, 0x5b, 0xc4, 0x36, 0xda, 0x8d, 0xea, 0x6f, 0x2f, 0x41, 0xa9,
+	0x30, 0x5c, 0x17, 0x18, 0xde, 0x42, 0xbb, 0xb3, 0x30, 0x88, 0xba, 0x84, 0x7e, 0xaa, 0xc1, 0xfa,
+	0xa8, 0xf9, 0x40, 0x47, 0x8b, 0x78, 0x47, 0x5d, 0x50, 0x4b, 0x26, 0x54, 0x18, 0x2a, 0x02, 0x83,
+	0x8e, 0x4a, 0xb3, 0x30, 0x08, 0xff, 0xff, 0x04, 0xf2, 0x91, 0xca, 0x38, 0x2b, 0xde, 0xa7, 0x7b,
+	0x1e, 0xfd, 0x30, 0x81, 0x2a, 0x21, 0x06, 0x58, 0xbb, 0x4e, 0x43, 0x81, 0x01, 0x4f, 0x78, 0xa2,
+	0xaa, 0x2d, 0x48, 0x78, 0xd1, 0xda, 0xaa, 0x57, 0x93, 0xc8, 0x16, 0xc7, 0x40, 0x58, 0x7f, 0x4f,
+	0xef, 0x3c, 0x7d, 0x71, 0xa0, 0x3d, 0x7b, 0x71, 0xa0, 0xfd, 0xfd, 0xc5, 0x81, 0xf6, 0xc5, 0xcb,
+	0x83, 0x95, 0x67, 0x2f, 0x0f, 0x56, 0xfe, 0xf2, 0xf2, 0x60, 0xe5, 0xa3, 0x68, 0x3d, 0x1e, 0xdd,
+	0xf5, 0xa8, 0x35, 0x3c, 0xfe, 0x86, 0x15, 0x08, 0x3e, 0xa2, 0x26, 0x37, 0xd6, 0x44, 0x1f, 0xff,
+	0xb5, 0xff, 0x0c, 0x00, 0xdf, 0xbf, 0x3d, 0x02, 0x40, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1463,6 +1853,10 @@ type QueryClient interface {
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// EthSimulate implements the `eth_simulateV1` rpc api
+	EthSimulate(ctx context.Context, in *EthSimulateRequest, opts ...grpc.CallOption) (*EthSimulateResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1575,6 +1969,24 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EthSimulate(ctx context.Context, in *EthSimulateRequest, opts ...grpc.CallOption) (*EthSimulateResponse, error) {
+	out := new(EthSimulateResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/EthSimulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1610,6 +2022,10 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// EthSimulate implements the `eth_simulateV1` rpc api
+	EthSimulate(context.Context, *EthSimulateRequest) (*EthSimulateResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1652,6 +2068,12 @@ func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxR
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) EthSimulate(ctx context.Context, req *EthSimulateRequest) (*EthSimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthSimulate not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1858,43 +2280,79 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseFeeRequest)
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BaseFee(ctx, in)
+		return srv.(QueryServer).TraceCall(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethermint.evm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Account",
-			Handler:    _Query_Account_Handler,
-		},
-		{
-			MethodName: "CosmosAccount",
-			Handler:    _Query_CosmosAccount_Handler,
-		},
-		{
-			MethodName: "ValidatorAccount",
-			Handler:    _Query_ValidatorAccount_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
+func _Query_EthSimulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthSimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthSimulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/EthSimulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthSimulate(ctx, req.(*EthSimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethermint.evm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "CosmosAccount",
+			Handler:    _Query_CosmosAccount_Handler,
+		},
+		{
+			MethodName: "ValidatorAccount",
+			Handler:    _Query_ValidatorAccount_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
 		},
 		{
 			MethodName: "Storage",
@@ -1924,6 +2382,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "EthSimulate",
+			Handler:    _Query_EthSimulate_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -1953,6 +2419,15 @@ func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingTxs[iNdEx])
+			copy(dAtA[i:], m.PendingTxs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -2499,6 +2974,29 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingTxs[iNdEx])
+			copy(dAtA[i:], m.PendingTxs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2798,7 +3296,7 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2808,20 +3306,44 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Call != nil {
+		{
+			size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2831,126 +3353,359 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EthSimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *EthSimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EthSimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EthSimulateBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EthSimulateBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthSimulateBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Calls[iNdEx])
+			copy(dAtA[i:], m.Calls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Calls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthSimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EthSimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthSimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *EthSimulateBlockResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthSimulateBlockResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthSimulateBlockResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PendingTxs) > 0 {
+		for _, b := range m.PendingTxs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.AccountAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -3122,6 +3877,20 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PendingTxs) > 0 {
+		for _, b := range m.PendingTxs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3243,42 +4012,150 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Call != nil {
+		l = m.Call.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
+func (m *QueryTraceCallResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
+	l = len(m.Data)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EthSimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EthSimulateBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, b := range m.Calls {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *EthSimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthSimulateBlockResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -3332,6 +4209,38 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, make([]byte, postIndex-iNdEx))
+			copy(m.PendingTxs[len(m.PendingTxs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4922,6 +5831,106 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, make([]byte, postIndex-iNdEx))
+			copy(m.PendingTxs[len(m.PendingTxs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5288,7 +6297,556 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
 			}
-			m.BlockMaxGas = 0
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Call == nil {
+				m.Call = &EthCallRequest{}
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5298,11 +6856,28 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5324,7 +6899,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5347,10 +6922,10 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5408,7 +6983,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *EthSimulateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5431,15 +7006,15 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthSimulateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthSimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5466,16 +7041,35 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Blocks = append(m.Blocks, EthSimulateBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5485,33 +7079,31 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.BlockNumber = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5521,16 +7113,66 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthSimulateBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthSimulateBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthSimulateBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5540,29 +7182,29 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Calls = append(m.Calls, make([]byte, postIndex-iNdEx))
+			copy(m.Calls[len(m.Calls)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5572,28 +7214,29 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5620,35 +7263,66 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockMaxGas = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthSimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthSimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthSimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5658,11 +7332,26 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, EthSimulateBlockResult{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5684,7 +7373,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *EthSimulateBlockResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5707,17 +7396,17 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthSimulateBlockResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthSimulateBlockResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5727,24 +7416,24 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Calls = append(m.Calls, &MsgEthereumTxResponse{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v15/types"
+)
+
+// methodSelectorLength is the length of the 4-byte selector of a contract method.
+const methodSelectorLength = 4
+
+// NewSponsorPolicy creates a new SponsorPolicy instance.
+func NewSponsorPolicy(sponsor sdk.AccAddress, contract common.Address, allowedMethods []string, budgetPerUser sdkmath.Int) SponsorPolicy {
+	return SponsorPolicy{
+		Sponsor:        sponsor.String(),
+		Contract:       contract.Hex(),
+		AllowedMethods: allowedMethods,
+		BudgetPerUser:  budgetPerUser,
+	}
+}
+
+// Validate performs a stateless validation of the sponsor policy.
+func (sp SponsorPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(sp.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+	if err := types.ValidateNonZeroAddress(sp.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	return validateSponsoredMethods(sp.AllowedMethods, sp.BudgetPerUser)
+}
+
+// SponsorAddress returns the address of the sponsor. It panics if the address is invalid.
+func (sp SponsorPolicy) SponsorAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(sp.Sponsor)
+}
+
+// ContractAddress returns the address of the sponsored contract.
+func (sp SponsorPolicy) ContractAddress() common.Address {
+	return common.HexToAddress(sp.Contract)
+}
+
+// IsMethodAllowed returns true if the given call input targets one of the allowed methods.
+// All the calls are allowed if the policy doesn't restrict the methods.
+func (sp SponsorPolicy) IsMethodAllowed(input []byte) bool {
+	if len(sp.AllowedMethods) == 0 {
+		return true
+	}
+	if len(input) < methodSelectorLength {
+		return false
+	}
+
+	for _, method := range sp.AllowedMethods {
+		selector, err := hexutil.Decode(method)
+		if err == nil && bytes.Equal(selector, input[:methodSelectorLength]) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSponsoredMethods checks that the methods are distinct hex encoded 4-byte selectors
+// and that the budget per user is positive.
+func validateSponsoredMethods(methods []string, budgetPerUser sdkmath.Int) error {
+	seen := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		selector, err := hexutil.Decode(method)
+		if err != nil || len(selector) != methodSelectorLength {
+			return errorsmod.Wrapf(ErrInvalidSponsorPolicy, "invalid method selector %s", method)
+		}
+		if seen[string(selector)] {
+			return errorsmod.Wrapf(ErrInvalidSponsorPolicy, "duplicate method selector %s", method)
+		}
+		seen[string(selector)] = true
+	}
+
+	if budgetPerUser.IsNil() || !budgetPerUser.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorPolicy, "budget per user must be positive, got %s", budgetPerUser)
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// TracerStateDiff is the name of the native state diff tracer. It produces the
+// same output as the go-ethereum prestateTracer in diff mode, including the
+// balance changes made through the Cosmos SDK by the stateful precompiles and
+// the fees paid by the sender.
+const TracerStateDiff = "stateDiffTracer"
+
+var _ tracers.Tracer = &StateDiffTracer{}
+
+// StateDiffAccount is the state of an account in the state diff tracer output.
+// Only the modified fields are included in the post state.
+type StateDiffAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// StateDiff is the output of the state diff tracer. Pre holds the state of the
+// modified accounts before the transaction, post holds the modified fields after
+// it. Created accounts are only in post and deleted accounts are only in pre.
+type StateDiff struct {
+	Pre  map[common.Address]*StateDiffAccount `json:"pre"`
+	Post map[common.Address]*StateDiffAccount `json:"post"`
+}
+
+// journalStateDB defines the StateDB interface that exposes the state of the
+// accounts modified in the journal before the execution, and the balances and
+// nonces stored in the keeper.
+type journalStateDB interface {
+	vm.StateDB
+	contextStateDB
+	JournalPrestate() map[common.Address]*StateDiffAccount
+	GetCommittedBalance(addr common.Address) *big.Int
+	GetCommittedNonce(addr common.Address) uint64
+}
+
+// StateDiffTracer is a native tracer that tracks the balance, nonce, code and
+// storage changes of the accounts modified by a transaction.
+//
+// The tracer expects the StateDB to be committed and the fees and nonce of the
+// transaction to be charged on the same context before GetResult is called:
+// the final balances and nonces are read from the keeper, so they include the
+// changes made around the EVM execution.
+type StateDiffTracer struct {
+	env         *vm.EVM
+	stateDB     journalStateDB
+	denom       string
+	from        common.Address
+	fromPre     *StateDiffAccount
+	eventsStart int
+	pre, post   map[common.Address]*StateDiffAccount
+	interrupt   uint32 // Atomic flag to signal execution interruption
+	reason      error  // Textual reason for the interruption
+}
+
+// NewStateDiffTracer returns a native state diff tracer that implements the
+// tracers.Tracer interface. The given denomination is the one of the EVM balances,
+// used to track the balance changes made through the Cosmos SDK.
+func NewStateDiffTracer(_ *tracers.Context, _ json.RawMessage, denom string) *StateDiffTracer {
+	return &StateDiffTracer{
+		denom: denom,
+		pre:   make(map[common.Address]*StateDiffAccount),
+		post:  make(map[common.Address]*StateDiffAccount),
+	}
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *StateDiffTracer) CaptureStart(env *vm.EVM, from common.Address, _ common.Address, _ bool, _ []byte, _ uint64, _ *big.Int) {
+	t.env = env
+	t.from = from
+
+	stateDB, ok := env.StateDB.(journalStateDB)
+	if !ok {
+		return
+	}
+	t.stateDB = stateDB
+	t.fromPre = t.currentState(from, stateDB.GetBalance(from))
+	t.eventsStart = len(stateDB.GetContext().EventManager().Events())
+}
+
+// CaptureEnd is called after the call finishes to collect the state of the
+// modified accounts, before the changes are committed.
+func (t *StateDiffTracer) CaptureEnd(_ []byte, _ uint64, _ time.Duration, _ error) {
+	if t.stateDB == nil {
+		return
+	}
+
+	for addr, pre := range t.stateDB.JournalPrestate() {
+		// accounts created by the transaction have no prestate
+		if pre.Balance != nil {
+			t.pre[addr] = pre
+		}
+		if t.stateDB.HasSuicided(addr) {
+			continue
+		}
+		post := t.currentState(addr, t.stateDB.GetBalance(addr))
+		for key := range pre.Storage {
+			post.Storage[key] = t.stateDB.GetState(addr, key)
+		}
+		t.post[addr] = post
+	}
+
+	// the sender is not in the journal if the transaction didn't modify it
+	if _, ok := t.pre[t.from]; !ok && t.fromPre != nil {
+		t.pre[t.from] = t.fromPre
+		t.post[t.from] = t.currentState(t.from, t.stateDB.GetBalance(t.from))
+	}
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (*StateDiffTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (*StateDiffTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *StateDiffTracer) CaptureEnter(_ vm.OpCode, _ common.Address, _ common.Address, _ []byte, _ uint64, _ *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+	}
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (*StateDiffTracer) CaptureExit(_ []byte, _ uint64, _ error) {}
+
+// CaptureTxStart implements the EVMLogger interface.
+func (*StateDiffTracer) CaptureTxStart(_ uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface.
+func (*StateDiffTracer) CaptureTxEnd(_ uint64) {}
+
+// GetResult returns the json-encoded state diff, and any error arising from the
+// encoding or forceful termination (via `Stop`).
+func (t *StateDiffTracer) GetResult() (json.RawMessage, error) {
+	t.captureCommitted()
+	t.removeUnmodified()
+
+	res, err := json.Marshal(StateDiff{Pre: t.pre, Post: t.post})
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *StateDiffTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// captureCommitted sets the post balances and nonces to the ones stored in the
+// keeper, which include the fees and nonce charged around the execution. The
+// stateful precompiles and the fee deduction move the balances of accounts
+// outside of the journal through the bank module, so these accounts are tracked
+// from the emitted events.
+func (t *StateDiffTracer) captureCommitted() {
+	if t.stateDB == nil {
+		return
+	}
+
+	for addr, delta := range t.bankDeltas() {
+		if _, ok := t.post[addr]; ok {
+			continue
+		}
+		balance := t.stateDB.GetCommittedBalance(addr)
+		t.post[addr] = t.currentState(addr, balance)
+		t.pre[addr] = t.currentState(addr, new(big.Int).Sub(balance, delta))
+	}
+
+	for addr, post := range t.post {
+		post.Balance = (*hexutil.Big)(new(big.Int).Set(t.stateDB.GetCommittedBalance(addr)))
+		post.Nonce = t.stateDB.GetCommittedNonce(addr)
+	}
+}
+
+// currentState returns the current nonce and code of the given account with the
+// given balance.
+func (t *StateDiffTracer) currentState(addr common.Address, balance *big.Int) *StateDiffAccount {
+	return &StateDiffAccount{
+		Balance: (*hexutil.Big)(new(big.Int).Set(balance)),
+		Nonce:   t.stateDB.GetNonce(addr),
+		Code:    t.stateDB.GetCode(addr),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// bankDeltas returns the net balance change in the EVM denomination of every
+// account that spent or received coins during the transaction.
+func (t *StateDiffTracer) bankDeltas() map[common.Address]*big.Int {
+	deltas := make(map[common.Address]*big.Int)
+
+	events := t.stateDB.GetContext().EventManager().Events()
+	if len(events) <= t.eventsStart {
+		return deltas
+	}
+
+	for _, event := range events[t.eventsStart:] {
+		var accountKey string
+		sign := 1
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			accountKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			accountKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		var (
+			account sdk.AccAddress
+			amount  sdk.Coins
+			err     error
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case accountKey:
+				account, err = sdk.AccAddressFromBech32(attr.Value)
+			case sdk.AttributeKeyAmount:
+				amount, err = sdk.ParseCoinsNormalized(attr.Value)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err != nil || account.Empty() {
+			continue
+		}
+
+		value := amount.AmountOf(t.denom).BigInt()
+		if sign < 0 {
+			value.Neg(value)
+		}
+
+		addr := common.BytesToAddress(account)
+		if delta, ok := deltas[addr]; ok {
+			delta.Add(delta, value)
+		} else {
+			deltas[addr] = value
+		}
+	}
+
+	for addr, delta := range deltas {
+		if delta.Sign() == 0 {
+			delete(deltas, addr)
+		}
+	}
+	return deltas
+}
+
+// removeUnmodified removes the unmodified fields from the post state, the
+// unmodified storage slots from both states and the accounts that weren't
+// modified at all.
+func (t *StateDiffTracer) removeUnmodified() {
+	for addr, post := range t.post {
+		pre, ok := t.pre[addr]
+		if !ok {
+			// created accounts only keep their non-empty fields
+			for key, value := range post.Storage {
+				if value == (common.Hash{}) {
+					delete(post.Storage, key)
+				}
+			}
+			continue
+		}
+
+		modified := false
+		if post.Balance.ToInt().Cmp(pre.Balance.ToInt()) == 0 {
+			post.Balance = nil
+		} else {
+			modified = true
+		}
+		if post.Nonce == pre.Nonce {
+			post.Nonce = 0
+		} else {
+			modified = true
+		}
+		if bytes.Equal(post.Code, pre.Code) {
+			post.Code = nil
+		} else {
+			modified = true
+		}
+		for key, value := range post.Storage {
+			if value == pre.Storage[key] {
+				delete(post.Storage, key)
+				delete(pre.Storage, key)
+			} else {
+				modified = true
+			}
+		}
+
+		if !modified {
+			delete(t.pre, addr)
+			delete(t.post, addr)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// diffStateDB is a StateDB that exposes a fixed journal prestate and fixed
+// committed balances and nonces.
+type diffStateDB struct {
+	vm.StateDB
+	ctx             sdk.Context
+	prestate        func() map[common.Address]*types.StateDiffAccount
+	balances        map[common.Address]*big.Int
+	committed       map[common.Address]*big.Int
+	nonces          map[common.Address]uint64
+	committedNonces map[common.Address]uint64
+	codes           map[common.Address][]byte
+	storage         map[common.Hash]common.Hash
+}
+
+func (db diffStateDB) GetContext() sdk.Context { return db.ctx }
+
+func (db diffStateDB) JournalPrestate() map[common.Address]*types.StateDiffAccount {
+	return db.prestate()
+}
+
+func (db diffStateDB) GetCommittedBalance(addr common.Address) *big.Int {
+	if balance, ok := db.committed[addr]; ok {
+		return balance
+	}
+	return common.Big0
+}
+
+func (db diffStateDB) GetCommittedNonce(addr common.Address) uint64 {
+	return db.committedNonces[addr]
+}
+
+func (db diffStateDB) GetBalance(addr common.Address) *big.Int {
+	if balance, ok := db.balances[addr]; ok {
+		return balance
+	}
+	return db.GetCommittedBalance(addr)
+}
+
+func (db diffStateDB) GetNonce(addr common.Address) uint64 { return db.nonces[addr] }
+
+func (db diffStateDB) GetCode(addr common.Address) []byte { return db.codes[addr] }
+
+func (db diffStateDB) GetState(_ common.Address, key common.Hash) common.Hash {
+	return db.storage[key]
+}
+
+func (diffStateDB) HasSuicided(common.Address) bool { return false }
+
+func TestStateDiffTracer(t *testing.T) {
+	denom := "aevmos"
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	validator := utiltx.GenerateAddress()
+	module := common.BytesToAddress(authtypes.NewModuleAddress("distribution"))
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	code := []byte{0x60}
+	key := common.BigToHash(big.NewInt(1))
+
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	stateDB := diffStateDB{
+		ctx: ctx,
+		prestate: func() map[common.Address]*types.StateDiffAccount {
+			return map[common.Address]*types.StateDiffAccount{
+				sender: {Balance: (*hexutil.Big)(big.NewInt(100000)), Nonce: 1, Storage: map[common.Hash]common.Hash{}},
+				contract: {
+					Balance: (*hexutil.Big)(big.NewInt(0)),
+					Nonce:   1,
+					Code:    code,
+					Storage: map[common.Hash]common.Hash{key: {}},
+				},
+			}
+		},
+		balances: map[common.Address]*big.Int{
+			sender:   big.NewInt(99900),
+			contract: big.NewInt(100),
+		},
+		// the committed state includes the fees and nonce charged after the
+		// execution
+		committed: map[common.Address]*big.Int{
+			sender:       big.NewInt(78900),
+			contract:     big.NewInt(105),
+			validator:    big.NewInt(530),
+			module:       big.NewInt(65),
+			feeCollector: big.NewInt(21005),
+		},
+		nonces:          map[common.Address]uint64{sender: 1, contract: 1},
+		committedNonces: map[common.Address]uint64{sender: 2, contract: 1},
+		codes:           map[common.Address][]byte{contract: code},
+		storage:         map[common.Hash]common.Hash{key: common.BigToHash(big.NewInt(1))},
+	}
+
+	bankEvent := func(typ, key string, addr common.Address, amount string) sdk.Event {
+		return sdk.NewEvent(typ,
+			sdk.NewAttribute(key, sdk.AccAddress(addr.Bytes()).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount),
+		)
+	}
+
+	// events emitted before the transaction are ignored
+	ctx.EventManager().EmitEvent(bankEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, validator, "999aevmos"))
+
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{GasPrice: big.NewInt(1)}, stateDB, params.TestChainConfig, vm.Config{})
+	tracer := types.NewStateDiffTracer(nil, nil, denom)
+
+	tracer.CaptureTxStart(50000)
+	tracer.CaptureStart(evm, sender, contract, false, nil, 50000, big.NewInt(100))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		bankEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, module, "35aevmos,5uatom"),
+		bankEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, validator, "30aevmos,5uatom"),
+		// the contract is also modified in the journal
+		bankEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, contract, "5aevmos"),
+	})
+	tracer.CaptureEnd(nil, 20000, 0, nil)
+	tracer.CaptureTxEnd(29000)
+
+	// the fees are charged after the execution
+	ctx.EventManager().EmitEvents(sdk.Events{
+		bankEvent(banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, sender, "21000aevmos"),
+		bankEvent(banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, feeCollector, "21000aevmos"),
+	})
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var diff types.StateDiff
+	require.NoError(t, json.Unmarshal(res, &diff))
+
+	expPre := map[common.Address]int64{sender: 100000, contract: 0, validator: 500, module: 100, feeCollector: 5}
+	expPost := map[common.Address]int64{sender: 78900, contract: 105, validator: 530, module: 65, feeCollector: 21005}
+	require.Len(t, diff.Pre, len(expPre))
+	require.Len(t, diff.Post, len(expPost))
+	for addr, balance := range expPre {
+		require.Equal(t, big.NewInt(balance).String(), diff.Pre[addr].Balance.ToInt().String(), addr.Hex())
+	}
+	for addr, balance := range expPost {
+		require.Equal(t, big.NewInt(balance).String(), diff.Post[addr].Balance.ToInt().String(), addr.Hex())
+	}
+
+	// the sender nonce is incremented
+	require.Equal(t, uint64(1), diff.Pre[sender].Nonce)
+	require.Equal(t, uint64(2), diff.Post[sender].Nonce)
+
+	// only the modified fields are in the post state
+	require.Equal(t, hexutil.Bytes(code), diff.Pre[contract].Code)
+	require.Nil(t, diff.Post[contract].Code)
+	require.Zero(t, diff.Post[contract].Nonce)
+	require.Equal(t, map[common.Hash]common.Hash{key: {}}, diff.Pre[contract].Storage)
+	require.Equal(t, map[common.Hash]common.Hash{key: common.BigToHash(big.NewInt(1))}, diff.Post[contract].Storage)
+}
@@ -0,0 +1,225 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+)
+
+const (
+	// TraceOutputStd prints the traces to the standard output and error
+	TraceOutputStd = ""
+	// TraceOutputFile writes the trace of each transaction to its own file
+	TraceOutputFile = "file"
+	// TraceOutputJSONL appends the traces to a rotating JSON lines file
+	TraceOutputJSONL = "jsonl"
+)
+
+// TraceSink stores the execution traces of the EVM transactions.
+type TraceSink interface {
+	WriteTrace(txHash common.Hash, tracer string, trace []byte) error
+}
+
+var (
+	_ TraceSink = &FileTraceSink{}
+	_ TraceSink = &JSONLTraceSink{}
+)
+
+// FileTraceSink writes the trace of each transaction to its own file in a
+// directory, named after the transaction hash.
+type FileTraceSink struct {
+	dir string
+}
+
+// NewFileTraceSink creates a FileTraceSink that writes the traces to the given
+// directory, which is created if it doesn't exist.
+func NewFileTraceSink(dir string) (*FileTraceSink, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	return &FileTraceSink{dir: dir}, nil
+}
+
+// WriteTrace implements the TraceSink interface.
+func (s *FileTraceSink) WriteTrace(txHash common.Hash, tracer string, trace []byte) error {
+	ext := ".json"
+	if tracer == TracerMarkdown {
+		ext = ".md"
+	}
+	return os.WriteFile(filepath.Join(s.dir, txHash.Hex()+ext), trace, 0o600)
+}
+
+// JSONLTrace is a line of the JSONLTraceSink file.
+type JSONLTrace struct {
+	TxHash common.Hash     `json:"txHash"`
+	Tracer string          `json:"tracer"`
+	Trace  json.RawMessage `json:"trace"`
+}
+
+// JSONLTraceSink appends the traces to a JSON lines file. Once the file exceeds
+// the maximum size, it's renamed with the rotation time as suffix and a new one
+// is created.
+type JSONLTraceSink struct {
+	mtx     sync.Mutex
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+}
+
+// NewJSONLTraceSink creates a JSONLTraceSink that appends the traces to the
+// file at the given path. A maximum size of 0 disables the rotation.
+func NewJSONLTraceSink(path string, maxSize int64) (*JSONLTraceSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	s := &JSONLTraceSink{path: path, maxSize: maxSize}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WriteTrace implements the TraceSink interface.
+func (s *JSONLTraceSink) WriteTrace(txHash common.Hash, tracer string, trace []byte) error {
+	// the markdown traces are stored as a string
+	if tracer == TracerMarkdown {
+		var err error
+		if trace, err = json.Marshal(string(trace)); err != nil {
+			return err
+		}
+	}
+
+	line, err := json.Marshal(JSONLTrace{TxHash: txHash, Tracer: tracer, Trace: trace})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	return err
+}
+
+// Close closes the current file of the sink.
+func (s *JSONLTraceSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.file.Close()
+}
+
+// open opens the file of the sink in append mode.
+func (s *JSONLTraceSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+// rotate renames the current file of the sink and opens a new one.
+func (s *JSONLTraceSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	ext := filepath.Ext(s.path)
+	rotated := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(s.path, ext), time.Now().UTC().Format("20060102T150405.000000000"), ext)
+	if err := os.Rename(s.path, rotated); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+// txStateDB defines the StateDB interface that exposes the hash of the executed
+// transaction.
+type txStateDB interface {
+	TxHash() common.Hash
+}
+
+// sinkTracer wraps a logger to write the trace of the transaction to a sink
+// once it's executed.
+type sinkTracer struct {
+	vm.EVMLogger
+	tracer string
+	sink   TraceSink
+	logger log.Logger
+	out    *bytes.Buffer
+	txHash common.Hash
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *sinkTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if stateDB, ok := env.StateDB.(txStateDB); ok {
+		t.txHash = stateDB.TxHash()
+	}
+	t.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureTxEnd implements the EVMLogger interface. It writes the trace to the
+// sink, unless the message isn't a transaction. The keeper only creates the
+// tracer on DeliverTx, so this is a safeguard for the messages applied without
+// a transaction hash.
+func (t *sinkTracer) CaptureTxEnd(restGas uint64) {
+	t.EVMLogger.CaptureTxEnd(restGas)
+
+	if t.txHash == (common.Hash{}) {
+		return
+	}
+
+	trace, err := t.result()
+	if err == nil {
+		err = t.sink.WriteTrace(t.txHash, t.tracer, trace)
+	}
+	if err != nil && t.logger != nil {
+		t.logger.Error("failed to write the transaction trace", "hash", t.txHash.Hex(), "error", err.Error())
+	}
+}
+
+// result returns the trace of the wrapped logger.
+func (t *sinkTracer) result() ([]byte, error) {
+	switch tracer := t.EVMLogger.(type) {
+	case *logger.StructLogger:
+		return tracer.GetResult()
+	case *logger.AccessListTracer:
+		return json.Marshal(tracer.AccessList())
+	}
+
+	if t.tracer != TracerJSON {
+		return t.out.Bytes(), nil
+	}
+
+	// the json logger writes a json object per line
+	lines := bytes.Split(bytes.TrimSpace(t.out.Bytes()), []byte("\n"))
+	trace := append([]byte{'['}, bytes.Join(lines, []byte{','})...)
+	return append(trace, ']'), nil
+}
@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// txStateDB is a StateDB that exposes the hash of the executed transaction.
+type txStateDB struct {
+	vm.StateDB
+	txHash common.Hash
+}
+
+func (db txStateDB) TxHash() common.Hash { return db.txHash }
+
+// memorySink is a TraceSink that keeps the traces in memory.
+type memorySink map[common.Hash][]byte
+
+func (s memorySink) WriteTrace(txHash common.Hash, _ string, trace []byte) error {
+	s[txHash] = trace
+	return nil
+}
+
+func TestNewTracerFilters(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name      string
+		senders   []common.Address
+		contracts []common.Address
+		from      common.Address
+		to        *common.Address
+		expTraced bool
+	}{
+		{"no filters", nil, nil, other, &other, true},
+		{"matching sender", []common.Address{sender}, nil, sender, &other, true},
+		{"matching contract", nil, []common.Address{contract}, other, &contract, true},
+		{"matching sender or contract", []common.Address{sender}, []common.Address{contract}, other, &contract, true},
+		{"no match", []common.Address{sender}, []common.Address{contract}, other, &other, false},
+		{"contract creation", nil, []common.Address{contract}, other, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := ethtypes.NewMessage(tc.from, tc.to, 0, big.NewInt(0), 21000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+			tracerCfg := types.TracerConfig{
+				Tracer:    types.TracerStruct,
+				Senders:   tc.senders,
+				Contracts: tc.contracts,
+			}
+			require.Equal(t, tc.expTraced, tracerCfg.IsTraced(msg))
+
+			tracer := types.NewTracer(tracerCfg, msg, params.TestChainConfig, 1)
+			_, isNoOp := tracer.(*types.NoOpTracer)
+			require.Equal(t, !tc.expTraced, isNoOp)
+		})
+	}
+}
+
+func TestNewTracerSink(t *testing.T) {
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	msg := ethtypes.NewMessage(from, &to, 0, big.NewInt(0), 21000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+
+	testCases := []struct {
+		name     string
+		tracer   string
+		txHash   common.Hash
+		expTrace string
+	}{
+		{"struct tracer", types.TracerStruct, common.HexToHash("0x01"), `{"gas":21000,"failed":false,"returnValue":"01","structLogs":[]}`},
+		{"json tracer", types.TracerJSON, common.HexToHash("0x02"), `[{"output":"01","gasUsed":"0x5208","time":0}]`},
+		{"access list tracer", types.TracerAccessList, common.HexToHash("0x03"), `[]`},
+		{"not a transaction", types.TracerStruct, common.Hash{}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := memorySink{}
+			tracerCfg := types.TracerConfig{Tracer: tc.tracer, Sink: sink}
+			tracer := types.NewTracer(tracerCfg, msg, params.TestChainConfig, 1)
+
+			evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, txStateDB{txHash: tc.txHash}, params.TestChainConfig, vm.Config{})
+			tracer.CaptureTxStart(21000)
+			tracer.CaptureStart(evm, from, to, false, nil, 21000, big.NewInt(0))
+			tracer.CaptureEnd([]byte{1}, 21000, 0, nil)
+			tracer.CaptureTxEnd(0)
+
+			if tc.expTrace == "" {
+				require.Empty(t, sink)
+				return
+			}
+			require.JSONEq(t, tc.expTrace, string(sink[tc.txHash]))
+		})
+	}
+}
+
+func TestFileTraceSink(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "traces")
+	sink, err := types.NewFileTraceSink(dir)
+	require.NoError(t, err)
+
+	txHash := common.HexToHash("0x01")
+	require.NoError(t, sink.WriteTrace(txHash, types.TracerStruct, []byte(`{"gas":1}`)))
+	require.NoError(t, sink.WriteTrace(txHash, types.TracerMarkdown, []byte("| Pc |")))
+
+	bz, err := os.ReadFile(filepath.Join(dir, txHash.Hex()+".json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"gas":1}`, string(bz))
+
+	bz, err = os.ReadFile(filepath.Join(dir, txHash.Hex()+".md"))
+	require.NoError(t, err)
+	require.Equal(t, "| Pc |", string(bz))
+}
+
+func TestJSONLTraceSink(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "traces.jsonl")
+
+	// rotate after each trace
+	sink, err := types.NewJSONLTraceSink(path, 10)
+	require.NoError(t, err)
+
+	hashes := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}
+	require.NoError(t, sink.WriteTrace(hashes[0], types.TracerStruct, []byte(`{"gas":1}`)))
+	require.NoError(t, sink.WriteTrace(hashes[1], types.TracerMarkdown, []byte("| Pc |")))
+	require.NoError(t, sink.Close())
+
+	files, err := filepath.Glob(filepath.Join(dir, "traces*.jsonl"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// the current file holds the last trace
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+	var trace types.JSONLTrace
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &trace))
+	require.Equal(t, hashes[1], trace.TxHash)
+	require.Equal(t, types.TracerMarkdown, trace.Tracer)
+	require.Equal(t, `"| Pc |"`, string(trace.Trace))
+	require.False(t, scanner.Scan())
+
+	// the sink appends to the existing file
+	sink, err = types.NewJSONLTraceSink(path, 0)
+	require.NoError(t, err)
+	require.NoError(t, sink.WriteTrace(hashes[0], types.TracerStruct, []byte(`{"gas":1}`)))
+	require.NoError(t, sink.Close())
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(bz)), "\n"), 2)
+}
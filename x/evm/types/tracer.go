@@ -3,15 +3,20 @@
 package types
 
 import (
+	"bytes"
+	"io"
 	"math/big"
 	"os"
 	"time"
 
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/params"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -21,27 +26,88 @@ const (
 	TracerMarkdown   = "markdown"
 )
 
+// TracerConfig defines the tracer used to collect the execution traces of the
+// EVM transactions when the node is run in trace mode.
+type TracerConfig struct {
+	// Tracer is the tracer type (json|struct|access_list|markdown)
+	Tracer string
+	// LogConfig defines the options of the json, struct and markdown loggers
+	LogConfig logger.Config
+	// Senders and Contracts restrict the traced transactions to the ones sent by
+	// one of the senders or to one of the contracts. All the transactions are
+	// traced if both are empty.
+	Senders   []common.Address
+	Contracts []common.Address
+	// Sink stores the trace of each transaction executed on DeliverTx. The
+	// simulations and the queries aren't traced when it's set. If nil, the
+	// traces of every execution are written to the standard output and error.
+	Sink TraceSink
+	// Logger logs the errors of the sink
+	Logger log.Logger
+}
+
+// IsTraced returns true if the transaction of the given message matches the
+// sender and contract filters.
+func (tc TracerConfig) IsTraced(msg core.Message) bool {
+	if len(tc.Senders) == 0 && len(tc.Contracts) == 0 {
+		return true
+	}
+	if slices.Contains(tc.Senders, msg.From()) {
+		return true
+	}
+	return msg.To() != nil && slices.Contains(tc.Contracts, *msg.To())
+}
+
 // NewTracer creates a new Logger tracer to collect execution traces from an
 // EVM transaction.
-func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
-	// TODO: enable additional log configuration
-	logCfg := &logger.Config{
-		Debug: true,
+func NewTracer(tracerCfg TracerConfig, msg core.Message, cfg *params.ChainConfig, height int64) vm.EVMLogger {
+	if !tracerCfg.IsTraced(msg) {
+		return NewNoOpTracer()
+	}
+
+	logCfg := tracerCfg.LogConfig
+	// the struct logger prints its output only when there's no sink
+	logCfg.Debug = tracerCfg.Sink == nil
+
+	var (
+		jsonOut io.Writer = os.Stderr
+		mdOut   io.Writer = os.Stdout
+		buf     *bytes.Buffer
+	)
+	if tracerCfg.Sink != nil {
+		buf = new(bytes.Buffer)
+		jsonOut, mdOut = buf, buf
 	}
 
-	switch tracer {
+	var tracer vm.EVMLogger
+	switch tracerCfg.Tracer {
 	case TracerAccessList:
+		to := crypto.CreateAddress(msg.From(), msg.Nonce())
+		if msg.To() != nil {
+			to = *msg.To()
+		}
 		preCompiles := vm.DefaultActivePrecompiles(cfg.Rules(big.NewInt(height), cfg.MergeNetsplitBlock != nil))
-		return logger.NewAccessListTracer(msg.AccessList(), msg.From(), *msg.To(), preCompiles)
+		tracer = logger.NewAccessListTracer(msg.AccessList(), msg.From(), to, preCompiles)
 	case TracerJSON:
-		return logger.NewJSONLogger(logCfg, os.Stderr)
+		tracer = logger.NewJSONLogger(&logCfg, jsonOut)
 	case TracerMarkdown:
-		return logger.NewMarkdownLogger(logCfg, os.Stdout) // TODO: Stderr ?
+		tracer = logger.NewMarkdownLogger(&logCfg, mdOut)
 	case TracerStruct:
-		return logger.NewStructLogger(logCfg)
+		tracer = logger.NewStructLogger(&logCfg)
 	default:
 		return NewNoOpTracer()
 	}
+
+	if tracerCfg.Sink == nil {
+		return tracer
+	}
+	return &sinkTracer{
+		EVMLogger: tracer,
+		tracer:    tracerCfg.Tracer,
+		sink:      tracerCfg.Sink,
+		logger:    tracerCfg.Logger,
+		out:       buf,
+	}
 }
 
 // TxTraceResult is the result of a single transaction trace during a block trace.
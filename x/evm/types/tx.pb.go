@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -440,6 +441,206 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetSponsorPolicy defines a Msg for creating or updating the policy under which
+// the sponsor pays for the fees of the Ethereum transactions sent to a contract.
+type MsgSetSponsorPolicy struct {
+	// sponsor is the bech32 address of the account paying for the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the sponsored contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// allowed_methods are the hex encoded 4-byte selectors of the sponsored contract
+	// methods. If empty, all the calls to the contract are sponsored.
+	AllowedMethods []string `protobuf:"bytes,3,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	// budget_per_user is the maximum amount of fees, in the EVM denomination, that
+	// the sponsor pays for each user.
+	BudgetPerUser cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=budget_per_user,json=budgetPerUser,proto3,customtype=cosmossdk.io/math.Int" json:"budget_per_user"`
+}
+
+func (m *MsgSetSponsorPolicy) Reset()         { *m = MsgSetSponsorPolicy{} }
+func (m *MsgSetSponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorPolicy) ProtoMessage()    {}
+func (*MsgSetSponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgSetSponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorPolicy.Merge(m, src)
+}
+func (m *MsgSetSponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorPolicy proto.InternalMessageInfo
+
+func (m *MsgSetSponsorPolicy) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorPolicy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorPolicy) GetAllowedMethods() []string {
+	if m != nil {
+		return m.AllowedMethods
+	}
+	return nil
+}
+
+// MsgSetSponsorPolicyResponse defines the response structure for executing a
+// MsgSetSponsorPolicy message.
+type MsgSetSponsorPolicyResponse struct {
+}
+
+func (m *MsgSetSponsorPolicyResponse) Reset()         { *m = MsgSetSponsorPolicyResponse{} }
+func (m *MsgSetSponsorPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorPolicyResponse) ProtoMessage()    {}
+func (*MsgSetSponsorPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgSetSponsorPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetSponsorPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveSponsorPolicy defines a Msg for removing the policy of a sponsor for a contract.
+type MsgRemoveSponsorPolicy struct {
+	// sponsor is the bech32 address of the account paying for the fees
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract is the hex address of the sponsored contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRemoveSponsorPolicy) Reset()         { *m = MsgRemoveSponsorPolicy{} }
+func (m *MsgRemoveSponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorPolicy) ProtoMessage()    {}
+func (*MsgRemoveSponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgRemoveSponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorPolicy.Merge(m, src)
+}
+func (m *MsgRemoveSponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveSponsorPolicy) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgRemoveSponsorPolicy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgRemoveSponsorPolicyResponse defines the response structure for executing a
+// MsgRemoveSponsorPolicy message.
+type MsgRemoveSponsorPolicyResponse struct {
+}
+
+func (m *MsgRemoveSponsorPolicyResponse) Reset()         { *m = MsgRemoveSponsorPolicyResponse{} }
+func (m *MsgRemoveSponsorPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSponsorPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveSponsorPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgRemoveSponsorPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSponsorPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSponsorPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSponsorPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSponsorPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveSponsorPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSponsorPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSponsorPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSponsorPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -449,74 +650,89 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetSponsorPolicy)(nil), "ethermint.evm.v1.MsgSetSponsorPolicy")
+	proto.RegisterType((*MsgSetSponsorPolicyResponse)(nil), "ethermint.evm.v1.MsgSetSponsorPolicyResponse")
+	proto.RegisterType((*MsgRemoveSponsorPolicy)(nil), "ethermint.evm.v1.MsgRemoveSponsorPolicy")
+	proto.RegisterType((*MsgRemoveSponsorPolicyResponse)(nil), "ethermint.evm.v1.MsgRemoveSponsorPolicyResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 977 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x24, 0x2c, 0xd5, 0x68, 0xab, 0x3a, 0x01, 0xe2, 0x34, 0x07, 0x48,
-	0x2b, 0xc5, 0xd6, 0x2e, 0xd0, 0xc3, 0x9e, 0xba, 0xe9, 0x6e, 0xab, 0x56, 0xbb, 0xa2, 0x32, 0xe9,
-	0x85, 0x22, 0x45, 0xb3, 0xce, 0xec, 0xc4, 0x22, 0xf6, 0x58, 0x9e, 0x89, 0x95, 0x70, 0xec, 0x89,
-	0x1b, 0x20, 0xbe, 0x00, 0x07, 0x4e, 0x9c, 0x90, 0xe8, 0x07, 0xe0, 0x58, 0x71, 0xaa, 0xe0, 0x82,
-	0x38, 0x18, 0x94, 0x45, 0x42, 0xda, 0x1b, 0x7c, 0x02, 0x34, 0x33, 0x4e, 0xb2, 0x69, 0xd8, 0x16,
-	0x4a, 0x51, 0x4f, 0x9e, 0xe7, 0xf7, 0xe6, 0xbd, 0x37, 0xbf, 0xdf, 0x6f, 0xfe, 0x80, 0x1a, 0xe6,
-	0x43, 0x1c, 0xf9, 0x5e, 0xc0, 0x6d, 0x1c, 0xfb, 0x76, 0xbc, 0x65, 0xf3, 0x89, 0x15, 0x46, 0x94,
-	0x53, 0x78, 0x61, 0xe1, 0xb2, 0x70, 0xec, 0x5b, 0xf1, 0x56, 0xfd, 0x92, 0x4b, 0x99, 0x4f, 0x99,
-	0xed, 0x33, 0x22, 0x22, 0x7d, 0x46, 0x54, 0x68, 0xbd, 0xa6, 0x1c, 0x7d, 0x69, 0xd9, 0xca, 0x48,
-	0x5d, 0xf5, 0xb5, 0x02, 0x22, 0x99, 0xf2, 0x6d, 0x12, 0x4a, 0xa8, 0x9a, 0x23, 0x46, 0xe9, 0xdf,
-	0xd7, 0x09, 0xa5, 0x64, 0x84, 0x6d, 0x14, 0x7a, 0x36, 0x0a, 0x02, 0xca, 0x11, 0xf7, 0x68, 0x30,
-	0xcf, 0x57, 0x4b, 0xbd, 0xd2, 0x3a, 0x1a, 0x1f, 0xdb, 0x28, 0x98, 0x2a, 0x57, 0xeb, 0x53, 0x0d,
-	0xbc, 0x72, 0xc8, 0xc8, 0xbe, 0x28, 0x88, 0xc7, 0x7e, 0x6f, 0x02, 0xdb, 0x40, 0x1f, 0x20, 0x8e,
-	0x0c, 0xad, 0xa9, 0xb5, 0x2b, 0xdb, 0x9b, 0x96, 0x9a, 0x6b, 0xcd, 0xe7, 0x5a, 0xbb, 0xc1, 0xd4,
-	0x91, 0x11, 0xb0, 0x06, 0x74, 0xe6, 0x7d, 0x8c, 0x8d, 0x6c, 0x53, 0x6b, 0x6b, 0xdd, 0xfc, 0x69,
-	0x62, 0x6a, 0x1d, 0x47, 0xfe, 0x82, 0x26, 0xd0, 0x87, 0x88, 0x0d, 0x8d, 0x5c, 0x53, 0x6b, 0x97,
-	0xbb, 0x95, 0x3f, 0x13, 0xb3, 0x18, 0x8d, 0xc2, 0x9d, 0x56, 0xa7, 0xe5, 0x48, 0x07, 0x84, 0x40,
-	0x3f, 0x8e, 0xa8, 0x6f, 0xe8, 0x22, 0xc0, 0x91, 0xe3, 0x1d, 0xfd, 0x93, 0x2f, 0xcd, 0x4c, 0xeb,
-	0xdb, 0x2c, 0x28, 0x1d, 0x60, 0x82, 0xdc, 0x69, 0x6f, 0x02, 0x37, 0x41, 0x3e, 0xa0, 0x81, 0x8b,
-	0x65, 0x37, 0xba, 0xa3, 0x0c, 0x78, 0x0b, 0x94, 0x09, 0x12, 0xc8, 0x79, 0xae, 0xaa, 0x5e, 0xee,
-	0x5e, 0xfd, 0x39, 0x31, 0xdf, 0x24, 0x1e, 0x1f, 0x8e, 0x8f, 0x2c, 0x97, 0xfa, 0x29, 0x9e, 0xe9,
-	0xa7, 0xc3, 0x06, 0x1f, 0xd9, 0x7c, 0x1a, 0x62, 0x66, 0xdd, 0x0e, 0xb8, 0x53, 0x22, 0x88, 0xdd,
-	0x15, 0x73, 0x61, 0x03, 0xe4, 0x08, 0x62, 0xb2, 0x4b, 0xbd, 0x5b, 0x9d, 0x25, 0x66, 0xe9, 0x16,
-	0x62, 0x07, 0x9e, 0xef, 0x71, 0x47, 0x38, 0xe0, 0x06, 0xc8, 0x72, 0x9a, 0xf6, 0x98, 0xe5, 0x14,
-	0xde, 0x01, 0xf9, 0x18, 0x8d, 0xc6, 0xd8, 0xc8, 0xcb, 0xa2, 0xef, 0xfc, 0xf3, 0xa2, 0xb3, 0xc4,
-	0x2c, 0xec, 0xfa, 0x74, 0x1c, 0x70, 0x47, 0xa5, 0x10, 0x08, 0x48, 0x9c, 0x0b, 0x4d, 0xad, 0x5d,
-	0x4d, 0x11, 0xad, 0x02, 0x2d, 0x36, 0x8a, 0xf2, 0x87, 0x16, 0x0b, 0x2b, 0x32, 0x4a, 0xca, 0x8a,
-	0x84, 0xc5, 0x8c, 0xb2, 0xb2, 0xd8, 0xce, 0x86, 0xc0, 0xea, 0xfb, 0x87, 0x9d, 0x42, 0x6f, 0xb2,
-	0x87, 0x38, 0x6a, 0xfd, 0x91, 0x03, 0xd5, 0x5d, 0xd7, 0xc5, 0x8c, 0x1d, 0x78, 0x8c, 0xf7, 0x26,
-	0xf0, 0x3e, 0x28, 0xb9, 0x43, 0xe4, 0x05, 0x7d, 0x6f, 0x20, 0xc1, 0x2b, 0x77, 0xaf, 0xff, 0xab,
-	0x6e, 0x8b, 0x37, 0xc4, 0xec, 0xdb, 0x7b, 0xa7, 0x89, 0x59, 0x74, 0xd5, 0xd0, 0x49, 0x07, 0x83,
-	0x25, 0x2d, 0xd9, 0x73, 0x69, 0xc9, 0xfd, 0x77, 0x5a, 0xf4, 0xa7, 0xd3, 0x92, 0x5f, 0xa7, 0xa5,
-	0xf0, 0xe2, 0x68, 0x29, 0x9e, 0xa1, 0xe5, 0x3e, 0x28, 0x21, 0x89, 0x2d, 0x66, 0x46, 0xa9, 0x99,
-	0x6b, 0x57, 0xb6, 0xdf, 0xb0, 0x9e, 0xdc, 0xe8, 0x96, 0x42, 0xbf, 0x37, 0x0e, 0x47, 0xb8, 0xdb,
-	0x7c, 0x94, 0x98, 0x99, 0xd3, 0xc4, 0x04, 0x68, 0x41, 0xc9, 0xd7, 0xbf, 0x98, 0x60, 0x49, 0x90,
-	0xb3, 0x48, 0xa8, 0x38, 0x2f, 0xaf, 0x70, 0x0e, 0x56, 0x38, 0xaf, 0x9c, 0xc7, 0xf9, 0x77, 0x3a,
-	0xa8, 0xee, 0x4d, 0x03, 0xe4, 0x7b, 0xee, 0x4d, 0x8c, 0x5f, 0x0e, 0xe7, 0x77, 0x40, 0x45, 0x70,
-	0xce, 0xbd, 0xb0, 0xef, 0xa2, 0xf0, 0x39, 0x58, 0x17, 0x92, 0xe9, 0x79, 0xe1, 0x0d, 0x14, 0xce,
-	0x73, 0x1d, 0x63, 0x2c, 0x73, 0xe9, 0xcf, 0x95, 0xeb, 0x26, 0xc6, 0x22, 0x57, 0x2a, 0xa1, 0xfc,
-	0xd3, 0x25, 0x54, 0x58, 0x97, 0x50, 0xf1, 0xc5, 0x49, 0xa8, 0x74, 0x8e, 0x84, 0xca, 0xff, 0x8b,
-	0x84, 0xc0, 0x8a, 0x84, 0x2a, 0x2b, 0x12, 0xaa, 0x9e, 0x27, 0xa1, 0x16, 0xa8, 0xef, 0x4f, 0x38,
-	0x0e, 0x98, 0x47, 0x83, 0xf7, 0x42, 0x79, 0x67, 0x2c, 0xaf, 0x82, 0xf4, 0x40, 0xfe, 0x4a, 0x03,
-	0x17, 0x57, 0xae, 0x08, 0x07, 0xb3, 0x90, 0x06, 0x4c, 0x2e, 0x54, 0x9e, 0xf2, 0x9a, 0x3a, 0xc4,
-	0xe5, 0xc1, 0x7e, 0x05, 0xe8, 0x23, 0x4a, 0x98, 0x91, 0x95, 0x8b, 0xbc, 0xb8, 0xbe, 0xc8, 0x03,
-	0x4a, 0x1c, 0x19, 0x02, 0x2f, 0x80, 0x5c, 0x84, 0xb9, 0xd4, 0x4c, 0xd5, 0x11, 0x43, 0x58, 0x03,
-	0xa5, 0xd8, 0xef, 0xe3, 0x28, 0xa2, 0x51, 0x7a, 0xea, 0x16, 0x63, 0x7f, 0x5f, 0x98, 0xc2, 0x25,
-	0xc4, 0x31, 0x66, 0x78, 0xa0, 0x58, 0x75, 0x8a, 0x04, 0xb1, 0x7b, 0x0c, 0x0f, 0xd2, 0x36, 0x3f,
-	0xd7, 0xc0, 0xab, 0x87, 0x8c, 0xdc, 0x0b, 0x07, 0x88, 0xe3, 0xbb, 0x28, 0x42, 0x3e, 0x83, 0xd7,
-	0x40, 0x19, 0x8d, 0xf9, 0x90, 0x46, 0x1e, 0x9f, 0xa6, 0x3b, 0xc2, 0xf8, 0xe1, 0x61, 0x67, 0x33,
-	0xbd, 0x6d, 0x77, 0x07, 0x83, 0x08, 0x33, 0xf6, 0x3e, 0x8f, 0xbc, 0x80, 0x38, 0xcb, 0x50, 0x78,
-	0x0d, 0x14, 0x42, 0x99, 0x41, 0x8a, 0xbd, 0xb2, 0x6d, 0xac, 0x2f, 0x43, 0x55, 0xe8, 0xea, 0x82,
-	0x26, 0x27, 0x8d, 0xde, 0xd9, 0x78, 0xf0, 0xfb, 0x37, 0x57, 0x97, 0x79, 0x5a, 0x35, 0x70, 0xe9,
-	0x89, 0x96, 0xe6, 0xd8, 0x6d, 0x27, 0x1a, 0xc8, 0x1d, 0x32, 0x02, 0xa7, 0x00, 0x9c, 0xb9, 0x7c,
-	0xcd, 0xf5, 0x42, 0x2b, 0xd0, 0xd7, 0xdf, 0x7a, 0x46, 0xc0, 0x3c, 0x7f, 0xeb, 0xf2, 0x83, 0x1f,
-	0x7f, 0xfb, 0x22, 0xfb, 0x5a, 0xab, 0x26, 0xde, 0x0e, 0x94, 0x2d, 0x1e, 0x12, 0x69, 0x64, 0x9f,
-	0x4f, 0xe0, 0x87, 0xa0, 0xba, 0x82, 0xd6, 0xe5, 0xbf, 0xcd, 0x7d, 0x36, 0xa4, 0x7e, 0xe5, 0x99,
-	0x21, 0xf3, 0x06, 0xba, 0xd7, 0x1f, 0xcd, 0x1a, 0xda, 0xe3, 0x59, 0x43, 0xfb, 0x75, 0xd6, 0xd0,
-	0x3e, 0x3b, 0x69, 0x64, 0x1e, 0x9f, 0x34, 0x32, 0x3f, 0x9d, 0x34, 0x32, 0x1f, 0x9c, 0xdd, 0x58,
-	0x8b, 0xe6, 0x28, 0xb3, 0xe3, 0xad, 0x77, 0xed, 0x89, 0x6c, 0x54, 0x6e, 0xae, 0xa3, 0x82, 0x7c,
-	0x73, 0xbc, 0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x09, 0x53, 0x2a, 0x70, 0x09, 0x00,
-	0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0xeb, 0x5f, 0x63, 0x37, 0xad, 0xa6, 0x29, 0x5d, 0xbb, 0xd4, 0xeb, 0x5a, 0x82,
+	0xba, 0x95, 0xec, 0xa5, 0x01, 0x7a, 0xc8, 0xa9, 0x71, 0x9b, 0x56, 0xad, 0x62, 0x11, 0x6d, 0x9c,
+	0x0b, 0x45, 0xb2, 0x26, 0xbb, 0x93, 0xf5, 0xaa, 0xde, 0x9d, 0x65, 0x67, 0xbc, 0xd8, 0x1c, 0x7b,
+	0xe2, 0x06, 0x88, 0x7f, 0x80, 0x03, 0x27, 0x4e, 0x48, 0xf4, 0xc6, 0x85, 0x63, 0xc5, 0xa9, 0x82,
+	0x0b, 0xea, 0xc1, 0xa0, 0x04, 0x09, 0x29, 0x37, 0xf8, 0x0b, 0xd0, 0xcc, 0xac, 0x9d, 0x38, 0x76,
+	0x9a, 0x50, 0x5a, 0x71, 0xda, 0x79, 0xf3, 0xbe, 0x79, 0xef, 0xcd, 0xfb, 0xbe, 0x99, 0x59, 0x50,
+	0xc4, 0xac, 0x8b, 0x43, 0xcf, 0xf5, 0x99, 0x81, 0x23, 0xcf, 0x88, 0x6e, 0x18, 0x6c, 0xd0, 0x08,
+	0x42, 0xc2, 0x08, 0x3c, 0x37, 0x71, 0x35, 0x70, 0xe4, 0x35, 0xa2, 0x1b, 0xa5, 0x8b, 0x16, 0xa1,
+	0x1e, 0xa1, 0x86, 0x47, 0x1d, 0x8e, 0xf4, 0xa8, 0x23, 0xa1, 0xa5, 0xa2, 0x74, 0x74, 0x84, 0x65,
+	0x48, 0x23, 0x76, 0x95, 0x66, 0x12, 0xf0, 0x60, 0xd2, 0xb7, 0xe4, 0x10, 0x87, 0xc8, 0x35, 0x7c,
+	0x14, 0xcf, 0xbe, 0xe9, 0x10, 0xe2, 0xf4, 0xb0, 0x81, 0x02, 0xd7, 0x40, 0xbe, 0x4f, 0x18, 0x62,
+	0x2e, 0xf1, 0xc7, 0xf1, 0x8a, 0xb1, 0x57, 0x58, 0xdb, 0xfd, 0x1d, 0x03, 0xf9, 0x43, 0xe9, 0xaa,
+	0x7e, 0xae, 0x80, 0x33, 0x2d, 0xea, 0xac, 0xf1, 0x84, 0xb8, 0xef, 0xb5, 0x07, 0xb0, 0x06, 0x54,
+	0x1b, 0x31, 0xa4, 0x29, 0x15, 0xa5, 0x96, 0x5f, 0x5e, 0x6a, 0xc8, 0xb5, 0x8d, 0xf1, 0xda, 0xc6,
+	0xaa, 0x3f, 0x34, 0x05, 0x02, 0x16, 0x81, 0x4a, 0xdd, 0x4f, 0xb1, 0x96, 0xa8, 0x28, 0x35, 0xa5,
+	0x99, 0xda, 0x1f, 0xe9, 0x4a, 0xdd, 0x14, 0x53, 0x50, 0x07, 0x6a, 0x17, 0xd1, 0xae, 0x96, 0xac,
+	0x28, 0xb5, 0x5c, 0x33, 0xff, 0xf7, 0x48, 0xcf, 0x84, 0xbd, 0x60, 0xa5, 0x5a, 0xaf, 0x9a, 0xc2,
+	0x01, 0x21, 0x50, 0x77, 0x42, 0xe2, 0x69, 0x2a, 0x07, 0x98, 0x62, 0xbc, 0xa2, 0x7e, 0xf6, 0xb5,
+	0xbe, 0x50, 0xfd, 0x3e, 0x01, 0xb2, 0xeb, 0xd8, 0x41, 0xd6, 0xb0, 0x3d, 0x80, 0x4b, 0x20, 0xe5,
+	0x13, 0xdf, 0xc2, 0xa2, 0x1a, 0xd5, 0x94, 0x06, 0xbc, 0x07, 0x72, 0x0e, 0xe2, 0x9d, 0x73, 0x2d,
+	0x99, 0x3d, 0xd7, 0xbc, 0xfe, 0x7c, 0xa4, 0xbf, 0xed, 0xb8, 0xac, 0xdb, 0xdf, 0x6e, 0x58, 0xc4,
+	0x8b, 0xfb, 0x19, 0x7f, 0xea, 0xd4, 0x7e, 0x64, 0xb0, 0x61, 0x80, 0x69, 0xe3, 0xbe, 0xcf, 0xcc,
+	0xac, 0x83, 0xe8, 0x06, 0x5f, 0x0b, 0xcb, 0x20, 0xe9, 0x20, 0x2a, 0xaa, 0x54, 0x9b, 0x85, 0xdd,
+	0x91, 0x9e, 0xbd, 0x87, 0xe8, 0xba, 0xeb, 0xb9, 0xcc, 0xe4, 0x0e, 0xb8, 0x08, 0x12, 0x8c, 0xc4,
+	0x35, 0x26, 0x18, 0x81, 0x0f, 0x40, 0x2a, 0x42, 0xbd, 0x3e, 0xd6, 0x52, 0x22, 0xe9, 0x7b, 0xa7,
+	0x4f, 0xba, 0x3b, 0xd2, 0xd3, 0xab, 0x1e, 0xe9, 0xfb, 0xcc, 0x94, 0x21, 0x78, 0x07, 0x44, 0x9f,
+	0xd3, 0x15, 0xa5, 0x56, 0x88, 0x3b, 0x5a, 0x00, 0x4a, 0xa4, 0x65, 0xc4, 0x84, 0x12, 0x71, 0x2b,
+	0xd4, 0xb2, 0xd2, 0x0a, 0xb9, 0x45, 0xb5, 0x9c, 0xb4, 0xe8, 0xca, 0x22, 0xef, 0xd5, 0x4f, 0x4f,
+	0xea, 0xe9, 0xf6, 0xe0, 0x0e, 0x62, 0xa8, 0xfa, 0x57, 0x12, 0x14, 0x56, 0x2d, 0x0b, 0x53, 0xba,
+	0xee, 0x52, 0xd6, 0x1e, 0xc0, 0x87, 0x20, 0x6b, 0x75, 0x91, 0xeb, 0x77, 0x5c, 0x5b, 0x34, 0x2f,
+	0xd7, 0xbc, 0xf5, 0xaf, 0xaa, 0xcd, 0xdc, 0xe6, 0xab, 0xef, 0xdf, 0xd9, 0x1f, 0xe9, 0x19, 0x4b,
+	0x0e, 0xcd, 0x78, 0x60, 0x1f, 0xd0, 0x92, 0x38, 0x96, 0x96, 0xe4, 0x7f, 0xa7, 0x45, 0x7d, 0x31,
+	0x2d, 0xa9, 0x59, 0x5a, 0xd2, 0xaf, 0x8e, 0x96, 0xcc, 0x21, 0x5a, 0x1e, 0x82, 0x2c, 0x12, 0xbd,
+	0xc5, 0x54, 0xcb, 0x56, 0x92, 0xb5, 0xfc, 0xf2, 0xe5, 0xc6, 0xd1, 0x83, 0xde, 0x90, 0xdd, 0x6f,
+	0xf7, 0x83, 0x1e, 0x6e, 0x56, 0x9e, 0x8e, 0xf4, 0x85, 0xfd, 0x91, 0x0e, 0xd0, 0x84, 0x92, 0x6f,
+	0x7f, 0xd3, 0xc1, 0x01, 0x41, 0xe6, 0x24, 0xa0, 0xe4, 0x3c, 0x37, 0xc5, 0x39, 0x98, 0xe2, 0x3c,
+	0x7f, 0x1c, 0xe7, 0x3f, 0xaa, 0xa0, 0x70, 0x67, 0xe8, 0x23, 0xcf, 0xb5, 0xee, 0x62, 0xfc, 0xff,
+	0x70, 0xfe, 0x00, 0xe4, 0x39, 0xe7, 0xcc, 0x0d, 0x3a, 0x16, 0x0a, 0x5e, 0x82, 0x75, 0x2e, 0x99,
+	0xb6, 0x1b, 0xdc, 0x46, 0xc1, 0x38, 0xd6, 0x0e, 0xc6, 0x22, 0x96, 0xfa, 0x52, 0xb1, 0xee, 0x62,
+	0xcc, 0x63, 0xc5, 0x12, 0x4a, 0xbd, 0x58, 0x42, 0xe9, 0x59, 0x09, 0x65, 0x5e, 0x9d, 0x84, 0xb2,
+	0xc7, 0x48, 0x28, 0xf7, 0x5a, 0x24, 0x04, 0xa6, 0x24, 0x94, 0x9f, 0x92, 0x50, 0xe1, 0x38, 0x09,
+	0x55, 0x41, 0x69, 0x6d, 0xc0, 0xb0, 0x4f, 0x5d, 0xe2, 0x7f, 0x10, 0x88, 0x37, 0xe3, 0xe0, 0x29,
+	0x88, 0x2f, 0xe4, 0x6f, 0x14, 0x70, 0x61, 0xea, 0x89, 0x30, 0x31, 0x0d, 0x88, 0x4f, 0xc5, 0x46,
+	0xc5, 0x2d, 0xaf, 0xc8, 0x4b, 0x9c, 0x8f, 0xe1, 0x35, 0xa0, 0xf6, 0x88, 0x43, 0xb5, 0x84, 0xd8,
+	0xe4, 0x85, 0xd9, 0x4d, 0xae, 0x13, 0xc7, 0x14, 0x10, 0x78, 0x0e, 0x24, 0x43, 0xcc, 0x84, 0x66,
+	0x0a, 0x26, 0x1f, 0xc2, 0x22, 0xc8, 0x46, 0x5e, 0x07, 0x87, 0x21, 0x09, 0xe3, 0x5b, 0x37, 0x13,
+	0x79, 0x6b, 0xdc, 0xe4, 0x2e, 0x2e, 0x8e, 0x3e, 0xc5, 0xb6, 0x64, 0xd5, 0xcc, 0x38, 0x88, 0x6e,
+	0x51, 0x6c, 0xc7, 0x65, 0x7e, 0xa9, 0x80, 0xb3, 0x2d, 0xea, 0x6c, 0x05, 0x36, 0x62, 0x78, 0x03,
+	0x85, 0xc8, 0xa3, 0xf0, 0x26, 0xc8, 0xa1, 0x3e, 0xeb, 0x92, 0xd0, 0x65, 0xc3, 0xf8, 0x44, 0x68,
+	0x3f, 0x3f, 0xa9, 0x2f, 0xc5, 0xaf, 0xed, 0xaa, 0x6d, 0x87, 0x98, 0xd2, 0x4d, 0x16, 0xba, 0xbe,
+	0x63, 0x1e, 0x40, 0xe1, 0x4d, 0x90, 0x0e, 0x44, 0x04, 0x21, 0xf6, 0xfc, 0xb2, 0x36, 0xbb, 0x0d,
+	0x99, 0xa1, 0xa9, 0x72, 0x9a, 0xcc, 0x18, 0xbd, 0xb2, 0xf8, 0xf8, 0xcf, 0xef, 0xae, 0x1f, 0xc4,
+	0xa9, 0x16, 0xc1, 0xc5, 0x23, 0x25, 0x8d, 0x7b, 0x57, 0xdd, 0x53, 0xc0, 0xf9, 0x16, 0x75, 0x36,
+	0x31, 0xdb, 0xe4, 0x13, 0x24, 0xdc, 0x20, 0x3d, 0xd7, 0x1a, 0xc2, 0x65, 0x90, 0xa1, 0x72, 0xe2,
+	0xc4, 0x82, 0xc7, 0x40, 0x58, 0x02, 0x59, 0x8b, 0xf8, 0x2c, 0x44, 0x16, 0x93, 0xcf, 0xa1, 0x39,
+	0xb1, 0xe1, 0x55, 0x70, 0x16, 0xf5, 0x7a, 0xe4, 0x13, 0x6c, 0x77, 0x3c, 0xcc, 0xba, 0xc4, 0xe6,
+	0xcf, 0x5d, 0xb2, 0x96, 0x33, 0x17, 0xe3, 0xe9, 0x96, 0x9c, 0x85, 0x6b, 0xe0, 0xec, 0x76, 0xdf,
+	0x76, 0x30, 0xeb, 0x04, 0x38, 0xe4, 0x7d, 0x8e, 0x29, 0x68, 0x5e, 0xe6, 0x5b, 0x7c, 0x3e, 0xd2,
+	0x2f, 0xc8, 0x22, 0xa8, 0xfd, 0xa8, 0xe1, 0x12, 0xc3, 0x43, 0xac, 0x2b, 0x0e, 0xdd, 0x19, 0xb9,
+	0x6a, 0x03, 0x87, 0x5b, 0x14, 0x87, 0x2b, 0x05, 0xde, 0x82, 0x71, 0x65, 0xd5, 0xcb, 0xe0, 0xd2,
+	0x9c, 0x4d, 0x4e, 0x9a, 0x10, 0x81, 0x37, 0x5a, 0xd4, 0x31, 0xb1, 0x47, 0x22, 0xfc, 0x5a, 0xdb,
+	0x70, 0xa4, 0xac, 0x0a, 0x28, 0xcf, 0xcf, 0x3b, 0xae, 0x6c, 0xf9, 0x87, 0x24, 0x48, 0xb6, 0xa8,
+	0x03, 0x87, 0x00, 0x1c, 0xfa, 0x37, 0xd2, 0x67, 0x75, 0x30, 0x75, 0x32, 0x4a, 0x57, 0x4f, 0x00,
+	0x4c, 0x76, 0x7e, 0xe5, 0xf1, 0x2f, 0x7f, 0x7c, 0x95, 0xb8, 0x54, 0x2d, 0xf2, 0x5f, 0x3b, 0x42,
+	0x27, 0xff, 0x79, 0x31, 0xb2, 0xc3, 0x06, 0xf0, 0x23, 0x50, 0x98, 0x12, 0xf3, 0x95, 0xb9, 0xb1,
+	0x0f, 0x43, 0x4a, 0xd7, 0x4e, 0x84, 0x4c, 0xce, 0x6e, 0x17, 0x9c, 0x9b, 0xd1, 0xde, 0x5b, 0x73,
+	0x97, 0x1f, 0x85, 0x95, 0xea, 0xa7, 0x82, 0x4d, 0x32, 0x7d, 0x0c, 0xce, 0xcf, 0x63, 0xb8, 0x36,
+	0x37, 0xca, 0x1c, 0x64, 0xe9, 0x9d, 0xd3, 0x22, 0xc7, 0x29, 0x9b, 0xb7, 0x9e, 0xee, 0x96, 0x95,
+	0x67, 0xbb, 0x65, 0xe5, 0xf7, 0xdd, 0xb2, 0xf2, 0xc5, 0x5e, 0x79, 0xe1, 0xd9, 0x5e, 0x79, 0xe1,
+	0xd7, 0xbd, 0xf2, 0xc2, 0x87, 0x87, 0x2f, 0xf5, 0x49, 0xe7, 0x09, 0x35, 0xa2, 0x1b, 0xef, 0x1b,
+	0x03, 0xc1, 0x82, 0xb8, 0xd8, 0xb7, 0xd3, 0xe2, 0x7f, 0xf7, 0xdd, 0x7f, 0x06, 0x00, 0x17, 0xea,
+	0x69, 0x9b, 0xec, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -536,6 +752,11 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetSponsorPolicy defines a method to create or update the policy under which a sponsor
+	// pays for the fees of the Ethereum transactions sent to a contract.
+	SetSponsorPolicy(ctx context.Context, in *MsgSetSponsorPolicy, opts ...grpc.CallOption) (*MsgSetSponsorPolicyResponse, error)
+	// RemoveSponsorPolicy defines a method to remove the sponsor policy of a contract.
+	RemoveSponsorPolicy(ctx context.Context, in *MsgRemoveSponsorPolicy, opts ...grpc.CallOption) (*MsgRemoveSponsorPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +785,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetSponsorPolicy(ctx context.Context, in *MsgSetSponsorPolicy, opts ...grpc.CallOption) (*MsgSetSponsorPolicyResponse, error) {
+	out := new(MsgSetSponsorPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/SetSponsorPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSponsorPolicy(ctx context.Context, in *MsgRemoveSponsorPolicy, opts ...grpc.CallOption) (*MsgRemoveSponsorPolicyResponse, error) {
+	out := new(MsgRemoveSponsorPolicyResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/RemoveSponsorPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -571,6 +810,11 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSponsorPolicy defines a method to create or update the policy under which a sponsor
+	// pays for the fees of the Ethereum transactions sent to a contract.
+	SetSponsorPolicy(context.Context, *MsgSetSponsorPolicy) (*MsgSetSponsorPolicyResponse, error)
+	// RemoveSponsorPolicy defines a method to remove the sponsor policy of a contract.
+	RemoveSponsorPolicy(context.Context, *MsgRemoveSponsorPolicy) (*MsgRemoveSponsorPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +827,12 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetSponsorPolicy(ctx context.Context, req *MsgSetSponsorPolicy) (*MsgSetSponsorPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSponsorPolicy(ctx context.Context, req *MsgRemoveSponsorPolicy) (*MsgRemoveSponsorPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSponsorPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +874,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSponsorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/SetSponsorPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorPolicy(ctx, req.(*MsgSetSponsorPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSponsorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSponsorPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSponsorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/RemoveSponsorPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSponsorPolicy(ctx, req.(*MsgRemoveSponsorPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +922,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetSponsorPolicy",
+			Handler:    _Msg_SetSponsorPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveSponsorPolicy",
+			Handler:    _Msg_RemoveSponsorPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1185,6 +1479,145 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BudgetPerUser.Size()
+		i -= size
+		if _, err := m.BudgetPerUser.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedMethods) > 0 {
+		for iNdEx := len(m.AllowedMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMethods[iNdEx])
+			copy(dAtA[i:], m.AllowedMethods[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSponsorPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSponsorPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSponsorPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1436,7 +1869,67 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgSetSponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedMethods) > 0 {
+		for _, s := range m.AllowedMethods {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.BudgetPerUser.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSponsorPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSponsorPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -3179,6 +3672,400 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSponsorPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMethods = append(m.AllowedMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetPerUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BudgetPerUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSponsorPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSponsorPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSponsorPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSponsorPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSponsorPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSponsorPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
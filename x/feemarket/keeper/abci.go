@@ -37,7 +37,9 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock updates the block gas wanted used by the base fee calculation, floored by the gas
+// used by the block transactions, and records the block on the fee history with the gas
+// wanted by the transactions.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
@@ -47,15 +49,15 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	}
 
 	gasWanted := sdkmath.NewIntFromUint64(k.GetTransientGasWanted(ctx))
-	gasUsed := sdkmath.NewIntFromUint64(ctx.BlockGasMeter().GasConsumedToLimit())
+	gasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
 
 	if !gasWanted.IsInt64() {
 		k.Logger(ctx).Error("integer overflow by integer type conversion. Gas wanted > MaxInt64", "gas wanted", gasWanted.String())
 		return
 	}
 
-	if !gasUsed.IsInt64() {
-		k.Logger(ctx).Error("integer overflow by integer type conversion. Gas used > MaxInt64", "gas used", gasUsed.String())
+	if !sdkmath.NewIntFromUint64(gasUsed).IsInt64() {
+		k.Logger(ctx).Error("integer overflow by integer type conversion. Gas used > MaxInt64", "gas used", gasUsed)
 		return
 	}
 
@@ -63,11 +65,31 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := sdk.NewDec(gasWanted.Int64()).Mul(minGasMultiplier)
-	updatedGasWanted := sdk.MaxDec(limitedGasWanted, sdk.NewDec(gasUsed.Int64())).TruncateInt().Uint64()
+	params := k.GetParams(ctx)
+	limitedGasWanted := sdk.NewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
+	updatedGasWanted := sdk.MaxDec(limitedGasWanted, sdk.NewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// record the block on the fee history
+	baseFee := sdkmath.ZeroInt()
+	if bf := k.GetBaseFee(ctx); bf != nil {
+		baseFee = sdkmath.NewIntFromBigInt(bf)
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	var gasLimit uint64
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > 0 {
+		gasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	k.SetBlockFeeInfo(ctx, params.FeeHistorySize, types.BlockFeeInfo{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   baseFee,
+		GasWanted: gasWanted.Uint64(),
+		GasUsed:   gasUsed,
+		GasLimit:  gasLimit,
+	})
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -9,26 +9,50 @@ import (
 
 func (suite *KeeperTestSuite) TestEndBlock() {
 	testCases := []struct {
-		name         string
-		NoBaseFee    bool
-		malleate     func()
-		expGasWanted uint64
+		name             string
+		NoBaseFee        bool
+		malleate         func()
+		expGasWanted     uint64
+		expInfoGasWanted uint64
+		expGasUsed       uint64
+		expFeeInfo       bool
 	}{
 		{
 			"baseFee nil",
 			true,
 			func() {},
 			uint64(0),
+			uint64(0),
+			uint64(0),
+			false,
 		},
 		{
-			"pass",
+			"pass - gas wanted floored by the min gas multiplier",
 			false,
 			func() {
 				meter := sdk.NewGasMeter(uint64(1000000000))
+				meter.ConsumeGas(2000000, "txs")
 				suite.ctx = suite.ctx.WithBlockGasMeter(meter)
 				suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
 			},
 			uint64(2500000),
+			uint64(5000000),
+			uint64(2000000),
+			true,
+		},
+		{
+			"pass - gas used higher than the min gas multiplier floor",
+			false,
+			func() {
+				meter := sdk.NewGasMeter(uint64(1000000000))
+				meter.ConsumeGas(4000000, "txs")
+				suite.ctx = suite.ctx.WithBlockGasMeter(meter)
+				suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
+			},
+			uint64(4000000),
+			uint64(5000000),
+			uint64(4000000),
+			true,
 		},
 	}
 	for _, tc := range testCases {
@@ -43,6 +67,11 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 			suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 1})
 			gasWanted := suite.app.FeeMarketKeeper.GetBlockGasWanted(suite.ctx)
 			suite.Require().Equal(tc.expGasWanted, gasWanted, tc.name)
+
+			info, found := suite.app.FeeMarketKeeper.GetBlockFeeInfo(suite.ctx, suite.ctx.BlockHeight())
+			suite.Require().Equal(tc.expFeeInfo, found, tc.name)
+			suite.Require().Equal(tc.expInfoGasWanted, info.GasWanted, tc.name)
+			suite.Require().Equal(tc.expGasUsed, info.GasUsed, tc.name)
 		})
 	}
 }
@@ -9,10 +9,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/evmos/v15/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// The parent block gas wanted is compared to the GasTarget parameter, or to the block gas limit divided by the
+// elasticity multiplier if it's not set, and the result is bounded by the MinGasPrice and MaxBaseFee parameters.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
@@ -43,18 +47,23 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
-	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
+	parentGasTargetBig := new(big.Int).SetUint64(params.GasTarget)
 
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = big.NewInt(consParams.Block.MaxGas)
-	}
+	// derive the gas target from the block gas limit if it's not set on the parameters
+	if params.GasTarget == 0 {
+		gasLimit := new(big.Int).SetUint64(math.MaxUint64)
 
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetBig.IsUint64() {
-		return nil
+		// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+		if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
+			gasLimit = big.NewInt(consParams.Block.MaxGas)
+		}
+
+		// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+		// validation
+		parentGasTargetBig = new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
+		if !parentGasTargetBig.IsUint64() {
+			return nil
+		}
 	}
 
 	parentGasTarget := parentGasTargetBig.Uint64()
@@ -63,7 +72,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// If the parent gasUsed is the same as the target, the baseFee remains
 	// unchanged.
 	if parentGasUsed == parentGasTarget {
-		return new(big.Int).Set(parentBaseFee)
+		return capBaseFee(new(big.Int).Set(parentBaseFee), params)
 	}
 
 	if parentGasUsed > parentGasTarget {
@@ -77,7 +86,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 			common.Big1,
 		)
 
-		return x.Add(parentBaseFee, baseFeeDelta)
+		return capBaseFee(x.Add(parentBaseFee, baseFeeDelta), params)
 	}
 
 	// Otherwise if the parent block used less gas than its target, the baseFee
@@ -90,5 +99,15 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// Set global min gas price as lower bound of the base fee, transactions below
 	// the min gas price don't even reach the mempool.
 	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
-	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
+	return capBaseFee(math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice), params)
+}
+
+// capBaseFee bounds the given base fee by the governance max base fee. A nil or
+// zero max base fee means that the base fee is not capped.
+func capBaseFee(baseFee *big.Int, params types.Params) *big.Int {
+	if !params.HasMaxBaseFee() {
+		return baseFee
+	}
+
+	return math.BigMin(baseFee, params.MaxBaseFee.BigInt())
 }
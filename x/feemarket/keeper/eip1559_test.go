@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -115,3 +116,100 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeGasTargetAndMaxBaseFee() {
+	testCases := []struct {
+		name                 string
+		gasTarget            uint64
+		maxBaseFee           sdkmath.Int
+		parentBaseFee        sdkmath.Int
+		parentBlockGasWanted uint64
+		expFee               *big.Int
+	}{
+		{
+			"gas target set - parent block wanted the same gas as the gas target",
+			25,
+			sdkmath.ZeroInt(),
+			sdkmath.Int{},
+			25,
+			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+		},
+		{
+			"gas target set - parent block wanted more gas than the gas target",
+			25,
+			sdkmath.ZeroInt(),
+			sdkmath.Int{},
+			50,
+			big.NewInt(1125000000),
+		},
+		{
+			"max base fee set - base fee increase limited by the max base fee",
+			0,
+			sdkmath.NewInt(1100000000),
+			sdkmath.Int{},
+			100,
+			big.NewInt(1100000000),
+		},
+		{
+			"max base fee set - base fee decrease not affected",
+			0,
+			sdkmath.NewInt(1100000000),
+			sdkmath.Int{},
+			25,
+			big.NewInt(937500000),
+		},
+		{
+			"max base fee set - unchanged base fee higher than the max base fee",
+			0,
+			sdkmath.NewInt(900000000),
+			sdkmath.Int{},
+			50,
+			big.NewInt(900000000),
+		},
+		{
+			"max base fee not set - base fee above the uint64 range not capped",
+			0,
+			sdkmath.Int{},
+			sdkmath.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))),
+			50,
+			new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100)),
+		},
+		{
+			"max base fee zero - base fee above the uint64 range not capped",
+			0,
+			sdkmath.ZeroInt(),
+			sdkmath.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))),
+			100,
+			new(big.Int).Mul(big.NewInt(1e17), big.NewInt(1125)),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.MinGasPrice = sdk.ZeroDec()
+			params.GasTarget = tc.gasTarget
+			params.MaxBaseFee = tc.maxBaseFee
+			if !tc.parentBaseFee.IsNil() {
+				params.BaseFee = tc.parentBaseFee
+			}
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+
+			// the gas target derived from MaxGas is 50 (ElasticityMultiplier = 2)
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee, tc.name)
+		})
+	}
+}
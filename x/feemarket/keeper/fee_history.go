@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Block Fee History
+// Ring buffer of the last FeeHistorySize blocks, used by eth_feeHistory.
+// ----------------------------------------------------------------------------
+
+// SetBlockFeeInfo stores the fee information of a block on the fee history
+// ring buffer, overwriting the entry of the block that is FeeHistorySize
+// blocks older. The slots left over by a larger FeeHistorySize are pruned
+// first, and nothing else is stored if the fee history is disabled.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockFeeInfo(ctx sdk.Context, size uint64, info types.BlockFeeInfo) {
+	k.pruneBlockFeeInfo(ctx, size)
+	if size == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.BlockFeeInfoKey(info.Height, size), bz)
+}
+
+// GetBlockFeeInfo returns the fee information of the block at the given height.
+// It returns false if the block is not on the fee history, either because it
+// has been overwritten by a newer block or because the history is disabled.
+func (k Keeper) GetBlockFeeInfo(ctx sdk.Context, height int64) (types.BlockFeeInfo, bool) {
+	size := k.GetParams(ctx).FeeHistorySize
+	if size == 0 || height < 0 {
+		return types.BlockFeeInfo{}, false
+	}
+
+	return k.getBlockFeeInfo(ctx, height, size)
+}
+
+// GetFeeHistory returns the fee information of up to blockCount blocks ending
+// at lastBlock, in ascending height order. Blocks that are not on the fee
+// history are skipped.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, lastBlock int64, blockCount uint64) []types.BlockFeeInfo {
+	size := k.GetParams(ctx).FeeHistorySize
+	if size == 0 || lastBlock < 0 {
+		return nil
+	}
+
+	// older blocks have been overwritten by newer ones
+	if blockCount > size {
+		blockCount = size
+	}
+
+	firstBlock := int64(0)
+	if uint64(lastBlock)+1 > blockCount {
+		firstBlock = lastBlock - int64(blockCount) + 1
+	}
+
+	blocks := make([]types.BlockFeeInfo, 0, lastBlock-firstBlock+1)
+	for height := firstBlock; height <= lastBlock; height++ {
+		info, found := k.getBlockFeeInfo(ctx, height, size)
+		if !found {
+			continue
+		}
+		blocks = append(blocks, info)
+	}
+
+	return blocks
+}
+
+// getBlockFeeInfo returns the fee information stored on the ring buffer slot
+// of the given height, checking that it hasn't been overwritten.
+func (k Keeper) getBlockFeeInfo(ctx sdk.Context, height int64, size uint64) (types.BlockFeeInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockFeeInfoKey(height, size))
+	if len(bz) == 0 {
+		return types.BlockFeeInfo{}, false
+	}
+
+	var info types.BlockFeeInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	if info.Height != height {
+		return types.BlockFeeInfo{}, false
+	}
+
+	return info, true
+}
+
+// pruneBlockFeeInfo deletes the ring buffer slots at or above the given size,
+// which are left over when the FeeHistorySize param is lowered.
+func (k Keeper) pruneBlockFeeInfo(ctx sdk.Context, size uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeHistory)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(size), nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
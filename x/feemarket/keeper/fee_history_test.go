@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeHistory() {
+	testCases := []struct {
+		name        string
+		historySize uint64
+		heights     []int64
+		lastBlock   int64
+		blockCount  uint64
+		expHeights  []int64
+	}{
+		{
+			"fee history disabled",
+			0,
+			[]int64{1, 2, 3},
+			3,
+			3,
+			[]int64{},
+		},
+		{
+			"all blocks on the history",
+			5,
+			[]int64{1, 2, 3},
+			3,
+			3,
+			[]int64{1, 2, 3},
+		},
+		{
+			"block count higher than the stored blocks",
+			5,
+			[]int64{1, 2, 3},
+			3,
+			10,
+			[]int64{1, 2, 3},
+		},
+		{
+			"older blocks overwritten by newer ones",
+			3,
+			[]int64{1, 2, 3, 4, 5},
+			5,
+			5,
+			[]int64{3, 4, 5},
+		},
+		{
+			"last block overwritten by a newer one",
+			3,
+			[]int64{1, 2, 3, 4, 5},
+			2,
+			2,
+			[]int64{},
+		},
+		{
+			"subset of the history",
+			5,
+			[]int64{1, 2, 3, 4, 5},
+			4,
+			2,
+			[]int64{3, 4},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeHistorySize = tc.historySize
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			for _, height := range tc.heights {
+				suite.app.FeeMarketKeeper.SetBlockFeeInfo(suite.ctx, tc.historySize, types.BlockFeeInfo{
+					Height:  height,
+					BaseFee: sdk.NewInt(height),
+					GasUsed: uint64(height),
+				})
+			}
+
+			blocks := suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, tc.lastBlock, tc.blockCount)
+			suite.Require().Len(blocks, len(tc.expHeights))
+			for i, height := range tc.expHeights {
+				suite.Require().Equal(height, blocks[i].Height)
+				suite.Require().Equal(sdk.NewInt(height), blocks[i].BaseFee)
+
+				info, found := suite.app.FeeMarketKeeper.GetBlockFeeInfo(suite.ctx, height)
+				suite.Require().True(found)
+				suite.Require().Equal(blocks[i], info)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeHistoryPruning() {
+	testCases := []struct {
+		name       string
+		newSize    uint64
+		expHeights []int64
+	}{
+		{
+			"history size lowered",
+			2,
+			[]int64{6, 1},
+		},
+		{
+			"history size unchanged",
+			5,
+			[]int64{6, 2, 3, 4, 5},
+		},
+		{
+			"fee history disabled",
+			0,
+			[]int64{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			for height := int64(1); height <= 5; height++ {
+				suite.app.FeeMarketKeeper.SetBlockFeeInfo(suite.ctx, 5, types.BlockFeeInfo{Height: height})
+			}
+
+			// the next block is written with the new history size
+			suite.app.FeeMarketKeeper.SetBlockFeeInfo(suite.ctx, tc.newSize, types.BlockFeeInfo{Height: 6})
+
+			store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixBlockFeeHistory)
+			iterator := store.Iterator(nil, nil)
+			defer iterator.Close()
+
+			heights := []int64{}
+			for ; iterator.Valid(); iterator.Next() {
+				var info types.BlockFeeInfo
+				suite.app.AppCodec().MustUnmarshal(iterator.Value(), &info)
+				heights = append(heights, info.Height)
+			}
+			suite.Require().ElementsMatch(tc.expHeights, heights)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v15/x/feemarket/types"
 )
@@ -52,3 +54,25 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.LastBlock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid last block %d", req.LastBlock)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lastBlock := req.LastBlock
+	if lastBlock == 0 || lastBlock > ctx.BlockHeight() {
+		lastBlock = ctx.BlockHeight()
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		Blocks: k.GetFeeHistory(ctx, lastBlock, req.BlockCount),
+	}, nil
+}
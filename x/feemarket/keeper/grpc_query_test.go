@@ -99,3 +99,53 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeeHistory() {
+	testCases := []struct {
+		name       string
+		req        *types.QueryFeeHistoryRequest
+		expHeights []int64
+		expPass    bool
+	}{
+		{
+			"fail - negative last block",
+			&types.QueryFeeHistoryRequest{LastBlock: -1, BlockCount: 1},
+			nil,
+			false,
+		},
+		{
+			"pass - last blocks",
+			&types.QueryFeeHistoryRequest{LastBlock: 3, BlockCount: 2},
+			[]int64{2, 3},
+			true,
+		},
+		{
+			"pass - current height by default",
+			&types.QueryFeeHistoryRequest{BlockCount: 5},
+			[]int64{1, 2, 3},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.SetupTest() // reset
+		suite.ctx = suite.ctx.WithBlockHeight(3)
+
+		for height := int64(1); height <= 3; height++ {
+			suite.app.FeeMarketKeeper.SetBlockFeeInfo(suite.ctx, types.DefaultFeeHistorySize, types.BlockFeeInfo{
+				Height:  height,
+				BaseFee: sdkmath.NewInt(height),
+			})
+		}
+
+		res, err := suite.app.FeeMarketKeeper.FeeHistory(sdk.WrapSDKContext(suite.ctx), tc.req)
+		if tc.expPass {
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Blocks, len(tc.expHeights), tc.name)
+			for i, height := range tc.expHeights {
+				suite.Require().Equal(height, res.Blocks[i].Height, tc.name)
+			}
+		} else {
+			suite.Require().Error(err)
+		}
+	}
+}
@@ -4,7 +4,11 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket    = "fee_market"
+	EventTypeFeeTokenSwap = "fee_token_swap"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee   = "base_fee"
+	AttributeKeyAccount   = "account"
+	AttributeKeyAmountIn  = "amount_in"
+	AttributeKeyAmountOut = "amount_out"
 )
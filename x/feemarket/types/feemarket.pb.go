@@ -43,6 +43,19 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// fee_tokens defines the whitelist of denominations, other than the EVM
+	// denomination, that can be used to pay for cosmos and eth transaction fees
+	FeeTokens []FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// max_base_fee defines the maximum value of the base fee. A zero value
+	// disables the ceiling.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_base_fee"`
+	// gas_target defines the amount of gas per block targeted by the base fee
+	// adjustment. A zero value derives the target from the consensus block max
+	// gas divided by the elasticity multiplier.
+	GasTarget uint64 `protobuf:"varint,11,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// fee_history_size defines the number of blocks kept in the fee history. A
+	// zero value disables the fee history.
+	FeeHistorySize uint64 `protobuf:"varint,12,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +119,158 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistorySize() uint64 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+// FeeToken defines a denomination that can be used to pay for transaction fees
+// and its conversion rate to the EVM denomination
+type FeeToken struct {
+	// denom is the denomination of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denomination that one unit of the fee token
+	// is worth
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BlockFeeInfo defines the base fee and gas values of a block kept in the fee
+// history
+type BlockFeeInfo struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the EIP-1559 base fee of the block
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
+	// gas_wanted is the total gas limit of the block transactions, before the
+	// min gas multiplier floor used to calculate the base fee of the next block
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the block gas limit, zero if it is unlimited
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BlockFeeInfo) Reset()         { *m = BlockFeeInfo{} }
+func (m *BlockFeeInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeInfo) ProtoMessage()    {}
+func (*BlockFeeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *BlockFeeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeInfo.Merge(m, src)
+}
+func (m *BlockFeeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeInfo proto.InternalMessageInfo
+
+func (m *BlockFeeInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeInfo) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BlockFeeInfo) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeInfo) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "ethermint.feemarket.v1.FeeToken")
+	proto.RegisterType((*BlockFeeInfo)(nil), "ethermint.feemarket.v1.BlockFeeInfo")
 }
 
 func init() {
@@ -115,32 +278,45 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x8a, 0xdb, 0x30,
-	0x10, 0xc6, 0xad, 0x66, 0x37, 0xeb, 0xd5, 0x36, 0x10, 0xc4, 0xb6, 0x98, 0x16, 0xbc, 0xa6, 0x85,
-	0xc5, 0x87, 0xd6, 0x26, 0x2c, 0x3d, 0xf6, 0x92, 0x2e, 0xdb, 0x6e, 0xa1, 0x10, 0x7c, 0x2c, 0x05,
-	0x21, 0x3b, 0x13, 0x5b, 0xc4, 0x92, 0x8c, 0xa5, 0x98, 0xe6, 0x2d, 0xfa, 0x58, 0x39, 0xe6, 0x54,
-	0x4a, 0x0f, 0xa1, 0x24, 0x2f, 0x52, 0x62, 0x27, 0x76, 0xae, 0xed, 0x45, 0x7f, 0xe6, 0xfb, 0xf4,
-	0x9b, 0x91, 0x34, 0xf8, 0x16, 0x4c, 0x06, 0xa5, 0xe0, 0xd2, 0x84, 0x33, 0x00, 0xc1, 0xca, 0x39,
-	0x98, 0xb0, 0x1a, 0x75, 0x9b, 0xa0, 0x28, 0x95, 0x51, 0xe4, 0x79, 0xeb, 0x0b, 0x3a, 0xa9, 0x1a,
-	0xbd, 0xb8, 0x4e, 0x55, 0xaa, 0x6a, 0x4b, 0xb8, 0x5f, 0x35, 0xee, 0x57, 0x3f, 0x7b, 0xb8, 0x3f,
-	0x61, 0x25, 0x13, 0x9a, 0xb8, 0xf8, 0x4a, 0x2a, 0x1a, 0x33, 0x0d, 0x74, 0x06, 0xe0, 0x20, 0x0f,
-	0xf9, 0x76, 0x74, 0x29, 0xd5, 0x98, 0x69, 0x78, 0x00, 0x20, 0xef, 0xf1, 0xcb, 0xa3, 0x48, 0x93,
-	0x8c, 0xc9, 0x14, 0xe8, 0x14, 0xa4, 0x12, 0x5c, 0x32, 0xa3, 0x4a, 0xe7, 0x89, 0x87, 0xfc, 0x41,
-	0xe4, 0xc4, 0x8d, 0xfb, 0x43, 0x6d, 0xb8, 0xef, 0x74, 0x72, 0x87, 0x9f, 0x41, 0xce, 0xb4, 0xe1,
-	0x09, 0x37, 0x4b, 0x2a, 0x16, 0xb9, 0xe1, 0x45, 0xce, 0xa1, 0x74, 0x7a, 0xf5, 0xc1, 0xeb, 0x4e,
-	0xfc, 0xd2, 0x6a, 0xe4, 0x35, 0x1e, 0x80, 0x64, 0x71, 0x0e, 0x34, 0x03, 0x9e, 0x66, 0xc6, 0x39,
-	0xf7, 0x90, 0xdf, 0x8b, 0x9e, 0x36, 0xc1, 0x4f, 0x75, 0x8c, 0x3c, 0x62, 0xbb, 0xad, 0xba, 0xef,
-	0x21, 0xff, 0x72, 0x1c, 0xac, 0x36, 0x37, 0xd6, 0xef, 0xcd, 0xcd, 0x6d, 0xca, 0x4d, 0xb6, 0x88,
-	0x83, 0x44, 0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x98, 0xde, 0xea, 0xe9, 0x3c, 0x34, 0xcb, 0x02,
-	0x74, 0xf0, 0x28, 0x4d, 0x74, 0x71, 0xa8, 0x9a, 0x44, 0x78, 0x20, 0xb8, 0xa4, 0x29, 0xd3, 0xb4,
-	0x28, 0x79, 0x02, 0xce, 0xc5, 0x3f, 0xf3, 0xee, 0x21, 0x89, 0xae, 0x04, 0x97, 0x1f, 0x99, 0x9e,
-	0xec, 0x11, 0xe4, 0x1b, 0x26, 0x47, 0xe6, 0xc9, 0xad, 0xed, 0xff, 0x02, 0x0f, 0x1b, 0x70, 0xf7,
-	0x42, 0x9f, 0xcf, 0xec, 0xb3, 0xe1, 0x79, 0x34, 0xe4, 0x92, 0x1b, 0xce, 0xf2, 0xf6, 0xfb, 0xc6,
-	0x0f, 0xab, 0xad, 0x8b, 0xd6, 0x5b, 0x17, 0xfd, 0xd9, 0xba, 0xe8, 0xc7, 0xce, 0xb5, 0xd6, 0x3b,
-	0xd7, 0xfa, 0xb5, 0x73, 0xad, 0xaf, 0x6f, 0x4e, 0x72, 0x41, 0xb5, 0x4f, 0xd5, 0x8c, 0xd5, 0xe8,
-	0x5d, 0xf8, 0xfd, 0xa4, 0xb7, 0xea, 0xac, 0x71, 0xbf, 0xee, 0x93, 0xbb, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5a, 0x22, 0x3a, 0xef, 0x7f, 0x02, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xd6, 0xb4, 0x6b, 0xdd, 0x0e, 0x55, 0xd6, 0x98, 0x02, 0x13, 0x59, 0x34, 0xa4, 0x29,
+	0x17, 0x90, 0x6a, 0x4c, 0x5c, 0x72, 0x53, 0x46, 0xd9, 0x10, 0x48, 0x53, 0x18, 0x42, 0x42, 0x48,
+	0x91, 0x9b, 0x9c, 0x26, 0x56, 0x63, 0xbb, 0x8a, 0xbd, 0xb2, 0xed, 0x29, 0x78, 0x1c, 0x1e, 0x61,
+	0x97, 0xbb, 0x44, 0x5c, 0x4c, 0x68, 0x7d, 0x11, 0x64, 0xa7, 0x7f, 0x12, 0xdc, 0x6c, 0xdc, 0x24,
+	0x39, 0xe7, 0xfb, 0x7c, 0xf2, 0x9d, 0xe3, 0xcf, 0x46, 0x7b, 0xa0, 0x32, 0x28, 0x18, 0xe5, 0xaa,
+	0x3b, 0x04, 0x60, 0xa4, 0x18, 0x81, 0xea, 0x4e, 0xf6, 0x97, 0x41, 0x30, 0x2e, 0x84, 0x12, 0x78,
+	0x6b, 0xc1, 0x0b, 0x96, 0xd0, 0x64, 0xff, 0xf1, 0x66, 0x2a, 0x52, 0x61, 0x28, 0x5d, 0xfd, 0x55,
+	0xb2, 0x77, 0x7f, 0xd4, 0x50, 0xfd, 0x84, 0x14, 0x84, 0x49, 0xec, 0xa2, 0x16, 0x17, 0xd1, 0x80,
+	0x48, 0x88, 0x86, 0x00, 0x8e, 0xe5, 0x59, 0x7e, 0x23, 0x6c, 0x72, 0xd1, 0x23, 0x12, 0xfa, 0x00,
+	0xf8, 0x15, 0xda, 0x9e, 0x83, 0x51, 0x9c, 0x11, 0x9e, 0x42, 0x94, 0x00, 0x17, 0x8c, 0x72, 0xa2,
+	0x44, 0xe1, 0xac, 0x79, 0x96, 0xbf, 0x11, 0x3a, 0x83, 0x92, 0xfd, 0xda, 0x10, 0x0e, 0x97, 0x38,
+	0x3e, 0x40, 0x0f, 0x21, 0x27, 0x52, 0xd1, 0x98, 0xaa, 0x8b, 0x88, 0x9d, 0xe5, 0x8a, 0x8e, 0x73,
+	0x0a, 0x85, 0x53, 0x35, 0x0b, 0x37, 0x97, 0xe0, 0x87, 0x05, 0x86, 0x9f, 0xa2, 0x0d, 0xe0, 0x64,
+	0x90, 0x43, 0x94, 0x01, 0x4d, 0x33, 0xe5, 0xd4, 0x3c, 0xcb, 0xaf, 0x86, 0xed, 0x32, 0x79, 0x64,
+	0x72, 0xf8, 0x18, 0x35, 0x16, 0xaa, 0xeb, 0x9e, 0xe5, 0x37, 0x7b, 0xc1, 0xd5, 0xcd, 0x4e, 0xe5,
+	0xd7, 0xcd, 0xce, 0x5e, 0x4a, 0x55, 0x76, 0x36, 0x08, 0x62, 0xc1, 0xba, 0xb1, 0x90, 0x4c, 0xc8,
+	0xd9, 0xeb, 0xb9, 0x4c, 0x46, 0x5d, 0x75, 0x31, 0x06, 0x19, 0x1c, 0x73, 0x15, 0xae, 0xcf, 0x54,
+	0xe3, 0x10, 0x6d, 0x30, 0xca, 0xa3, 0x94, 0xc8, 0x68, 0x5c, 0xd0, 0x18, 0x9c, 0xf5, 0x3b, 0xd7,
+	0x3b, 0x84, 0x38, 0x6c, 0x31, 0xca, 0xdf, 0x12, 0x79, 0xa2, 0x4b, 0xe0, 0xaf, 0x08, 0xcf, 0x6b,
+	0xae, 0x74, 0xdd, 0xb8, 0x57, 0xe1, 0x4e, 0x59, 0x78, 0x65, 0x42, 0x6f, 0x10, 0xd2, 0x1b, 0xa2,
+	0xc4, 0x08, 0xb8, 0x74, 0x9a, 0x5e, 0xd5, 0x6f, 0xbd, 0xf0, 0x82, 0x7f, 0x7b, 0x20, 0xe8, 0x03,
+	0x9c, 0x6a, 0x62, 0xcf, 0xd6, 0xff, 0x0d, 0x9b, 0xc3, 0x59, 0x2c, 0xf1, 0x09, 0x6a, 0x33, 0x72,
+	0xbe, 0xdc, 0x7d, 0x74, 0xaf, 0x39, 0x22, 0x46, 0xce, 0xe7, 0x76, 0x79, 0x82, 0x90, 0x6e, 0x59,
+	0x91, 0x22, 0x05, 0xe5, 0xb4, 0x3c, 0xcb, 0xb7, 0xc3, 0x66, 0x4a, 0xe4, 0xa9, 0x49, 0x60, 0x1f,
+	0x75, 0xb4, 0xee, 0x8c, 0x4a, 0x25, 0x8a, 0x8b, 0x48, 0xd2, 0x4b, 0x70, 0xda, 0x86, 0xf4, 0x60,
+	0x08, 0x70, 0x54, 0xa6, 0x3f, 0xd2, 0x4b, 0x78, 0x67, 0x37, 0xec, 0x4e, 0x2d, 0xec, 0x50, 0x4e,
+	0x15, 0x25, 0xf9, 0x42, 0xe2, 0x6e, 0x82, 0x1a, 0xf3, 0x7e, 0xf0, 0x26, 0xaa, 0x19, 0x2f, 0x1a,
+	0xd7, 0x36, 0xc3, 0x32, 0xc0, 0x3d, 0x64, 0x17, 0x44, 0x81, 0xb3, 0x76, 0xe7, 0x66, 0xf4, 0xac,
+	0xcd, 0xda, 0xdd, 0x2b, 0x0b, 0xb5, 0x7b, 0xb9, 0x88, 0x47, 0x7d, 0x80, 0x63, 0x3e, 0x14, 0x78,
+	0x0b, 0xd5, 0x67, 0x5e, 0xb4, 0x8c, 0x17, 0xeb, 0xd9, 0xdf, 0x2e, 0x5c, 0xfb, 0x3f, 0x17, 0xce,
+	0x46, 0xf7, 0x8d, 0x70, 0x05, 0x89, 0x53, 0x5d, 0x8c, 0xee, 0xb3, 0x49, 0xe0, 0x47, 0xa8, 0xa1,
+	0xe1, 0x33, 0x09, 0x89, 0x63, 0x1b, 0x70, 0x3d, 0x25, 0xf2, 0x93, 0x84, 0x04, 0x6f, 0x23, 0xcd,
+	0x8b, 0x72, 0xca, 0x68, 0x79, 0x56, 0xec, 0x50, 0x73, 0xdf, 0xeb, 0xb8, 0xd7, 0xbf, 0xba, 0x75,
+	0xad, 0xeb, 0x5b, 0xd7, 0xfa, 0x7d, 0xeb, 0x5a, 0xdf, 0xa7, 0x6e, 0xe5, 0x7a, 0xea, 0x56, 0x7e,
+	0x4e, 0xdd, 0xca, 0x97, 0x67, 0x2b, 0x0a, 0x61, 0xa2, 0x05, 0x96, 0xcf, 0xc9, 0xfe, 0xcb, 0xee,
+	0xf9, 0xca, 0x75, 0x63, 0xb4, 0x0e, 0xea, 0xe6, 0xea, 0x38, 0xf8, 0x33, 0x00, 0x74, 0x26, 0x10,
+	0xcb, 0x92, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +339,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -221,6 +431,99 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFeeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -256,6 +559,58 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistorySize))
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockFeeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -473,6 +828,388 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
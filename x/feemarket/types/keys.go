@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -22,18 +24,28 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockFeeHistory
 )
 
 const (
-	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBlockGasWanted = iota + 1
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted  = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockFeeHistory = []byte{prefixBlockFeeHistory}
 )
 
+// BlockFeeInfoKey returns the key of the fee history ring buffer slot used by
+// the given block height, where size is the number of stored blocks.
+// CONTRACT: size must be greater than 0.
+func BlockFeeInfoKey(height int64, size uint64) []byte {
+	slot := uint64(height) % size
+	return append(KeyPrefixBlockFeeHistory, sdk.Uint64ToBigEndian(slot)...)
+}
+
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasWanted}
 )
@@ -20,6 +20,12 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMaxBaseFee is 0 (i.e no ceiling)
+	DefaultMaxBaseFee = sdkmath.ZeroInt()
+	// DefaultGasTarget is 0 (i.e derived from the consensus block max gas)
+	DefaultGasTarget = uint64(0)
+	// DefaultFeeHistorySize is 100 blocks, the default cap of the eth_feeHistory block count
+	DefaultFeeHistorySize = uint64(100)
 )
 
 // Parameter keys
@@ -32,6 +38,10 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyFeeTokens                = []byte("FeeTokens")
+	ParamStoreKeyMaxBaseFee               = []byte("MaxBaseFee")
+	ParamStoreKeyGasTarget                = []byte("GasTarget")
+	ParamStoreKeyFeeHistorySize           = []byte("FeeHistorySize")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -49,6 +59,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBaseFee, &p.MaxBaseFee, validateMaxBaseFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyGasTarget, &p.GasTarget, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeHistorySize, &p.FeeHistorySize, validateUint64),
 	}
 }
 
@@ -61,6 +75,10 @@ func NewParams(
 	enableHeight int64,
 	minGasPrice sdk.Dec,
 	minGasPriceMultiplier sdk.Dec,
+	feeTokens []FeeToken,
+	maxBaseFee sdkmath.Int,
+	gasTarget uint64,
+	feeHistorySize uint64,
 ) Params {
 	return Params{
 		NoBaseFee:                noBaseFee,
@@ -70,6 +88,10 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		FeeTokens:                feeTokens,
+		MaxBaseFee:               maxBaseFee,
+		GasTarget:                gasTarget,
+		FeeHistorySize:           feeHistorySize,
 	}
 }
 
@@ -83,6 +105,9 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		GasTarget:                DefaultGasTarget,
+		FeeHistorySize:           DefaultFeeHistorySize,
 	}
 }
 
@@ -104,7 +129,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if err := validateMaxBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if p.HasMaxBaseFee() && p.MaxBaseFee.LT(p.MinGasPrice.TruncateInt()) {
+		return fmt.Errorf("max base fee %s cannot be lower than the min gas price %s", p.MaxBaseFee, p.MinGasPrice)
+	}
+
+	return nil
 }
 
 func validateBool(i interface{}) error {
@@ -119,6 +160,64 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// HasMaxBaseFee returns true if the base fee has a ceiling.
+func (p Params) HasMaxBaseFee() bool {
+	return !p.MaxBaseFee.IsNil() && p.MaxBaseFee.IsPositive()
+}
+
+// GetFeeToken returns the whitelisted fee token with the given denomination.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// ValidateFeeTokens checks that none of the fee tokens is in the given EVM
+// denomination, as the fees in the EVM denomination are never swapped. The EVM
+// denomination is a parameter of the EVM module, so it can't be checked by the
+// stateless validation of the params.
+func (p Params) ValidateFeeTokens(evmDenom string) error {
+	if _, found := p.GetFeeToken(evmDenom); found {
+		return fmt.Errorf("fee token denom cannot be the EVM denom %s", evmDenom)
+	}
+	return nil
+}
+
+// FeeTokensOf returns the whitelisted fee tokens out of the denominations of
+// the given coins, in the order of the coins. A fee token in the given EVM
+// denomination is ignored.
+func (p Params) FeeTokensOf(coins sdk.Coins, evmDenom string) []FeeToken {
+	feeTokens := make([]FeeToken, 0, len(coins))
+	for _, coin := range coins {
+		if coin.Denom == evmDenom {
+			continue
+		}
+		if feeToken, found := p.GetFeeToken(coin.Denom); found {
+			feeTokens = append(feeTokens, feeToken)
+		}
+	}
+	return feeTokens
+}
+
+// FeesInEvmDenom returns the value of the given fees in the EVM denomination,
+// i.e. the amount of the EVM denomination plus the converted amount of every
+// whitelisted fee token. Coins of any other denomination are ignored.
+func (p Params) FeesInEvmDenom(fees sdk.Coins, evmDenom string) sdkmath.Int {
+	total := fees.AmountOfNoDenomValidation(evmDenom)
+	for _, fee := range fees {
+		if fee.Denom == evmDenom {
+			continue
+		}
+		if feeToken, found := p.GetFeeToken(fee.Denom); found {
+			total = total.Add(feeToken.ToEvmDenom(fee.Amount))
+		}
+	}
+	return total
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -171,6 +270,27 @@ func validateBaseFee(i interface{}) error {
 	return nil
 }
 
+func validateMaxBaseFee(i interface{}) error {
+	value, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil value disables the ceiling, as for parameters set before the max base fee was introduced
+	if !value.IsNil() && value.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative")
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateEnableHeight(i interface{}) error {
 	value, ok := i.(int64)
 	if !ok {
@@ -204,3 +324,57 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFeeTokens(i interface{}) error {
+	feeTokens, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(feeTokens))
+	for _, feeToken := range feeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+
+		if seen[feeToken.Denom] {
+			return fmt.Errorf("duplicate fee token denom %s", feeToken.Denom)
+		}
+		seen[feeToken.Denom] = true
+	}
+
+	return nil
+}
+
+// NewFeeToken creates a new FeeToken instance
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate performs a stateless validation of the fee token.
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+
+	if ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+		return fmt.Errorf("fee token %s rate must be positive: %s", ft.Denom, ft.Rate)
+	}
+
+	return nil
+}
+
+// ToEvmDenom converts an amount of the fee token into the EVM denomination,
+// rounding down.
+func (ft FeeToken) ToEvmDenom(amount sdkmath.Int) sdkmath.Int {
+	return ft.Rate.MulInt(amount).TruncateInt()
+}
+
+// FromEvmDenom returns the amount of the fee token that is needed to cover the
+// given amount of the EVM denomination, rounding up.
+func (ft FeeToken) FromEvmDenom(amount sdkmath.Int) sdkmath.Int {
+	return sdk.NewDecFromInt(amount).Quo(ft.Rate).Ceil().TruncateInt()
+}
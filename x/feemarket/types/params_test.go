@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v15/utils"
 )
 
 type ParamsTestSuite struct {
@@ -26,7 +27,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
 			false,
 		},
 		{
@@ -36,27 +37,84 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		},
 		{
 			"base fee change denominator is 0 ",
-			NewParams(true, 0, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier),
+			NewParams(true, 0, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), DefaultMinGasMultiplier, nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
 			true,
 		},
 		{
 			"invalid: min gas price negative",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecFromInt(sdkmath.NewInt(-1)), DefaultMinGasMultiplier),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecFromInt(sdkmath.NewInt(-1)), DefaultMinGasMultiplier, nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
 			true,
 		},
 		{
 			"valid: min gas multiplier zero",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.ZeroDec()),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.ZeroDec(), nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
 			false,
 		},
 		{
 			"invalid: min gas multiplier is negative",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.NewDecWithPrec(-5, 1)),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.NewDecWithPrec(-5, 1), nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
 			true,
 		},
 		{
 			"invalid: min gas multiplier bigger than 1",
-			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2), nil, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			true,
+		},
+		{
+			"valid - fee tokens",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, []FeeToken{
+				NewFeeToken("uusdc", sdk.NewDec(20000000000000)),
+				NewFeeToken("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewDecWithPrec(5, 1)),
+			}, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			false,
+		},
+		{
+			"invalid - fee token with invalid denom",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, []FeeToken{
+				NewFeeToken("1usdc", sdk.NewDec(1)),
+			}, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			true,
+		},
+		{
+			"invalid - fee token with zero rate",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, []FeeToken{
+				NewFeeToken("uusdc", sdk.ZeroDec()),
+			}, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			true,
+		},
+		{
+			"invalid - duplicate fee token",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, []FeeToken{
+				NewFeeToken("uusdc", sdk.NewDec(1)),
+				NewFeeToken("uusdc", sdk.NewDec(2)),
+			}, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			true,
+		},
+		{
+			"valid - fee token in the base denom, checked against the EVM params instead",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, []FeeToken{
+				NewFeeToken(utils.BaseDenom, sdk.NewDec(1)),
+			}, DefaultMaxBaseFee, DefaultGasTarget, DefaultFeeHistorySize),
+			false,
+		},
+		{
+			"valid - max base fee, gas target and fee history size",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDec(10), DefaultMinGasMultiplier, nil, sdkmath.NewInt(1000000000000), 5000000, 10),
+			false,
+		},
+		{
+			"valid - nil max base fee",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, nil, sdkmath.Int{}, DefaultGasTarget, DefaultFeeHistorySize),
+			false,
+		},
+		{
+			"invalid - negative max base fee",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, DefaultMinGasMultiplier, nil, sdkmath.NewInt(-1), DefaultGasTarget, DefaultFeeHistorySize),
+			true,
+		},
+		{
+			"invalid - max base fee lower than min gas price",
+			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDec(10), DefaultMinGasMultiplier, nil, sdkmath.NewInt(9), DefaultGasTarget, DefaultFeeHistorySize),
 			true,
 		},
 	}
@@ -91,6 +149,44 @@ func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateMinGasMultiplier(sdk.NewDec(-5)))
 	suite.Require().Error(validateMinGasMultiplier(sdk.Dec{}))
 	suite.Require().Error(validateMinGasMultiplier(""))
+	suite.Require().Error(validateFeeTokens(""))
+	suite.Require().NoError(validateFeeTokens([]FeeToken{}))
+}
+
+func (suite *ParamsTestSuite) TestFeesInEvmDenom() {
+	params := DefaultParams()
+	params.FeeTokens = []FeeToken{
+		NewFeeToken("uusdc", sdk.NewDec(20000000000000)),
+		NewFeeToken("uatom", sdk.NewDecWithPrec(5, 1)),
+		// a fee token in the EVM denomination is ignored
+		NewFeeToken("aevmos", sdk.NewDec(2)),
+	}
+
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("aevmos", 100),
+		sdk.NewInt64Coin("uusdc", 2),
+		sdk.NewInt64Coin("uatom", 3),
+		sdk.NewInt64Coin("ufoo", 1000),
+	)
+
+	// 100 + 2 * 2e13 + trunc(3 * 0.5); ufoo is not whitelisted
+	suite.Require().Equal(sdkmath.NewInt(40000000000101), params.FeesInEvmDenom(fees, "aevmos"))
+	suite.Require().Equal([]FeeToken{params.FeeTokens[1], params.FeeTokens[0]}, params.FeeTokensOf(fees, "aevmos"))
+
+	feeToken, found := params.GetFeeToken("uusdc")
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(2), feeToken.FromEvmDenom(sdkmath.NewInt(20000000000001)), "expected the swapped amount to be rounded up")
+	suite.Require().Equal(sdkmath.NewInt(1), feeToken.FromEvmDenom(sdkmath.NewInt(20000000000000)))
+
+	_, found = params.GetFeeToken("ufoo")
+	suite.Require().False(found)
+}
+
+func (suite *ParamsTestSuite) TestValidateFeeTokensEvmDenom() {
+	params := DefaultParams()
+	params.FeeTokens = []FeeToken{NewFeeToken("uusdc", sdk.NewDec(1))}
+	suite.Require().NoError(params.ValidateFeeTokens("aevmos"))
+	suite.Require().Error(params.ValidateFeeTokens("uusdc"))
 }
 
 func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
@@ -274,6 +274,109 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeHistoryRequest defines the request type for querying the fee history.
+type QueryFeeHistoryRequest struct {
+	// last_block is the height of the most recent block to return. It defaults to
+	// the current height if zero.
+	LastBlock int64 `protobuf:"varint,1,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+	// block_count is the number of blocks to return, up to the fee history size
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the base fee and gas values of the requested
+// blocks, ordered by height, that are kept in the fee history.
+type QueryFeeHistoryResponse struct {
+	// blocks are the base fee and gas values of the blocks
+	Blocks []BlockFeeInfo `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetBlocks() []BlockFeeInfo {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +384,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -288,36 +393,42 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0x29, 0x74, 0xc3, 0x5c, 0x90, 0xe9, 0x26, 0x14, 0x15, 0x17, 0x45, 0x30, 0xc1, 0xd8,
-	0x62, 0x75, 0x88, 0x1b, 0xa7, 0x48, 0x0c, 0x71, 0x83, 0x70, 0x43, 0x42, 0x93, 0x53, 0xde, 0xb2,
-	0xa8, 0x4b, 0x9c, 0xc5, 0x6e, 0xc4, 0xae, 0x48, 0x5c, 0x38, 0x20, 0x24, 0x7e, 0x07, 0xff, 0x63,
-	0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x0a, 0xb5, 0xfc, 0x10, 0x14, 0xdb, 0x29, 0x0d, 0xb4, 0xd0, 0x4b,
-	0x62, 0xbd, 0x7c, 0xef, 0xfb, 0xbe, 0xf7, 0x3d, 0x07, 0x7b, 0xa0, 0x4e, 0xa0, 0x48, 0x93, 0x4c,
-	0xb1, 0x63, 0x80, 0x94, 0x17, 0x43, 0x50, 0xac, 0xec, 0xb3, 0xb3, 0x11, 0x14, 0xe7, 0x7e, 0x5e,
-	0x08, 0x25, 0xc8, 0xf6, 0x1c, 0xe3, 0xcf, 0x31, 0x7e, 0xd9, 0x77, 0x77, 0x56, 0xf4, 0xfe, 0x06,
-	0xe9, 0x7e, 0xb7, 0x13, 0x8b, 0x58, 0xe8, 0x23, 0xab, 0x4e, 0xb6, 0xda, 0x8d, 0x85, 0x88, 0x4f,
-	0x81, 0xf1, 0x3c, 0x61, 0x3c, 0xcb, 0x84, 0xe2, 0x2a, 0x11, 0x99, 0x34, 0x5f, 0xbd, 0x0e, 0x26,
-	0x2f, 0x2a, 0x0b, 0xcf, 0x79, 0xc1, 0x53, 0x19, 0xc2, 0xd9, 0x08, 0xa4, 0xf2, 0x5e, 0xe2, 0x1b,
-	0x8d, 0xaa, 0xcc, 0x45, 0x26, 0x81, 0x3c, 0xc6, 0xed, 0x5c, 0x57, 0x6e, 0xa2, 0xdb, 0xe8, 0xde,
-	0xb5, 0x03, 0xea, 0x2f, 0x77, 0xec, 0x9b, 0xbe, 0xe0, 0xf2, 0xc5, 0xa4, 0xe7, 0x84, 0xb6, 0xc7,
-	0xdb, 0xb2, 0xa4, 0x01, 0x97, 0x70, 0x08, 0x50, 0x6b, 0xbd, 0xc6, 0x9d, 0x66, 0xd9, 0x8a, 0x3d,
-	0xc1, 0x9b, 0x11, 0x97, 0x70, 0x74, 0x0c, 0xa0, 0xe5, 0xae, 0x06, 0xbb, 0xdf, 0x27, 0xbd, 0x9d,
-	0x38, 0x51, 0x27, 0xa3, 0xc8, 0x1f, 0x88, 0x94, 0x0d, 0x84, 0x4c, 0x85, 0xb4, 0xaf, 0x7d, 0xf9,
-	0x66, 0xc8, 0xd4, 0x79, 0x0e, 0xd2, 0x7f, 0x96, 0xa9, 0x70, 0x23, 0x32, 0x74, 0xde, 0x76, 0x4d,
-	0x7f, 0x2a, 0x06, 0xc3, 0xa7, 0x7c, 0x3e, 0xe2, 0x7d, 0xbc, 0xf5, 0x47, 0xdd, 0xea, 0x5e, 0xc7,
-	0xad, 0x98, 0x9b, 0x09, 0x5b, 0x61, 0x75, 0x3c, 0xf8, 0xd2, 0xc2, 0x57, 0x34, 0x96, 0xbc, 0x47,
-	0xb8, 0x6d, 0x66, 0x23, 0xbb, 0xab, 0x66, 0xff, 0x3b, 0x4e, 0xf7, 0xc1, 0x5a, 0x58, 0xa3, 0xef,
-	0x79, 0xef, 0xbe, 0xfe, 0xfc, 0x7c, 0xa9, 0x4b, 0x5c, 0x06, 0x65, 0x35, 0x5e, 0x63, 0xe5, 0x26,
-	0x4a, 0xf2, 0x01, 0xe1, 0x0d, 0x9b, 0x17, 0xf9, 0x37, 0x79, 0x33, 0x6c, 0x77, 0x6f, 0x3d, 0xb0,
-	0xb5, 0x72, 0x47, 0x5b, 0xa1, 0xa4, 0xbb, 0xcc, 0x4a, 0xbd, 0x1c, 0xf2, 0x11, 0xe1, 0xcd, 0x3a,
-	0x45, 0xf2, 0x1f, 0x81, 0xe6, 0x12, 0xdc, 0xfd, 0x35, 0xd1, 0xd6, 0xcf, 0x5d, 0xed, 0xa7, 0x47,
-	0x6e, 0x2d, 0xf5, 0x53, 0xa1, 0x8f, 0x62, 0x2e, 0x83, 0xc3, 0x8b, 0x29, 0x45, 0xe3, 0x29, 0x45,
-	0x3f, 0xa6, 0x14, 0x7d, 0x9a, 0x51, 0x67, 0x3c, 0xa3, 0xce, 0xb7, 0x19, 0x75, 0x5e, 0xed, 0x2d,
-	0xdc, 0x1e, 0x43, 0x61, 0x9e, 0x65, 0xff, 0x11, 0x7b, 0xbb, 0x40, 0xa7, 0xef, 0x51, 0xd4, 0xd6,
-	0xbf, 0xc8, 0xc3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xd3, 0x2a, 0x9e, 0xbc, 0x03, 0x00,
-	0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x0b, 0xd2, 0xf6, 0x71, 0x31, 0x23, 0xc5, 0x66, 0x43, 0x97, 0xba, 0xa9, 0x15, 0x6b,
+	0xbb, 0x13, 0x30, 0xde, 0x3c, 0x61, 0x44, 0x7b, 0x53, 0xbc, 0x18, 0x93, 0x86, 0x0c, 0xf8, 0x58,
+	0x08, 0xb0, 0x43, 0x77, 0x06, 0x22, 0x57, 0x13, 0x2f, 0x1e, 0x8c, 0x89, 0x89, 0x3f, 0xc9, 0xf4,
+	0xd8, 0xc4, 0x8b, 0xf1, 0xd0, 0x18, 0xf0, 0x87, 0x98, 0x9d, 0x19, 0x68, 0x11, 0x68, 0xb9, 0xc0,
+	0xe4, 0x9b, 0xef, 0x7d, 0xef, 0x9b, 0x79, 0xdf, 0x2c, 0xb8, 0x28, 0x9b, 0x18, 0x76, 0x5b, 0x81,
+	0xa4, 0x0d, 0xc4, 0x2e, 0x0b, 0xdb, 0x28, 0xe9, 0xa0, 0x40, 0x4f, 0xfb, 0x18, 0x0e, 0xbd, 0x5e,
+	0xc8, 0x25, 0x27, 0x99, 0x29, 0xc7, 0x9b, 0x72, 0xbc, 0x41, 0xc1, 0xde, 0x5f, 0x52, 0x7b, 0x49,
+	0x52, 0xf5, 0x76, 0xda, 0xe7, 0x3e, 0x57, 0x4b, 0x1a, 0xad, 0x0c, 0x9a, 0xf5, 0x39, 0xf7, 0x3b,
+	0x48, 0x59, 0xaf, 0x45, 0x59, 0x10, 0x70, 0xc9, 0x64, 0x8b, 0x07, 0x42, 0xef, 0xba, 0x69, 0x20,
+	0xaf, 0x23, 0x0b, 0xaf, 0x58, 0xc8, 0xba, 0xa2, 0x82, 0xa7, 0x7d, 0x14, 0xd2, 0x7d, 0x03, 0x77,
+	0x66, 0x50, 0xd1, 0xe3, 0x81, 0x40, 0xf2, 0x14, 0x92, 0x3d, 0x85, 0x6c, 0x5b, 0xbb, 0x56, 0x3e,
+	0x55, 0x74, 0xbc, 0xc5, 0x8e, 0x3d, 0x5d, 0x57, 0x4a, 0x9c, 0x5d, 0xe4, 0x62, 0x15, 0x53, 0xe3,
+	0x6e, 0x19, 0xd1, 0x12, 0x13, 0x58, 0x46, 0x9c, 0xf4, 0x3a, 0x81, 0xf4, 0x2c, 0x6c, 0x9a, 0x3d,
+	0x87, 0x8d, 0x1a, 0x13, 0x58, 0x6d, 0x20, 0xaa, 0x76, 0x9b, 0xa5, 0x83, 0xdf, 0x17, 0xb9, 0x7d,
+	0xbf, 0x25, 0x9b, 0xfd, 0x9a, 0x57, 0xe7, 0x5d, 0x5a, 0xe7, 0xa2, 0xcb, 0x85, 0xf9, 0x3b, 0x12,
+	0xef, 0xdb, 0x54, 0x0e, 0x7b, 0x28, 0xbc, 0xe3, 0x40, 0x56, 0xd6, 0x6b, 0x5a, 0xce, 0xcd, 0x4c,
+	0xe4, 0x3b, 0xbc, 0xde, 0x7e, 0xc1, 0xa6, 0x47, 0x7c, 0x08, 0x5b, 0xff, 0xe1, 0xa6, 0xef, 0x6d,
+	0x88, 0xfb, 0x4c, 0x9f, 0x30, 0x5e, 0x89, 0x96, 0xee, 0x5b, 0xc8, 0x28, 0x6a, 0x19, 0xf1, 0x65,
+	0x4b, 0x48, 0x1e, 0x0e, 0x8d, 0x08, 0xd9, 0x01, 0xe8, 0x30, 0x21, 0xab, 0xb5, 0x48, 0xc4, 0x94,
+	0x6c, 0x46, 0x88, 0x52, 0x25, 0x39, 0x48, 0xa9, 0x9d, 0x6a, 0x9d, 0xf7, 0x03, 0xb9, 0xbd, 0xb6,
+	0x6b, 0xe5, 0x13, 0x15, 0x50, 0xd0, 0xb3, 0x08, 0x71, 0x4f, 0xe0, 0xee, 0x9c, 0xb2, 0xb1, 0x51,
+	0x82, 0xa4, 0x22, 0x46, 0x4e, 0xe2, 0xf9, 0x54, 0x71, 0x6f, 0xd9, 0x5d, 0xab, 0x56, 0x65, 0xc4,
+	0xe3, 0xa0, 0xc1, 0x27, 0x37, 0xae, 0x2b, 0x8b, 0x3f, 0x12, 0x70, 0x4b, 0xe9, 0x93, 0x4f, 0x16,
+	0x24, 0xf5, 0x50, 0xc8, 0xc1, 0x32, 0xa1, 0xf9, 0x1c, 0xd8, 0x8f, 0x56, 0xe2, 0x6a, 0xc7, 0xae,
+	0xfb, 0xf1, 0xe7, 0xdf, 0x6f, 0x6b, 0x59, 0x62, 0x53, 0x1c, 0x44, 0x73, 0x99, 0xc9, 0xaa, 0xce,
+	0x00, 0xf9, 0x6c, 0xc1, 0xba, 0x19, 0x34, 0xb9, 0x5e, 0x7c, 0x36, 0x25, 0xf6, 0xe1, 0x6a, 0x64,
+	0x63, 0x65, 0x4f, 0x59, 0x71, 0x48, 0x76, 0x91, 0x95, 0x49, 0xaa, 0xc8, 0x17, 0x0b, 0x36, 0x26,
+	0xe3, 0x27, 0x37, 0x34, 0x98, 0x4d, 0x8f, 0x7d, 0xb4, 0x22, 0xdb, 0xf8, 0xb9, 0xaf, 0xfc, 0xe4,
+	0xc8, 0xce, 0x42, 0x3f, 0x2a, 0x22, 0x3e, 0x13, 0xe4, 0xbb, 0x05, 0x70, 0x19, 0x05, 0xe2, 0x5d,
+	0xdb, 0x64, 0x2e, 0x8d, 0x36, 0x5d, 0x99, 0x6f, 0x6c, 0x3d, 0x50, 0xb6, 0xee, 0x91, 0xdc, 0x22,
+	0x5b, 0x0d, 0xc4, 0x6a, 0x53, 0x17, 0x94, 0xca, 0x67, 0x23, 0xc7, 0x3a, 0x1f, 0x39, 0xd6, 0x9f,
+	0x91, 0x63, 0x7d, 0x1d, 0x3b, 0xb1, 0xf3, 0xb1, 0x13, 0xfb, 0x35, 0x76, 0x62, 0xef, 0x0e, 0xaf,
+	0xbc, 0x47, 0x2d, 0xa2, 0x7f, 0x07, 0x85, 0x27, 0xf4, 0xc3, 0x15, 0x41, 0xf5, 0x32, 0x6b, 0x49,
+	0xf5, 0xd1, 0x79, 0xfc, 0x6f, 0x00, 0x2b, 0x20, 0x88, 0xe6, 0x0e, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -338,6 +449,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the base fee and gas values of the blocks kept in the
+	// fee history.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -375,6 +489,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -383,6 +506,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the base fee and gas values of the blocks kept in the
+	// fee history.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -398,6 +524,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -457,6 +586,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -473,6 +620,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -643,6 +794,76 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -717,6 +938,36 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1111,6 +1362,178 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, BlockFeeInfo{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
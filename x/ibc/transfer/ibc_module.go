@@ -4,7 +4,12 @@
 package transfer
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	ibctransfer "github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	"github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
 )
@@ -12,8 +17,11 @@ import (
 var _ porttypes.IBCModule = IBCModule{}
 
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
+// On acknowledgement and timeout it also calls back the contracts that sent the
+// packet through the ICS20 precompile.
 type IBCModule struct {
 	*ibctransfer.IBCModule
+	keeper keeper.Keeper
 }
 
 // NewIBCModule creates a new IBCModule given the keeper
@@ -21,5 +29,43 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 	transferModule := ibctransfer.NewIBCModule(*k.Keeper)
 	return IBCModule{
 		IBCModule: &transferModule,
+		keeper:    k,
 	}
 }
+
+// OnAcknowledgementPacket implements the IBCModule interface. It executes the
+// packet callback after the acknowledgement has been processed, so that the
+// tokens of a failed transfer are refunded before the sender contract is notified.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	im.keeper.OnAcknowledgementPacketCallback(ctx, packet, ack)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It executes the packet
+// callback after the tokens have been refunded.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacketCallback(ctx, packet)
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	"github.com/evmos/evmos/v15/x/ibc/transfer/types"
+)
+
+// SetPacketCallback registers the callback to execute when the packet with the
+// given source port, source channel and sequence is acknowledged or times out.
+func (k Keeper) SetPacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64, callback types.PacketCallback) error {
+	if err := callback.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketCallbackKey(portID, channelID, sequence), callback.Bytes())
+	return nil
+}
+
+// GetPacketCallback returns the callback registered for the packet with the given
+// source port, source channel and sequence, if any.
+func (k Keeper) GetPacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketCallbackKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PacketCallback{}, false
+	}
+
+	callback, err := types.PacketCallbackFromBytes(bz)
+	if err != nil {
+		return types.PacketCallback{}, false
+	}
+
+	return callback, true
+}
+
+// DeletePacketCallback removes the callback registered for the packet with the
+// given source port, source channel and sequence.
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketCallbackKey(portID, channelID, sequence))
+}
+
+// OnAcknowledgementPacketCallback calls back the contract that sent the packet,
+// if it registered a callback, with the result of the acknowledgement.
+func (k Keeper) OnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	var result []byte
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		result = resp.Result
+	case *channeltypes.Acknowledgement_Error:
+		result = []byte(resp.Error)
+	}
+
+	k.executePacketCallback(
+		ctx, packet, types.OnPacketAcknowledgementMethod,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence, ack.Success(), result,
+	)
+}
+
+// OnTimeoutPacketCallback calls back the contract that sent the packet, if it
+// registered a callback, to notify it that the packet timed out.
+func (k Keeper) OnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet) {
+	k.executePacketCallback(
+		ctx, packet, types.OnPacketTimeoutMethod,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+}
+
+// executePacketCallback calls the given method on the contract registered as the
+// callback of the packet, with the ICS20 precompile as sender and the gas limit
+// chosen when the packet was sent. The callback is removed once executed.
+//
+// A failing callback never fails the acknowledgement or the timeout of the packet,
+// so that the tokens are always refunded: its state changes are discarded and the
+// failure is only reported in an event. The gas used by the callback is charged
+// to the relayer.
+func (k Keeper) executePacketCallback(ctx sdk.Context, packet channeltypes.Packet, method string, args ...interface{}) {
+	callback, found := k.GetPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeletePacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	gasUsed, err := k.callContract(ctx, callback, method, args...)
+	ctx.GasMeter().ConsumeGas(gasUsed, "ics20 packet callback")
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackContract, callback.Contract.Hex()),
+		sdk.NewAttribute(types.AttributeKeyCallbackMethod, method),
+		sdk.NewAttribute(types.AttributeKeyCallbackSequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallbackGasUsed, strconv.FormatUint(gasUsed, 10)),
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error(
+			"packet callback failed",
+			"contract", callback.Contract.Hex(),
+			"method", method,
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCallbackError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypePacketCallback, attrs...))
+}
+
+// callContract calls the given method on the callback contract in a cached context,
+// which is only written if the call succeeds. It returns the gas used by the call.
+func (k Keeper) callContract(ctx sdk.Context, callback types.PacketCallback, method string, args ...interface{}) (uint64, error) {
+	data, err := types.CallbackABI.Pack(method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	msg := ethtypes.NewMessage(
+		common.HexToAddress(types.ICS20PrecompileAddress),
+		&callback.Contract,
+		0,                 // nonce, not checked nor increased on calls
+		big.NewInt(0),     // amount
+		callback.GasLimit, // gasLimit
+		big.NewInt(0),     // gasFeeCap
+		big.NewInt(0),     // gasTipCap
+		big.NewInt(0),     // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	// As for Ethereum transactions, the call is only metered by the EVM gas.
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return callback.GasLimit, err
+	}
+
+	if res.Failed() {
+		return res.GasUsed, evmtypes.ErrVMExecution.Wrap(res.VmError)
+	}
+
+	writeCache()
+	return res.GasUsed, nil
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/ibc/transfer/types"
+)
+
+var (
+	// callerRecorderCode stores the caller in slot 0 and the first word of the
+	// calldata, which starts with the method selector, in slot 1.
+	callerRecorderCode = hexutil.MustDecode("0x33600055600035600155" + "00")
+	// revertingCode stores the caller in slot 0 and reverts.
+	revertingCode = hexutil.MustDecode("0x336000556000" + "6000fd")
+	// infiniteLoopCode loops until it runs out of gas.
+	infiniteLoopCode = hexutil.MustDecode("0x5b600056")
+)
+
+// setCode deploys the given runtime code at a new address.
+func (suite *KeeperTestSuite) setCode(code []byte) common.Address {
+	addr := utiltx.GenerateAddress()
+	db := suite.StateDB()
+	db.SetCode(addr, code)
+	suite.Require().NoError(db.Commit())
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestPacketCallbackStore() {
+	suite.SetupTest()
+	k := suite.app.TransferKeeper
+
+	_, found := k.GetPacketCallback(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().False(found)
+
+	err := k.SetPacketCallback(suite.ctx, "transfer", "channel-0", 1, types.PacketCallback{})
+	suite.Require().ErrorContains(err, "zero address")
+
+	err = k.SetPacketCallback(suite.ctx, "transfer", "channel-0", 1, types.PacketCallback{
+		Contract: utiltx.GenerateAddress(),
+		GasLimit: types.MaxCallbackGasLimit + 1,
+	})
+	suite.Require().ErrorContains(err, "invalid callback gas limit")
+
+	callback := types.PacketCallback{Contract: utiltx.GenerateAddress(), GasLimit: 100_000}
+	err = k.SetPacketCallback(suite.ctx, "transfer", "channel-0", 1, callback)
+	suite.Require().NoError(err)
+
+	stored, found := k.GetPacketCallback(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(callback, stored)
+
+	// the callbacks are keyed by port, channel and sequence
+	_, found = k.GetPacketCallback(suite.ctx, "transfer", "channel-0", 2)
+	suite.Require().False(found)
+	_, found = k.GetPacketCallback(suite.ctx, "transfer", "channel-1", 1)
+	suite.Require().False(found)
+
+	// the callbacks don't interfere with the denom traces of the transfer module
+	traces, err := k.DenomTraces(suite.ctx, &transfertypes.QueryDenomTracesRequest{Pagination: &query.PageRequest{}})
+	suite.Require().NoError(err)
+	suite.Require().Empty(traces.DenomTraces)
+
+	k.DeletePacketCallback(suite.ctx, "transfer", "channel-0", 1)
+	_, found = k.GetPacketCallback(suite.ctx, "transfer", "channel-0", 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestPacketCallbacks() {
+	packet := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 1}
+	gasLimit := uint64(100_000)
+
+	testCases := []struct {
+		name     string
+		code     []byte
+		timeout  bool
+		expOK    bool
+		expSlot0 common.Hash
+		expGas   bool
+	}{
+		{
+			name:     "success - acknowledgement",
+			code:     callerRecorderCode,
+			expOK:    true,
+			expSlot0: common.BytesToHash(common.HexToAddress(types.ICS20PrecompileAddress).Bytes()),
+		},
+		{
+			name:     "success - timeout",
+			code:     callerRecorderCode,
+			timeout:  true,
+			expOK:    true,
+			expSlot0: common.BytesToHash(common.HexToAddress(types.ICS20PrecompileAddress).Bytes()),
+		},
+		{
+			name:     "fail - reverted callback state is discarded",
+			code:     revertingCode,
+			expOK:    false,
+			expSlot0: common.Hash{},
+		},
+		{
+			name:     "fail - out of gas callback uses its gas limit",
+			code:     infiniteLoopCode,
+			expOK:    false,
+			expSlot0: common.Hash{},
+			expGas:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.TransferKeeper
+
+			contract := suite.setCode(tc.code)
+			err := k.SetPacketCallback(suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, types.PacketCallback{
+				Contract: contract,
+				GasLimit: gasLimit,
+			})
+			suite.Require().NoError(err)
+
+			gasBefore := suite.ctx.GasMeter().GasConsumed()
+			method := types.OnPacketAcknowledgementMethod
+			if tc.timeout {
+				method = types.OnPacketTimeoutMethod
+				k.OnTimeoutPacketCallback(suite.ctx, packet)
+			} else {
+				k.OnAcknowledgementPacketCallback(suite.ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}))
+			}
+			gasUsed := suite.ctx.GasMeter().GasConsumed() - gasBefore
+
+			// the callback is executed only once
+			_, found := k.GetPacketCallback(suite.ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			suite.Require().Equal(tc.expSlot0, suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{}))
+			if tc.expOK {
+				selector := types.CallbackABI.Methods[method].ID
+				slot1 := suite.app.EvmKeeper.GetState(suite.ctx, contract, common.BigToHash(common.Big1))
+				suite.Require().Equal(selector, slot1.Bytes()[:4])
+			}
+
+			events := suite.ctx.EventManager().Events()
+			event := events[len(events)-1]
+			suite.Require().Equal(types.EventTypePacketCallback, event.Type)
+
+			attrs := make(map[string]string, len(event.Attributes))
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			suite.Require().Equal(contract.Hex(), attrs[types.AttributeKeyCallbackContract])
+			suite.Require().Equal(method, attrs[types.AttributeKeyCallbackMethod])
+			suite.Require().Equal("1", attrs[types.AttributeKeyCallbackSequence])
+			_, failed := attrs[types.AttributeKeyCallbackError]
+			suite.Require().Equal(!tc.expOK, failed)
+
+			// the gas used by the callback is charged to the relayer
+			callbackGas, err := strconv.ParseUint(attrs[types.AttributeKeyCallbackGasUsed], 10, 64)
+			suite.Require().NoError(err)
+			suite.Require().GreaterOrEqual(gasUsed, callbackGas)
+			if tc.expGas {
+				suite.Require().Equal(gasLimit, callbackGas)
+			}
+		})
+	}
+
+	suite.Run("no callback registered", func() {
+		suite.SetupTest()
+		eventsBefore := len(suite.ctx.EventManager().Events())
+
+		suite.app.TransferKeeper.OnTimeoutPacketCallback(suite.ctx, packet)
+		suite.Require().Len(suite.ctx.EventManager().Events(), eventsBefore)
+	})
+}
@@ -18,12 +18,15 @@ import (
 
 // Keeper defines the modified IBC transfer keeper that embeds the original one.
 // It also contains the bank keeper and the erc20 keeper to support ERC20 tokens
-// to be sent via IBC.
+// to be sent via IBC, and the EVM keeper to call back the contracts that sent
+// a packet through the ICS20 precompile.
 type Keeper struct {
 	*keeper.Keeper
+	storeKey      storetypes.StoreKey
 	bankKeeper    types.BankKeeper
 	erc20Keeper   types.ERC20Keeper
 	accountKeeper types.AccountKeeper
+	evmKeeper     types.EVMKeeper
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -39,6 +42,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	erc20Keeper types.ERC20Keeper,
+	evmKeeper types.EVMKeeper,
 ) Keeper {
 	// create the original IBC transfer keeper for embedding
 	transferKeeper := keeper.NewKeeper(
@@ -49,8 +53,10 @@ func NewKeeper(
 
 	return Keeper{
 		Keeper:        &transferKeeper,
+		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
 		erc20Keeper:   erc20Keeper,
 		accountKeeper: accountKeeper,
+		evmKeeper:     evmKeeper,
 	}
 }
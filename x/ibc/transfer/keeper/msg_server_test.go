@@ -273,6 +273,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				mockChannelKeeper, &suite.app.IBCKeeper.PortKeeper,
 				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.ScopedTransferKeeper,
 				suite.app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
+				suite.app.EvmKeeper,   // Add EVM Keeper for the ICS20 precompile packet callbacks
 			)
 			msg := tc.malleate()
 
@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ICS20PrecompileAddress is the address of the ICS20 precompile, which registers
+	// the packet callbacks and is the sender of the callback calls.
+	ICS20PrecompileAddress = "0x0000000000000000000000000000000000000802"
+
+	// MaxCallbackGasLimit is the maximum gas limit of a packet callback. The callbacks
+	// are paid by the relayer, so their gas is bounded.
+	MaxCallbackGasLimit uint64 = 1_000_000
+
+	// OnPacketAcknowledgementMethod is the method of the sender contract called when
+	// a packet is acknowledged.
+	OnPacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// OnPacketTimeoutMethod is the method of the sender contract called when a packet
+	// times out.
+	OnPacketTimeoutMethod = "onPacketTimeout"
+
+	// EventTypePacketCallback is the event type emitted when a packet callback is executed.
+	EventTypePacketCallback = "ics20_packet_callback"
+	// AttributeKeyCallbackContract is the attribute key of the contract called back.
+	AttributeKeyCallbackContract = "contract"
+	// AttributeKeyCallbackMethod is the attribute key of the method called back.
+	AttributeKeyCallbackMethod = "method"
+	// AttributeKeyCallbackSequence is the attribute key of the packet sequence.
+	AttributeKeyCallbackSequence = "sequence"
+	// AttributeKeyCallbackGasUsed is the attribute key of the gas used by the callback.
+	AttributeKeyCallbackGasUsed = "gas_used"
+	// AttributeKeyCallbackError is the attribute key of the error of a failed callback.
+	AttributeKeyCallbackError = "error"
+)
+
+// callbackABIJSON is the ABI of the callbacks that a contract must implement to be
+// notified of the lifecycle of the packets it sent through the ICS20 precompile.
+const callbackABIJSON = `[
+	{"type":"function","name":"onPacketAcknowledgement","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"sourcePort","type":"string"},
+		{"name":"sourceChannel","type":"string"},
+		{"name":"sequence","type":"uint64"},
+		{"name":"success","type":"bool"},
+		{"name":"acknowledgement","type":"bytes"}
+	]},
+	{"type":"function","name":"onPacketTimeout","stateMutability":"nonpayable","outputs":[],"inputs":[
+		{"name":"sourcePort","type":"string"},
+		{"name":"sourceChannel","type":"string"},
+		{"name":"sequence","type":"uint64"}
+	]}
+]`
+
+// CallbackABI is the parsed ABI of the packet callbacks.
+var CallbackABI abi.ABI
+
+func init() {
+	var err error
+	CallbackABI, err = abi.JSON(strings.NewReader(callbackABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// PacketCallback defines the contract to call back when a packet is acknowledged
+// or times out, and the gas limit of the call.
+type PacketCallback struct {
+	Contract common.Address
+	GasLimit uint64
+}
+
+// Validate performs a stateless validation of the packet callback.
+func (pc PacketCallback) Validate() error {
+	if pc.Contract == (common.Address{}) {
+		return fmt.Errorf("callback contract cannot be the zero address")
+	}
+
+	if pc.GasLimit == 0 || pc.GasLimit > MaxCallbackGasLimit {
+		return fmt.Errorf("invalid callback gas limit %d, must be between 1 and %d", pc.GasLimit, MaxCallbackGasLimit)
+	}
+
+	return nil
+}
+
+// Bytes returns the store encoding of the packet callback: the contract address
+// followed by the big endian gas limit.
+func (pc PacketCallback) Bytes() []byte {
+	return append(pc.Contract.Bytes(), sdk.Uint64ToBigEndian(pc.GasLimit)...)
+}
+
+// PacketCallbackFromBytes decodes a packet callback from its store encoding.
+func PacketCallbackFromBytes(bz []byte) (PacketCallback, error) {
+	if len(bz) != common.AddressLength+8 {
+		return PacketCallback{}, fmt.Errorf("invalid packet callback length %d", len(bz))
+	}
+
+	return PacketCallback{
+		Contract: common.BytesToAddress(bz[:common.AddressLength]),
+		GasLimit: sdk.BigEndianToUint64(bz[common.AddressLength:]),
+	}, nil
+}
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyPrefixPacketCallback is the prefix under which the packet callbacks are
+// stored in the IBC transfer store. It is a string prefix so that it doesn't
+// collide with the single byte prefixes of the IBC transfer module.
+var KeyPrefixPacketCallback = []byte("evmosPacketCallback/")
+
+// PacketCallbackKey returns the store key of the callback registered for the
+// packet with the given source port, source channel and sequence.
+func PacketCallbackKey(portID, channelID string, sequence uint64) []byte {
+	return append(
+		append(KeyPrefixPacketCallback, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...),
+		sdk.Uint64ToBigEndian(sequence)...,
+	)
+}
@@ -1476,7 +1476,8 @@ var _ = Describe("Clawback Vesting Account - Barberry bug", func() {
 		// account.
 		vestingLength = int64(60 * 60 * 24 * 30) // 30 days in seconds
 
-		// txCost is the cost of a transaction to be deducted from the expected account balance
+		// txCost is the cost of a transaction to be deducted from the expected account balance,
+		// which is charged on the gas used as the fees of the unused gas are refunded
 		txCost int64
 	)
 
@@ -1499,7 +1500,7 @@ var _ = Describe("Clawback Vesting Account - Barberry bug", func() {
 
 		res, err := testutil.DeliverTx(s.ctx, s.app, vestingPriv, &gasPrice, msgCreate)
 		Expect(err).ToNot(HaveOccurred(), "failed to create clawback vesting account")
-		txCost = gasPrice.Int64() * res.GasWanted
+		txCost = gasPrice.Int64() * res.GasUsed
 
 		// Check clawback acccount was created
 		acc := s.app.AccountKeeper.GetAccount(s.ctx, vestingAddr)